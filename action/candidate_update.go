@@ -11,17 +11,21 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 )
 
 const (
 	// CandidateUpdateBaseIntrinsicGas represents the base intrinsic gas for CandidateUpdate
 	CandidateUpdateBaseIntrinsicGas = uint64(10000)
+	// CandidateUpdateBLSIntrinsicGas represents the intrinsic gas to verify the proof of possession of the BLS key
+	CandidateUpdateBLSIntrinsicGas = uint64(20000)
 
 	_candidateUpdateInterfaceABI = `[
 		{
@@ -46,6 +50,39 @@ const (
 			"outputs": [],
 			"stateMutability": "nonpayable",
 			"type": "function"
+		},
+		{
+			"inputs": [
+				{
+					"internalType": "string",
+					"name": "name",
+					"type": "string"
+				},
+				{
+					"internalType": "address",
+					"name": "operatorAddress",
+					"type": "address"
+				},
+				{
+					"internalType": "address",
+					"name": "rewardAddress",
+					"type": "address"
+				},
+				{
+					"internalType": "bytes",
+					"name": "blsPubKey",
+					"type": "bytes"
+				},
+				{
+					"internalType": "bytes",
+					"name": "blsProof",
+					"type": "bytes"
+				}
+			],
+			"name": "candidateUpdateWithBLS",
+			"outputs": [],
+			"stateMutability": "nonpayable",
+			"type": "function"
 		}
 	]`
 )
@@ -53,7 +90,12 @@ const (
 var (
 	// _candidateUpdateMethod is the interface of the abi encoding of stake action
 	_candidateUpdateMethod abi.Method
-	_                      EthCompatibleAction = (*CandidateUpdate)(nil)
+	// _candidateUpdateWithBLSMethod is the interface of the abi encoding of stake action with the BLS key
+	_candidateUpdateWithBLSMethod abi.Method
+	_                             EthCompatibleAction = (*CandidateUpdate)(nil)
+
+	// ErrInvalidBLSKey represents that the BLS public key or its proof of possession is invalid
+	ErrInvalidBLSKey = errors.New("invalid BLS public key")
 )
 
 // CandidateUpdate is the action to update a candidate
@@ -62,6 +104,8 @@ type CandidateUpdate struct {
 	name            string
 	operatorAddress address.Address
 	rewardAddress   address.Address
+	blsPubKey       []byte
+	blsProof        []byte
 }
 
 func init() {
@@ -74,6 +118,10 @@ func init() {
 	if !ok {
 		panic("fail to load the method")
 	}
+	_candidateUpdateWithBLSMethod, ok = _candidateUpdateInterface.Methods["candidateUpdateWithBLS"]
+	if !ok {
+		panic("fail to load the method")
+	}
 }
 
 // NewCandidateUpdate creates a CandidateUpdate instance
@@ -99,6 +147,18 @@ func NewCandidateUpdate(name, operatorAddrStr, rewardAddrStr string) (*Candidate
 	return cu, nil
 }
 
+// NewCandidateUpdateWithBLS creates a CandidateUpdate instance registering the BLS public key of the candidate,
+// with the proof of possession of the key signed with BLSProofMessage
+func NewCandidateUpdateWithBLS(name, operatorAddrStr, rewardAddrStr string, blsPubKey, blsProof []byte) (*CandidateUpdate, error) {
+	cu, err := NewCandidateUpdate(name, operatorAddrStr, rewardAddrStr)
+	if err != nil {
+		return nil, err
+	}
+	cu.blsPubKey = append([]byte{}, blsPubKey...)
+	cu.blsProof = append([]byte{}, blsProof...)
+	return cu, nil
+}
+
 // Name returns candidate name to update
 func (cu *CandidateUpdate) Name() string { return cu.name }
 
@@ -108,6 +168,12 @@ func (cu *CandidateUpdate) OperatorAddress() address.Address { return cu.operato
 // RewardAddress returns candidate rewardAddress to update
 func (cu *CandidateUpdate) RewardAddress() address.Address { return cu.rewardAddress }
 
+// BLSPubKey returns the BLS public key to register, nil if the key is not updated
+func (cu *CandidateUpdate) BLSPubKey() []byte { return cu.blsPubKey }
+
+// BLSProof returns the proof of possession of the BLS public key
+func (cu *CandidateUpdate) BLSProof() []byte { return cu.blsProof }
+
 // Serialize returns a raw byte stream of the CandidateUpdate struct
 func (cu *CandidateUpdate) Serialize() []byte {
 	return byteutil.Must(proto.Marshal(cu.Proto()))
@@ -131,6 +197,11 @@ func (cu *CandidateUpdate) Proto() *iotextypes.CandidateBasicInfo {
 		act.RewardAddress = cu.rewardAddress.String()
 	}
 
+	if len(cu.blsPubKey) > 0 {
+		act.BlsPubKey = cu.blsPubKey
+		act.BlsProof = cu.blsProof
+	}
+
 	return act
 }
 
@@ -157,11 +228,20 @@ func (cu *CandidateUpdate) LoadProto(pbAct *iotextypes.CandidateBasicInfo) error
 		}
 		cu.rewardAddress = rewardAddr
 	}
+	if len(pbAct.GetBlsPubKey()) > 0 {
+		cu.blsPubKey = append([]byte{}, pbAct.GetBlsPubKey()...)
+	}
+	if len(pbAct.GetBlsProof()) > 0 {
+		cu.blsProof = append([]byte{}, pbAct.GetBlsProof()...)
+	}
 	return nil
 }
 
 // IntrinsicGas returns the intrinsic gas of a CandidateUpdate
 func (cu *CandidateUpdate) IntrinsicGas() (uint64, error) {
+	if len(cu.blsPubKey) > 0 {
+		return CandidateUpdateBaseIntrinsicGas + CandidateUpdateBLSIntrinsicGas, nil
+	}
 	return CandidateUpdateBaseIntrinsicGas, nil
 }
 
@@ -170,6 +250,35 @@ func (cu *CandidateUpdate) SanityCheck() error {
 	if !IsValidCandidateName(cu.Name()) {
 		return ErrInvalidCanName
 	}
+	if len(cu.blsPubKey) == 0 && len(cu.blsProof) == 0 {
+		return nil
+	}
+	if len(cu.blsPubKey) != bls.PublicKeySize || len(cu.blsProof) != bls.SignatureSize {
+		return errors.Wrap(ErrInvalidBLSKey, "invalid size of BLS public key or proof")
+	}
+	return nil
+}
+
+// BLSProofMessage returns the message that the proof of possession of a BLS public key is signed with, which binds
+// the key to the owner of the candidate on the chain
+func BLSProofMessage(owner address.Address, chainID uint32) []byte {
+	return append(owner.Bytes(), byteutil.Uint32ToBytesBigEndian(chainID)...)
+}
+
+// VerifyBLSKey checks the BLS public key is valid and its proof of possession is signed by the key for the candidate
+// of owner on the chain
+func (cu *CandidateUpdate) VerifyBLSKey(owner address.Address, chainID uint32) error {
+	pk, err := bls.BytesToPublicKey(cu.blsPubKey)
+	if err != nil {
+		return errors.Wrap(ErrInvalidBLSKey, err.Error())
+	}
+	proof, err := bls.BytesToSignature(cu.blsProof)
+	if err != nil {
+		return errors.Wrap(ErrInvalidBLSKey, err.Error())
+	}
+	if !pk.VerifyProofOfPossession(BLSProofMessage(owner, chainID), proof) {
+		return errors.Wrap(ErrInvalidBLSKey, "failed to verify the proof of possession")
+	}
 	return nil
 }
 
@@ -181,6 +290,17 @@ func (cu *CandidateUpdate) EthData() ([]byte, error) {
 	if cu.rewardAddress == nil {
 		return nil, ErrAddress
 	}
+	if len(cu.blsPubKey) > 0 {
+		data, err := _candidateUpdateWithBLSMethod.Inputs.Pack(cu.name,
+			common.BytesToAddress(cu.operatorAddress.Bytes()),
+			common.BytesToAddress(cu.rewardAddress.Bytes()),
+			cu.blsPubKey,
+			cu.blsProof)
+		if err != nil {
+			return nil, err
+		}
+		return append(_candidateUpdateWithBLSMethod.ID, data...), nil
+	}
 	data, err := _candidateUpdateMethod.Inputs.Pack(cu.name,
 		common.BytesToAddress(cu.operatorAddress.Bytes()),
 		common.BytesToAddress(cu.rewardAddress.Bytes()))
@@ -199,10 +319,16 @@ func NewCandidateUpdateFromABIBinary(data []byte) (*CandidateUpdate, error) {
 		cu        CandidateUpdate
 	)
 	// sanity check
-	if len(data) <= 4 || !bytes.Equal(_candidateUpdateMethod.ID, data[:4]) {
+	if len(data) <= 4 {
+		return nil, errDecodeFailure
+	}
+	method := _candidateUpdateMethod
+	if bytes.Equal(_candidateUpdateWithBLSMethod.ID, data[:4]) {
+		method = _candidateUpdateWithBLSMethod
+	} else if !bytes.Equal(_candidateUpdateMethod.ID, data[:4]) {
 		return nil, errDecodeFailure
 	}
-	if err := _candidateUpdateMethod.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+	if err := method.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
 		return nil, err
 	}
 	if cu.name, ok = paramsMap["name"].(string); !ok {
@@ -214,5 +340,13 @@ func NewCandidateUpdateFromABIBinary(data []byte) (*CandidateUpdate, error) {
 	if cu.rewardAddress, err = ethAddrToNativeAddr(paramsMap["rewardAddress"]); err != nil {
 		return nil, err
 	}
+	if method.Name == _candidateUpdateWithBLSMethod.Name {
+		if cu.blsPubKey, ok = paramsMap["blsPubKey"].([]byte); !ok {
+			return nil, errDecodeFailure
+		}
+		if cu.blsProof, ok = paramsMap["blsProof"].([]byte); !ok {
+			return nil, errDecodeFailure
+		}
+	}
 	return &cu, nil
 }
@@ -10,8 +10,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
 )
 
 var (
@@ -81,4 +85,51 @@ func TestCandidateUpdate(t *testing.T) {
 		_, err = cu.EthData()
 		require.Equal(ErrAddress, err)
 	})
+	t.Run("BLS key", func(t *testing.T) {
+		sk, err := bls.GenerateKey()
+		require.NoError(err)
+		owner := identityset.Address(1)
+		pop, err := sk.ProofOfPossession(BLSProofMessage(owner, 1))
+		require.NoError(err)
+		cu, err := NewCandidateUpdateWithBLS(_cuName, _cuOperatorAddrStr, _cuRewardAddrStr, sk.PublicKey().Bytes(), pop.Bytes())
+		require.NoError(err)
+		require.NoError(cu.SanityCheck())
+		require.NoError(cu.VerifyBLSKey(owner, 1))
+		// the proof is bound to the owner and the chain
+		require.ErrorIs(cu.VerifyBLSKey(identityset.Address(2), 1), ErrInvalidBLSKey)
+		require.ErrorIs(cu.VerifyBLSKey(owner, 2), ErrInvalidBLSKey)
+		gas, err := cu.IntrinsicGas()
+		require.NoError(err)
+		require.Equal(CandidateUpdateBaseIntrinsicGas+CandidateUpdateBLSIntrinsicGas, gas)
+
+		b, err := proto.Marshal(cu.Proto())
+		require.NoError(err)
+		pb := &iotextypes.CandidateBasicInfo{}
+		require.NoError(proto.Unmarshal(b, pb))
+		require.Equal(sk.PublicKey().Bytes(), pb.GetBlsPubKey())
+		require.Equal(pop.Bytes(), pb.GetBlsProof())
+		cu2 := &CandidateUpdate{}
+		require.NoError(cu2.LoadProto(pb))
+		require.Equal(sk.PublicKey().Bytes(), cu2.BLSPubKey())
+		require.Equal(pop.Bytes(), cu2.BLSProof())
+		require.Equal(cu.Serialize(), cu2.Serialize())
+
+		data, err := cu.EthData()
+		require.NoError(err)
+		cu2, err = NewCandidateUpdateFromABIBinary(data)
+		require.NoError(err)
+		require.Equal(_cuName, cu2.Name())
+		require.Equal(sk.PublicKey().Bytes(), cu2.BLSPubKey())
+		require.Equal(pop.Bytes(), cu2.BLSProof())
+
+		// the proof of another key is rejected
+		other, err := bls.GenerateKey()
+		require.NoError(err)
+		cu2, err = NewCandidateUpdateWithBLS(_cuName, _cuOperatorAddrStr, _cuRewardAddrStr, other.PublicKey().Bytes(), pop.Bytes())
+		require.NoError(err)
+		require.ErrorIs(cu2.VerifyBLSKey(owner, 1), ErrInvalidBLSKey)
+		cu2, err = NewCandidateUpdateWithBLS(_cuName, _cuOperatorAddrStr, _cuRewardAddrStr, sk.PublicKey().Bytes(), nil)
+		require.NoError(err)
+		require.ErrorIs(cu2.SanityCheck(), ErrInvalidBLSKey)
+	})
 }
@@ -157,6 +157,7 @@ type (
 		TimestampedStakingContract              bool
 		PreStateSystemAction                    bool
 		CreatePostActionStates                  bool
+		EnableBLSEndorsement                    bool
 	}
 
 	// FeatureWithHeightCtx provides feature check functions.
@@ -319,6 +320,7 @@ func WithFeatureCtx(ctx context.Context) context.Context {
 			TimestampedStakingContract:              g.IsWake(height),
 			PreStateSystemAction:                    !g.IsWake(height),
 			CreatePostActionStates:                  g.IsWake(height),
+			EnableBLSEndorsement:                    g.IsBLSEndorsement(height),
 		},
 	)
 }
@@ -327,6 +329,9 @@ func (fCtx *FeatureCtx) Tolerate(err error) bool {
 	if fCtx.TolerateEmptyCandidateName && errors.Cause(err) == action.ErrInvalidCanName {
 		return true
 	}
+	if !fCtx.EnableBLSEndorsement && errors.Cause(err) == action.ErrInvalidBLSKey {
+		return true
+	}
 	return false
 }
 
@@ -14,7 +14,10 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
 )
 
 func TestRegistryCtx(t *testing.T) {
@@ -183,3 +186,16 @@ func TestGetVMConfigCtx(t *testing.T) {
 	require.True(ok)
 	require.True(ret.NoBaseFee)
 }
+
+func TestFeatureCtxTolerate(t *testing.T) {
+	require := require.New(t)
+	blsErr := errors.Wrap(action.ErrInvalidBLSKey, "invalid size of BLS public key or proof")
+	fCtx := &FeatureCtx{TolerateEmptyCandidateName: true}
+	require.True(fCtx.Tolerate(action.ErrInvalidCanName))
+	// the BLS key is not checked before the fork
+	require.True(fCtx.Tolerate(blsErr))
+	require.False(fCtx.Tolerate(action.ErrInvalidAct))
+	fCtx = &FeatureCtx{EnableBLSEndorsement: true}
+	require.False(fCtx.Tolerate(action.ErrInvalidCanName))
+	require.False(fCtx.Tolerate(blsErr))
+}
@@ -198,7 +198,7 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 		options = append(options, mptrie.RootHashOption(account.Root[:]))
 	}
 	if enableAsync {
-		options = append(options, mptrie.AsyncOption())
+		options = append(options, mptrie.AsyncOption(), mptrie.ParallelHashOption(mptrie.ParallelHashThreshold))
 	}
 
 	tr, err := mptrie.New(options...)
@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/iotexproject/go-pkgs/hash"
@@ -84,6 +85,13 @@ type (
 		DelState(...StateOption) (uint64, error)
 		WriteView(string, View) error
 	}
+
+	// StateForker defines a state reader which creates working sets on top of its state, the changes made to
+	// the working sets are discarded
+	StateForker interface {
+		StateReader
+		WorkingSet(context.Context) (StateManager, error)
+	}
 )
 
 type (
@@ -14,6 +14,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/v2/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/v2/state"
@@ -124,3 +125,30 @@ func (cd *CandidateIndexer) ProbationList(height uint64) (*vote.ProbationList, e
 	}
 	return bl, nil
 }
+
+// Rewind deletes the candidate and probation lists stored above the height
+func (cd *CandidateIndexer) Rewind(_ context.Context, height uint64) error {
+	cd.mutex.Lock()
+	defer cd.mutex.Unlock()
+	b := batch.NewBatch()
+	for _, ns := range []string{CandidateNamespace, ProbationNamespace} {
+		keys, _, err := cd.kvStore.Filter(ns, func(k, v []byte) bool {
+			return len(k) == 8 && byteutil.BytesToUint64(k) > height
+		}, nil, nil)
+		switch errors.Cause(err) {
+		case nil:
+		case db.ErrNotExist, db.ErrBucketNotExist:
+			continue
+		default:
+			return err
+		}
+		for _, k := range keys {
+			b.Delete(ns, k, "failed to delete data above rewind height")
+		}
+	}
+	if b.Size() == 0 {
+		return nil
+	}
+	log.L().Info("rewind candidate indexer", zap.Uint64("height", height), zap.Int("deleted", b.Size()))
+	return cd.kvStore.WriteBatch(b)
+}
@@ -86,6 +86,14 @@ type PostActionHandler interface {
 	HandleReceipt(ctx context.Context, elp action.Envelope, sm StateManager, receipt *action.Receipt) error
 }
 
+// StateMerger merges the update of a state made on top of a stale value, so that actions updating the same state
+// can be executed in parallel
+type StateMerger interface {
+	// MergeState rebases the value written on top of the value read onto the current value, it returns false if the
+	// state is not handled or the update cannot be merged
+	MergeState(ns string, key []byte, current, read, written []byte) ([]byte, bool, error)
+}
+
 type (
 	DepositOptionCfg struct {
 		PriorityFee *big.Int
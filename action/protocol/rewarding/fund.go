@@ -6,9 +6,11 @@
 package rewarding
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
@@ -133,6 +135,51 @@ func (p *Protocol) AvailableBalance(
 	return f.unclaimedBalance, height, nil
 }
 
+// MergeState merges the deposits into the rewarding fund made on top of a stale fund. An update decreasing the
+// balances, like claiming the reward, cannot be merged as it depends on the balances
+func (p *Protocol) MergeState(ns string, key []byte, current, read, written []byte) ([]byte, bool, error) {
+	if !p.isFundKey(ns, key) {
+		return nil, false, nil
+	}
+	var c, r, w fund
+	for _, v := range []struct {
+		f    *fund
+		data []byte
+	}{
+		{&c, current},
+		{&r, read},
+		{&w, written},
+	} {
+		if err := v.f.Deserialize(v.data); err != nil {
+			return nil, false, err
+		}
+	}
+	var (
+		total     = new(big.Int).Sub(w.totalBalance, r.totalBalance)
+		unclaimed = new(big.Int).Sub(w.unclaimedBalance, r.unclaimedBalance)
+	)
+	if total.Sign() < 0 || unclaimed.Sign() < 0 {
+		return nil, false, nil
+	}
+	merged, err := fund{
+		totalBalance:     total.Add(total, c.totalBalance),
+		unclaimedBalance: unclaimed.Add(unclaimed, c.unclaimedBalance),
+	}.Serialize()
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+func (p *Protocol) isFundKey(ns string, key []byte) bool {
+	k := append(p.keyPrefix, _fundKey...)
+	if ns == _v2RewardingNamespace {
+		return bytes.Equal(key, k)
+	}
+	keyHash := hash.Hash160b(k)
+	return bytes.Equal(key, keyHash[:])
+}
+
 // DepositGas deposits gas into the rewarding fund
 func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int, opts ...protocol.DepositOption) ([]*action.TransactionLog, error) {
 	// TODO: we bypass the gas deposit for the actions in genesis block. Later we should remove this after we remove
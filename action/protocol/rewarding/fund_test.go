@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,6 +19,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 )
 
 func TestProtocol_Fund(t *testing.T) {
@@ -56,3 +58,42 @@ func TestDepositNegativeGasFee(t *testing.T) {
 		require.Error(t, err)
 	}, false)
 }
+
+func TestProtocol_MergeState(t *testing.T) {
+	r := require.New(t)
+	p := NewProtocol(genesis.Default.Rewarding)
+	serialize := func(total, unclaimed int64) []byte {
+		data, err := fund{
+			totalBalance:     big.NewInt(total),
+			unclaimedBalance: big.NewInt(unclaimed),
+		}.Serialize()
+		r.NoError(err)
+		return data
+	}
+	v2Key := append(p.keyPrefix, _fundKey...)
+	v1Key := hash.Hash160b(v2Key)
+
+	for _, key := range []struct {
+		ns  string
+		key []byte
+	}{
+		{_v2RewardingNamespace, v2Key},
+		{"Account", v1Key[:]},
+	} {
+		// deposits are merged onto the current fund
+		merged, ok, err := p.MergeState(key.ns, key.key, serialize(130, 70), serialize(100, 50), serialize(105, 53))
+		r.NoError(err)
+		r.True(ok)
+		r.Equal(serialize(135, 73), merged)
+		// claims cannot be merged
+		_, ok, err = p.MergeState(key.ns, key.key, serialize(130, 70), serialize(100, 50), serialize(100, 40))
+		r.NoError(err)
+		r.False(ok)
+	}
+	// other states are not handled
+	_, ok, err := p.MergeState(_v2RewardingNamespace, []byte("other"), nil, nil, nil)
+	r.NoError(err)
+	r.False(ok)
+	_, _, err = p.MergeState(_v2RewardingNamespace, v2Key, []byte{1}, serialize(100, 50), serialize(105, 53))
+	r.Error(err)
+}
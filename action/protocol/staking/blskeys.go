@@ -0,0 +1,64 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/v2/state"
+)
+
+// snapshotBLSKeys takes the snapshot of the candidates with a BLS public key at the start of the epoch, as the
+// keys of the next epoch. So the keys of the current and the next epoch are both known at any block of an
+// epoch, and a key registered in an epoch takes effect 2 epochs later
+func snapshotBLSKeys(sm protocol.StateManager, epochNum uint64) error {
+	csr, err := ConstructBaseView(sm)
+	if err != nil {
+		return err
+	}
+	var cands CandidateList
+	for _, c := range csr.AllCandidates() {
+		if len(c.BLSPubKey) != 0 {
+			cands = append(cands, c)
+		}
+	}
+	if len(cands) != 0 {
+		if _, err := sm.PutState(cands, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(blsKeysKey(epochNum+1))); err != nil {
+			return err
+		}
+	}
+	if epochNum == 0 {
+		return nil
+	}
+	// the keys of the previous epoch are no longer used
+	_, err = sm.DelState(protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(blsKeysKey(epochNum-1)))
+	if errors.Cause(err) == state.ErrStateNotExist {
+		return nil
+	}
+	return err
+}
+
+// BLSKeysByEpoch returns the candidates with a BLS public key in the epoch, which is either the epoch of the
+// state or the next one. It returns an empty list if no key is registered
+func BLSKeysByEpoch(sr protocol.StateReader, epochNum uint64) (CandidateList, error) {
+	var cands CandidateList
+	_, err := sr.State(&cands, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(blsKeysKey(epochNum)))
+	switch errors.Cause(err) {
+	case nil:
+		return cands, nil
+	case state.ErrStateNotExist:
+		return CandidateList{}, nil
+	default:
+		return nil, err
+	}
+}
+
+func blsKeysKey(epochNum uint64) []byte {
+	key := []byte{_blsKeys}
+	return append(key, byteutil.Uint64ToBytesBigEndian(epochNum)...)
+}
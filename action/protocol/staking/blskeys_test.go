@@ -0,0 +1,89 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
+	"github.com/iotexproject/iotex-core/v2/testutil/testdb"
+)
+
+func TestSnapshotBLSKeys(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm := testdb.NewMockStateManager(ctrl)
+	_, err := sm.PutState(
+		&totalBucketCount{count: 0},
+		protocol.NamespaceOption(_stakingNameSpace),
+		protocol.KeyOption(TotalBucketKey),
+	)
+	r.NoError(err)
+	g := genesis.TestDefault()
+	p, err := NewProtocol(HelperCtx{
+		DepositGas:    nil,
+		BlockInterval: getBlockInterval,
+	}, &BuilderConfig{
+		Staking:                  g.Staking,
+		PersistStakingPatchBlock: math.MaxUint64,
+		Revise: ReviseConfig{
+			VoteWeight: g.Staking.VoteWeightCalConsts,
+		},
+	}, nil, nil, nil)
+	r.NoError(err)
+	ctx := genesis.WithGenesisContext(context.Background(), g)
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 10})
+	ctx = protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+	v, err := p.Start(ctx, sm)
+	r.NoError(err)
+	r.NoError(sm.WriteView(_protocolID, v))
+
+	// no key is registered
+	r.NoError(snapshotBLSKeys(sm, 1))
+	cands, err := BLSKeysByEpoch(sm, 2)
+	r.NoError(err)
+	r.Empty(cands)
+
+	csm, err := NewCandidateStateManager(sm)
+	r.NoError(err)
+	sk, err := bls.GenerateKey()
+	r.NoError(err)
+	withKey := testCandidates[0].d.Clone()
+	withKey.BLSPubKey = sk.PublicKey().Bytes()
+	r.NoError(csm.Upsert(withKey))
+	r.NoError(csm.Upsert(testCandidates[1].d))
+	r.NoError(csm.Commit(ctx))
+
+	// the keys at the start of epoch 2 are those of epoch 3
+	r.NoError(snapshotBLSKeys(sm, 2))
+	for _, e := range []uint64{1, 2} {
+		cands, err = BLSKeysByEpoch(sm, e)
+		r.NoError(err)
+		r.Empty(cands)
+	}
+	cands, err = BLSKeysByEpoch(sm, 3)
+	r.NoError(err)
+	r.Equal(CandidateList{withKey}, cands)
+
+	// the snapshot of the previous epoch is deleted
+	r.NoError(snapshotBLSKeys(sm, 3))
+	for _, e := range []uint64{3, 4} {
+		cands, err = BLSKeysByEpoch(sm, e)
+		r.NoError(err)
+		r.Equal(CandidateList{withKey}, cands)
+	}
+	r.NoError(snapshotBLSKeys(sm, 4))
+	cands, err = BLSKeysByEpoch(sm, 3)
+	r.NoError(err)
+	r.Empty(cands)
+}
@@ -6,6 +6,7 @@
 package staking
 
 import (
+	"bytes"
 	"math/big"
 	"sort"
 	"strings"
@@ -31,6 +32,9 @@ type (
 		Votes              *big.Int
 		SelfStakeBucketIdx uint64
 		SelfStake          *big.Int
+		// BLSPubKey is the BLS public key the delegate endorses blocks with, its proof of possession is verified
+		// when it is registered
+		BLSPubKey []byte
 	}
 
 	// CandidateList is a list of candidates which is sortable
@@ -54,6 +58,7 @@ func (d *Candidate) Clone() *Candidate {
 		Votes:              new(big.Int).Set(d.Votes),
 		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
 		SelfStake:          new(big.Int).Set(d.SelfStake),
+		BLSPubKey:          append([]byte(nil), d.BLSPubKey...),
 	}
 }
 
@@ -66,7 +71,8 @@ func (d *Candidate) Equal(c *Candidate) bool {
 		address.Equal(d.Reward, c.Reward) &&
 		address.Equal(d.Identifier, c.Identifier) &&
 		d.Votes.Cmp(c.Votes) == 0 &&
-		d.SelfStake.Cmp(c.SelfStake) == 0
+		d.SelfStake.Cmp(c.SelfStake) == 0 &&
+		bytes.Equal(d.BLSPubKey, c.BLSPubKey)
 }
 
 // Validate does the sanity check
@@ -212,6 +218,7 @@ func (d *Candidate) toProto() (*stakingpb.Candidate, error) {
 		Votes:              d.Votes.String(),
 		SelfStakeBucketIdx: d.SelfStakeBucketIdx,
 		SelfStake:          d.SelfStake.String(),
+		BlsPubKey:          d.BLSPubKey,
 	}, nil
 }
 
@@ -255,6 +262,9 @@ func (d *Candidate) fromProto(pb *stakingpb.Candidate) error {
 	if !ok {
 		return action.ErrInvalidAmount
 	}
+	if len(pb.GetBlsPubKey()) > 0 {
+		d.BLSPubKey = append([]byte(nil), pb.GetBlsPubKey()...)
+	}
 	return nil
 }
 
@@ -253,3 +253,62 @@ func getFromIndexer(kv db.KVStoreForRangeIndex, ns string, height uint64) ([]byt
 		return nil, err
 	}
 }
+
+// Rewind deletes the candidates and buckets stored above the height, the
+// latest height and hash fall back to the data at the height
+func (cbi *CandidatesBucketsIndexer) Rewind(_ context.Context, height uint64) error {
+	b := batch.NewBatch()
+	heightBytes := byteutil.Uint64ToBytesBigEndian(height)
+	latest := make(map[string]hash.Hash160)
+	for _, v := range []struct {
+		ns        string
+		tip       uint64
+		heightKey []byte
+		hashKey   []byte
+	}{
+		{StakingCandidatesNamespace, cbi.latestCandidatesHeight, _candHeightKey, _latestCandidatesHash},
+		{StakingBucketsNamespace, cbi.latestBucketsHeight, _bucketHeightKey, _latestBucketsHash},
+	} {
+		if v.tip <= height {
+			continue
+		}
+		keys, _, err := cbi.kvStore.Filter(v.ns, func(k, _ []byte) bool {
+			return len(k) == 8 && byteutil.BytesToUint64BigEndian(k) > height
+		}, heightBytes, nil)
+		switch errors.Cause(err) {
+		case nil, db.ErrNotExist, db.ErrBucketNotExist:
+		default:
+			return err
+		}
+		for _, k := range keys {
+			b.Delete(v.ns, k, "failed to delete data above rewind height")
+		}
+		h := hash.ZeroHash160
+		data, err := getFromIndexer(cbi.kvStore, v.ns, height)
+		switch errors.Cause(err) {
+		case nil:
+			if len(data) > 0 {
+				h = hash.Hash160b(data)
+			}
+		case db.ErrNotExist, db.ErrBucketNotExist:
+		default:
+			return err
+		}
+		b.Put(StakingMetaNamespace, v.heightKey, heightBytes, "failed to update indexer height")
+		b.Put(StakingMetaNamespace, v.hashKey, h[:], "failed to update latest hash")
+		latest[v.ns] = h
+	}
+	if b.Size() == 0 {
+		return nil
+	}
+	if err := cbi.kvStore.WriteBatch(b); err != nil {
+		return err
+	}
+	if h, ok := latest[StakingCandidatesNamespace]; ok {
+		cbi.latestCandidatesHeight, cbi.latestCandidatesHash = height, h
+	}
+	if h, ok := latest[StakingBucketsNamespace]; ok {
+		cbi.latestBucketsHeight, cbi.latestBucketsHash = height, h
+	}
+	return nil
+}
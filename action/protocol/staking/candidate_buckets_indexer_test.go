@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/v2/testutil"
 )
 
@@ -278,3 +280,54 @@ func TestCandidatesBucketsIndexer_PutGetBuckets(t *testing.T) {
 	require.Equal(height, cbi.latestBucketsHeight)
 	require.Equal(lbHash, cbi.latestBucketsHash)
 }
+
+func TestCandidatesBucketsIndexer_Rewind(t *testing.T) {
+	require := require.New(t)
+
+	testPath, err := testutil.PathOfTempFile("test-rewind")
+	require.NoError(err)
+	defer testutil.CleanupPath(testPath)
+
+	cfg := db.DefaultConfig
+	cfg.DbPath = testPath
+	cbi, err := NewStakingCandidatesBucketsIndexer(db.NewBoltDB(cfg))
+	require.NoError(err)
+	ctx := context.Background()
+	require.NoError(cbi.Start(ctx))
+	defer func() {
+		require.NoError(cbi.Stop(ctx))
+	}()
+
+	cand := []*iotextypes.CandidateListV2{
+		{Candidates: []*iotextypes.CandidateV2{{OwnerAddress: "owner1", Name: "abc", Id: "owner1"}}},
+		{Candidates: []*iotextypes.CandidateV2{{OwnerAddress: "owner2", Name: "xyz", Id: "owner2"}}},
+	}
+	bucket := &iotextypes.VoteBucketList{Buckets: []*iotextypes.VoteBucket{{Index: 1, Owner: "abc"}}}
+	for i, v := range []*iotextypes.CandidateListV2{cand[0], cand[0], cand[1], cand[1]} {
+		require.NoError(cbi.PutCandidates(uint64(i+1), v))
+	}
+	require.NoError(cbi.PutBuckets(1, bucket))
+	hash1 := cbi.latestBucketsHash
+
+	// buckets are not above the height
+	require.NoError(cbi.Rewind(ctx, 2))
+	require.EqualValues(2, cbi.latestCandidatesHeight)
+	require.EqualValues(1, cbi.latestBucketsHeight)
+	require.Equal(hash1, cbi.latestBucketsHash)
+	r, height, err := cbi.GetCandidates(4, 0, 1)
+	require.NoError(err)
+	require.EqualValues(2, height)
+	require.Equal("owner1", r.Candidates[0].OwnerAddress)
+	_, err = cbi.kvStore.Get(StakingCandidatesNamespace, byteutil.Uint64ToBytesBigEndian(3))
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+
+	// the latest hash is restored, a new list is written
+	require.NoError(cbi.Stop(ctx))
+	require.NoError(cbi.Start(ctx))
+	require.EqualValues(2, cbi.latestCandidatesHeight)
+	require.NoError(cbi.PutCandidates(3, cand[1]))
+	r, height, err = cbi.GetCandidates(3, 0, 1)
+	require.NoError(err)
+	require.EqualValues(3, height)
+	require.Equal("owner2", r.Candidates[0].OwnerAddress)
+}
@@ -6,6 +6,7 @@
 package staking
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"time"
@@ -851,6 +852,19 @@ func (p *Protocol) handleCandidateUpdate(ctx context.Context, act *action.Candid
 	if act.RewardAddress() != nil {
 		c.Reward = act.RewardAddress()
 	}
+
+	if len(act.BLSPubKey()) != 0 {
+		// a BLS key is registered by one candidate only, so that no vote is counted twice in an aggregate endorsement
+		for _, d := range csm.DirtyView().candCenter.All() {
+			if !address.Equal(d.GetIdentifier(), c.GetIdentifier()) && bytes.Equal(d.BLSPubKey, act.BLSPubKey()) {
+				return log, &handleError{
+					err:           errors.New("BLS public key already registered by another candidate"),
+					failureStatus: iotextypes.ReceiptStatus_ErrCandidateConflict,
+				}
+			}
+		}
+		c.BLSPubKey = act.BLSPubKey()
+	}
 	log.AddTopics(c.GetIdentifier().Bytes())
 
 	if err := csm.Upsert(c); err != nil {
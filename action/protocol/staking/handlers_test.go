@@ -28,6 +28,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/pkg/unit"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/assertions"
 	"github.com/iotexproject/iotex-core/v2/state"
@@ -1001,6 +1002,79 @@ func TestProtocol_HandleCandidateUpdate(t *testing.T) {
 	}
 }
 
+func TestProtocol_HandleCandidateUpdateBLS(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm, p, candidate, candidate2 := initAll(t, ctrl)
+	sk, err := bls.GenerateKey()
+	require.NoError(err)
+	const chainID = 1
+	pop, err := sk.ProofOfPossession(action.BLSProofMessage(candidate.Owner, chainID))
+	require.NoError(err)
+
+	g := genesis.TestDefault()
+	g.BLSEndorsementBlockHeight = 2
+	handle := func(height uint64, owner address.Address, nonce uint64, pk, proof []byte) (*action.Receipt, error) {
+		require.NoError(setupAccount(sm, owner, 1000))
+		cu, err := action.NewCandidateUpdateWithBLS("", "", "", pk, proof)
+		require.NoError(err)
+		intrinsic, err := cu.IntrinsicGas()
+		require.NoError(err)
+		elp := builder.SetNonce(nonce).SetGasLimit(intrinsic).SetGasPrice(big.NewInt(0)).SetAction(cu).Build()
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       owner,
+			GasPrice:     big.NewInt(0),
+			IntrinsicGas: intrinsic,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: time.Now(),
+			GasLimit:       1000000,
+		})
+		ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Tip: protocol.TipInfo{}, ChainID: chainID})
+		ctx = genesis.WithGenesisContext(ctx, g)
+		ctx = protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+		if err := p.Validate(ctx, elp, sm); err != nil {
+			return nil, err
+		}
+		return p.Handle(ctx, elp, sm)
+	}
+
+	// the registration is disabled before the activation height
+	_, err = handle(1, candidate.Owner, 1, sk.PublicKey().Bytes(), pop.Bytes())
+	require.ErrorIs(err, action.ErrInvalidAct)
+	// the proof of possession must match the key
+	other, err := bls.GenerateKey()
+	require.NoError(err)
+	_, err = handle(2, candidate.Owner, 1, other.PublicKey().Bytes(), pop.Bytes())
+	require.ErrorIs(err, action.ErrInvalidBLSKey)
+
+	r, err := handle(2, candidate.Owner, 1, sk.PublicKey().Bytes(), pop.Bytes())
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Success, r.Status)
+	csm, err := NewCandidateStateManager(sm)
+	require.NoError(err)
+	require.Equal(sk.PublicKey().Bytes(), csm.GetByOwner(candidate.Owner).BLSPubKey)
+
+	// the proof of a candidate cannot be replayed by another
+	_, err = handle(2, candidate2.Owner, 1, sk.PublicKey().Bytes(), pop.Bytes())
+	require.ErrorIs(err, action.ErrInvalidBLSKey)
+	// and the key registered by a candidate cannot be registered by another
+	pop2, err := sk.ProofOfPossession(action.BLSProofMessage(candidate2.Owner, chainID))
+	require.NoError(err)
+	r, err = handle(2, candidate2.Owner, 1, sk.PublicKey().Bytes(), pop2.Bytes())
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_ErrCandidateConflict, r.Status)
+	csm, err = NewCandidateStateManager(sm)
+	require.NoError(err)
+	require.Empty(csm.GetByOwner(candidate2.Owner).BLSPubKey)
+	// but can be registered again by the same candidate
+	r, err = handle(2, candidate.Owner, 2, sk.PublicKey().Bytes(), pop.Bytes())
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Success, r.Status)
+}
+
 func TestProtocol_HandleUnstake(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
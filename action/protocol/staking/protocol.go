@@ -52,6 +52,7 @@ const (
 	_voterIndex
 	_candIndex
 	_endorsement
+	_blsKeys
 )
 
 // Errors
@@ -368,7 +369,7 @@ func (p *Protocol) CreatePreStates(ctx context.Context, sm protocol.StateManager
 		return err
 	}
 
-	if p.candBucketsIndexer == nil {
+	if p.candBucketsIndexer == nil && !featureCtx.EnableBLSEndorsement {
 		return nil
 	}
 	rp := rolldpos.FindProtocol(protocol.MustGetRegistry(ctx))
@@ -380,7 +381,15 @@ func (p *Protocol) CreatePreStates(ctx context.Context, sm protocol.StateManager
 		return nil
 	}
 	epochStartHeight := rp.GetEpochHeight(currentEpochNum)
-	if epochStartHeight != blkCtx.BlockHeight || featureCtx.SkipStakingIndexer {
+	if epochStartHeight != blkCtx.BlockHeight {
+		return nil
+	}
+	if featureCtx.EnableBLSEndorsement {
+		if err := snapshotBLSKeys(sm, currentEpochNum); err != nil {
+			return err
+		}
+	}
+	if p.candBucketsIndexer == nil || featureCtx.SkipStakingIndexer {
 		return nil
 	}
 	return p.handleStakingIndexer(ctx, rp.GetEpochHeight(currentEpochNum-1), sm)
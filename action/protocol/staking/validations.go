@@ -96,6 +96,15 @@ func (p *Protocol) validateCandidateUpdate(ctx context.Context, act *action.Cand
 			return action.ErrInvalidCanName
 		}
 	}
+	if len(act.BLSPubKey()) != 0 || len(act.BLSProof()) != 0 {
+		if !protocol.MustGetFeatureCtx(ctx).EnableBLSEndorsement {
+			return errors.Wrap(action.ErrInvalidAct, "BLS key registration is disabled")
+		}
+		// only the owner can update the candidate, so the key is bound to the caller
+		if err := act.VerifyBLSKey(protocol.MustGetActionCtx(ctx).Caller, protocol.MustGetBlockchainCtx(ctx).ChainID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -47,6 +47,33 @@ func (s *actionByPrice) Pop() interface{} {
 	return x
 }
 
+// LessFunc returns true if action a should be picked before action b
+type LessFunc func(a, b *action.SealedEnvelope) bool
+
+// actionByOrder is a big root heap of actions ordered by a LessFunc, actions
+// in the same order are sorted by hash
+type actionByOrder struct {
+	actionByPrice
+	less LessFunc
+}
+
+func (s *actionByOrder) Less(i, j int) bool {
+	if s.less == nil {
+		return s.actionByPrice.Less(i, j)
+	}
+	a, b := s.actionByPrice[i], s.actionByPrice[j]
+	switch {
+	case s.less(a, b):
+		return true
+	case s.less(b, a):
+		return false
+	default:
+		ha, _ := a.Hash()
+		hb, _ := b.Hash()
+		return bytes.Compare(ha[:], hb[:]) > 0
+	}
+}
+
 // ActionIterator define the interface of action iterator
 type ActionIterator interface {
 	Next() (*action.SealedEnvelope, bool)
@@ -55,11 +82,19 @@ type ActionIterator interface {
 
 type actionIterator struct {
 	accountActs map[string][]*action.SealedEnvelope
-	heads       actionByPrice
+	heads       *actionByOrder
 }
 
-// NewActionIterator return a new action iterator
+// NewActionIterator return a new action iterator, which picks the action with
+// the highest gas price among the first pending actions of all accounts
 func NewActionIterator(accountActs map[string][]*action.SealedEnvelope) ActionIterator {
+	return NewActionIteratorWithOrder(accountActs, nil)
+}
+
+// NewActionIteratorWithOrder return a new action iterator, which picks the
+// first action in the order of less among the first pending actions of all
+// accounts
+func NewActionIteratorWithOrder(accountActs map[string][]*action.SealedEnvelope, less LessFunc) ActionIterator {
 	heads := make(actionByPrice, 0, len(accountActs))
 	for sender, accActs := range accountActs {
 		if len(accActs) == 0 {
@@ -73,38 +108,39 @@ func NewActionIterator(accountActs map[string][]*action.SealedEnvelope) ActionIt
 			accountActs[sender] = []*action.SealedEnvelope{}
 		}
 	}
-	heap.Init(&heads)
+	h := &actionByOrder{actionByPrice: heads, less: less}
+	heap.Init(h)
 	return &actionIterator{
 		accountActs: accountActs,
-		heads:       heads,
+		heads:       h,
 	}
 }
 
 // loadNextActionForTopAccount load next action of account of top action
 func (ai *actionIterator) loadNextActionForTopAccount() {
-	callerAddrStr := ai.heads[0].SenderAddress().String()
+	callerAddrStr := ai.heads.actionByPrice[0].SenderAddress().String()
 	if actions, ok := ai.accountActs[callerAddrStr]; ok && len(actions) > 0 {
-		ai.heads[0], ai.accountActs[callerAddrStr] = actions[0], actions[1:]
-		heap.Fix(&ai.heads, 0)
+		ai.heads.actionByPrice[0], ai.accountActs[callerAddrStr] = actions[0], actions[1:]
+		heap.Fix(ai.heads, 0)
 	} else {
-		heap.Pop(&ai.heads)
+		heap.Pop(ai.heads)
 	}
 }
 
 // Next load next action of account of top action
 func (ai *actionIterator) Next() (*action.SealedEnvelope, bool) {
-	if len(ai.heads) == 0 {
+	if ai.heads.Len() == 0 {
 		return nil, false
 	}
 
-	headAction := ai.heads[0]
+	headAction := ai.heads.actionByPrice[0]
 	ai.loadNextActionForTopAccount()
 	return headAction, true
 }
 
 // PopAccount will remove all actions related to this account
 func (ai *actionIterator) PopAccount() {
-	if len(ai.heads) != 0 {
-		heap.Pop(&ai.heads)
+	if ai.heads.Len() != 0 {
+		heap.Pop(ai.heads)
 	}
 }
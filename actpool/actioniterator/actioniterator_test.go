@@ -82,6 +82,47 @@ func TestActionIterator(t *testing.T) {
 	require.Equal(appliedActionList, []*action.SealedEnvelope{selp3, selp1, selp2, selp4, selp5, selp6})
 }
 
+func TestActionIteratorWithOrder(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		accMap = make(map[string][]*action.SealedEnvelope)
+		acts   = make([]*action.SealedEnvelope, 0)
+	)
+	// lower nonce first, the gas price does not matter
+	for i, v := range []struct {
+		sender int
+		nonce  uint64
+		price  int64
+	}{
+		{28, 3, 10},
+		{28, 4, 20},
+		{29, 1, 1},
+		{29, 5, 100},
+		{30, 2, 5},
+	} {
+		elp := (&action.EnvelopeBuilder{}).SetNonce(v.nonce).SetGasPrice(big.NewInt(v.price)).
+			SetAction(action.NewTransfer(big.NewInt(int64(i)), identityset.Address(0).String(), nil)).Build()
+		selp, err := action.Sign(elp, identityset.PrivateKey(v.sender))
+		require.NoError(err)
+		sender := identityset.Address(v.sender).String()
+		accMap[sender] = append(accMap[sender], selp)
+		acts = append(acts, selp)
+	}
+	ai := NewActionIteratorWithOrder(accMap, func(a, b *action.SealedEnvelope) bool {
+		return a.Nonce() < b.Nonce()
+	})
+	picked := make([]*action.SealedEnvelope, 0)
+	for {
+		act, ok := ai.Next()
+		if !ok {
+			break
+		}
+		picked = append(picked, act)
+	}
+	require.Equal([]*action.SealedEnvelope{acts[2], acts[4], acts[0], acts[1], acts[3]}, picked)
+}
+
 func TestActionByPrice(t *testing.T) {
 	require := require.New(t)
 
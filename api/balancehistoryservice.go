@@ -0,0 +1,99 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory/balancehistorypb"
+)
+
+type balanceHistoryService struct {
+	core CoreService
+}
+
+func newBalanceHistoryService(core CoreService) *balanceHistoryService {
+	return &balanceHistoryService{
+		core: core,
+	}
+}
+
+func (service *balanceHistoryService) GetBalanceChanges(_ context.Context, request *balancehistorypb.GetBalanceChangesRequest) (*balancehistorypb.GetBalanceChangesResponse, error) {
+	addr, err := address.FromString(request.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	changes, total, err := service.core.BalanceChanges(addr, request.Offset, request.Limit)
+	if err != nil {
+		return nil, balanceHistoryError(err)
+	}
+	resp := &balancehistorypb.GetBalanceChangesResponse{
+		Total:   total,
+		Changes: make([]*balancehistorypb.BalanceChangeInfo, 0, len(changes)),
+	}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &balancehistorypb.BalanceChangeInfo{
+			Height:     c.Height,
+			Timestamp:  c.Timestamp,
+			ActionHash: hex.EncodeToString(c.ActionHash[:]),
+			Amount:     c.Amount.String(),
+			Type:       c.Type,
+		})
+	}
+	return resp, nil
+}
+
+func (service *balanceHistoryService) GetBalanceAt(_ context.Context, request *balancehistorypb.GetBalanceAtRequest) (*balancehistorypb.GetBalanceAtResponse, error) {
+	addr, err := address.FromString(request.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	height := request.Height
+	if request.Timestamp != 0 {
+		if height, _, err = service.core.HeightByTimestamp(request.Timestamp); err != nil {
+			return nil, balanceHistoryError(err)
+		}
+	}
+	balance, err := service.core.BalanceAt(addr, height)
+	if err != nil {
+		return nil, balanceHistoryError(err)
+	}
+	return &balancehistorypb.GetBalanceAtResponse{
+		Height:  height,
+		Balance: balance.String(),
+	}, nil
+}
+
+func (service *balanceHistoryService) GetHeightByTimestamp(_ context.Context, request *balancehistorypb.GetHeightByTimestampRequest) (*balancehistorypb.GetHeightByTimestampResponse, error) {
+	height, timestamp, err := service.core.HeightByTimestamp(request.Timestamp)
+	if err != nil {
+		return nil, balanceHistoryError(err)
+	}
+	return &balancehistorypb.GetHeightByTimestampResponse{
+		Height:    height,
+		Timestamp: timestamp,
+	}, nil
+}
+
+func balanceHistoryError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch errors.Cause(err) {
+	case ErrNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrBalanceHistoryNotSupported:
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
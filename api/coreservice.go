@@ -59,7 +59,10 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/blockindex"
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 	"github.com/iotexproject/iotex-core/v2/blocksync"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/gasstation"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
@@ -83,6 +86,8 @@ type (
 	// CoreService provides api interface for user to interact with blockchain data
 	CoreService interface {
 		WithHeight(uint64) CoreServiceReaderWithHeight
+		// Pending returns the state reader of the pending block
+		Pending() CoreServiceReaderWithHeight
 		// Account returns the metadata of an account
 		Account(addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error)
 		// ChainMeta returns blockchain metadata
@@ -190,6 +195,22 @@ type (
 		Track(ctx context.Context, start time.Time, method string, size int64, success bool)
 		// BlobSidecarsByHeight returns blob sidecars by height
 		BlobSidecarsByHeight(height uint64) ([]*apitypes.BlobSidecarResult, error)
+		// StateDiff returns the state changes of the block at the height
+		StateDiff(height uint64) (*statediff.BlockDiff, error)
+		// BalanceChanges returns the balance changes of the address in [start, start+count), and the total number of them
+		BalanceChanges(addr address.Address, start, count uint64) ([]*balancehistory.BalanceChange, uint64, error)
+		// BalanceAt returns the balance of the address at the height
+		BalanceAt(addr address.Address, height uint64) (*big.Int, error)
+		// HeightByTimestamp returns the height and timestamp of the last block produced at or before the timestamp
+		HeightByTimestamp(timestamp int64) (uint64, int64, error)
+		// FinalizedHeight returns the height of the last block whose endorsements reach consensus finality,
+		// which is the tip since a block is committed only after its endorsements are validated
+		FinalizedHeight() (uint64, error)
+		// PendingBlock returns the unsigned block built on top of the tip by running the actions in the actpool
+		PendingBlock() (*block.Block, error)
+		// Evidences returns up to count equivocation evidences of the delegates at or above the height, of the
+		// offender if it is not empty
+		Evidences(height, count uint64, offender string) ([]*evidencepb.Evidence, error)
 	}
 
 	// coreService implements the CoreService interface
@@ -211,6 +232,10 @@ type (
 		readCache         *ReadCache
 		actionRadio       *ActionRadio
 		apiStats          *nodestats.APILocalStats
+		stateDiffIndexer  *statediff.Indexer
+		balanceIndexer    *balancehistory.Indexer
+		evidenceReader    EvidenceReader
+		pending           *pendingBlockBuilder
 	}
 
 	// jobDesc provides a struct to get and store logs in core.LogsInRange
@@ -254,13 +279,39 @@ func WithArchiveSupport() Option {
 	}
 }
 
+// WithStateDiffIndexer is the option to return the state changes of blocks through API
+func WithStateDiffIndexer(indexer *statediff.Indexer) Option {
+	return func(svr *coreService) {
+		svr.stateDiffIndexer = indexer
+	}
+}
+
+// WithBalanceHistoryIndexer is the option to return the balance history of accounts through API
+func WithBalanceHistoryIndexer(indexer *balancehistory.Indexer) Option {
+	return func(svr *coreService) {
+		svr.balanceIndexer = indexer
+	}
+}
+
+// WithEvidenceReader is the option to return the equivocation evidences detected by the consensus through API
+func WithEvidenceReader(reader EvidenceReader) Option {
+	return func(svr *coreService) {
+		svr.evidenceReader = reader
+	}
+}
+
 type intrinsicGasCalculator interface {
 	IntrinsicGas() (uint64, error)
 }
 
 var (
-	ErrNotFound            = errors.New("not found")
-	ErrArchiveNotSupported = errors.New("archive-mode not supported")
+	ErrNotFound              = errors.New("not found")
+	ErrArchiveNotSupported   = errors.New("archive-mode not supported")
+	ErrStateDiffNotSupported = errors.New("state diff not supported")
+	// ErrBalanceHistoryNotSupported indicates the balance history is not indexed
+	ErrBalanceHistoryNotSupported = errors.New("balance history not supported")
+	// ErrEvidenceNotSupported indicates the consensus does not detect equivocations
+	ErrEvidenceNotSupported = errors.New("equivocation evidence not supported")
 )
 
 // newCoreService creates a api server that contains major blockchain components
@@ -298,6 +349,7 @@ func newCoreService(
 		chainListener: NewChainListener(cfg.ListenerLimit),
 		gs:            gasstation.NewGasStation(chain, dao, cfg.GasStation),
 		readCache:     NewReadCache(),
+		pending:       newPendingBlockBuilder(chain, sf, actPool),
 	}
 
 	for _, opt := range opts {
@@ -316,6 +368,11 @@ func (core *coreService) WithHeight(height uint64) CoreServiceReaderWithHeight {
 	return newCoreServiceWithHeight(core, height)
 }
 
+// Pending returns the state reader of the pending block
+func (core *coreService) Pending() CoreServiceReaderWithHeight {
+	return newCoreServiceReaderPending(core)
+}
+
 // Account returns the metadata of an account
 func (core *coreService) Account(addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error) {
 	ctx, span := tracer.NewSpan(context.Background(), "coreService.Account")
@@ -339,14 +396,34 @@ func (core *coreService) Account(addr address.Address) (*iotextypes.AccountMeta,
 }
 
 func (core *coreService) acccount(ctx context.Context, height uint64, state *state.Account, pendingNonce uint64, addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error) {
+	accountMeta, err := core.accountMeta(ctx, core.sf, state, pendingNonce, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	span := tracer.SpanFromContext(ctx)
+	span.AddEvent("bc.BlockHeaderByHeight")
+	header, err := core.bc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	hash := header.HashBlock()
+	span.AddEvent("coreService.Account.End")
+	return accountMeta, &iotextypes.BlockIdentifier{
+		Hash:   hex.EncodeToString(hash[:]),
+		Height: height,
+	}, nil
+}
+
+// accountMeta returns the account meta, reading the contract code from the state reader
+func (core *coreService) accountMeta(ctx context.Context, sr protocol.StateReader, state *state.Account, pendingNonce uint64, addr address.Address) (*iotextypes.AccountMeta, error) {
 	if core.indexer == nil {
-		return nil, nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
 	}
 	span := tracer.SpanFromContext(ctx)
 	span.AddEvent("indexer.GetActionCount")
 	numActions, err := core.indexer.GetActionCountByAddress(hash.BytesToHash160(addr.Bytes()))
 	if err != nil {
-		return nil, nil, status.Error(codes.NotFound, err.Error())
+		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	// TODO: deprecate nonce field in account meta
 	accountMeta := &iotextypes.AccountMeta{
@@ -358,23 +435,13 @@ func (core *coreService) acccount(ctx context.Context, height uint64, state *sta
 	}
 	if state.IsContract() {
 		var code protocol.SerializableBytes
-		_, err = core.sf.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(state.CodeHash))
+		_, err = sr.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(state.CodeHash))
 		if err != nil {
-			return nil, nil, status.Error(codes.NotFound, err.Error())
+			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		accountMeta.ContractByteCode = code
 	}
-	span.AddEvent("bc.BlockHeaderByHeight")
-	header, err := core.bc.BlockHeaderByHeight(height)
-	if err != nil {
-		return nil, nil, status.Error(codes.NotFound, err.Error())
-	}
-	hash := header.HashBlock()
-	span.AddEvent("coreService.Account.End")
-	return accountMeta, &iotextypes.BlockIdentifier{
-		Hash:   hex.EncodeToString(hash[:]),
-		Height: height,
-	}, nil
+	return accountMeta, nil
 }
 
 // ChainMeta returns blockchain metadata
@@ -1293,6 +1360,101 @@ func (core *coreService) BlobSidecarsByHeight(height uint64) ([]*apitypes.BlobSi
 	return res, nil
 }
 
+func (core *coreService) StateDiff(height uint64) (*statediff.BlockDiff, error) {
+	if core.stateDiffIndexer == nil {
+		return nil, ErrStateDiffNotSupported
+	}
+	diff, err := core.stateDiffIndexer.StateDiff(height)
+	switch errors.Cause(err) {
+	case nil:
+		return diff, nil
+	case statediff.ErrNotExist:
+		return nil, errors.Wrapf(ErrNotFound, "failed to find state diff at height %d", height)
+	default:
+		return nil, err
+	}
+}
+
+func (core *coreService) BalanceChanges(addr address.Address, start, count uint64) ([]*balancehistory.BalanceChange, uint64, error) {
+	if core.balanceIndexer == nil {
+		return nil, 0, ErrBalanceHistoryNotSupported
+	}
+	if count == 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > core.cfg.RangeQueryLimit {
+		return nil, 0, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	changes, total, err := core.balanceIndexer.BalanceChanges(addr, start, count)
+	switch errors.Cause(err) {
+	case nil:
+		return changes, total, nil
+	case db.ErrInvalid:
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, 0, err
+	}
+}
+
+func (core *coreService) BalanceAt(addr address.Address, height uint64) (*big.Int, error) {
+	if core.balanceIndexer == nil {
+		return nil, ErrBalanceHistoryNotSupported
+	}
+	if address.IsAddrV1Special(addr.String()) {
+		return nil, status.Error(codes.InvalidArgument, "balance history of protocol pool is not indexed")
+	}
+	ctx := genesis.WithGenesisContext(context.Background(), core.bc.Genesis())
+	state, tipHeight, err := accountutil.AccountStateWithHeight(ctx, core.sf, addr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if height > tipHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is higher than the tip height %d", height, tipHeight)
+	}
+	// the balance at the height is the current balance minus the changes after the height
+	tipTotal, err := core.balanceIndexer.TotalAt(addr, tipHeight)
+	if err != nil {
+		if errors.Cause(err) == balancehistory.ErrNotExist {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, err
+	}
+	total, err := core.balanceIndexer.TotalAt(addr, height)
+	if err != nil {
+		return nil, err
+	}
+	balance := new(big.Int).Sub(state.Balance, tipTotal)
+	return balance.Add(balance, total), nil
+}
+
+func (core *coreService) HeightByTimestamp(timestamp int64) (uint64, int64, error) {
+	if core.balanceIndexer == nil {
+		return 0, 0, ErrBalanceHistoryNotSupported
+	}
+	height, err := core.balanceIndexer.HeightByTimestamp(timestamp)
+	if err == nil {
+		timestamp, err = core.balanceIndexer.BlockTimestamp(height)
+	}
+	switch errors.Cause(err) {
+	case nil:
+		return height, timestamp, nil
+	case balancehistory.ErrNotExist:
+		return 0, 0, errors.Wrapf(ErrNotFound, "failed to find block at timestamp %d", timestamp)
+	default:
+		return 0, 0, err
+	}
+}
+
+func (core *coreService) FinalizedHeight() (uint64, error) {
+	// the footer of a block is validated before it is committed, so every block up to the tip is final
+	return core.bc.TipHeight(), nil
+}
+
+func (core *coreService) PendingBlock() (*block.Block, error) {
+	blk, _, err := core.pending.PendingBlock()
+	return blk, err
+}
+
 func (core *coreService) getBlobSidecars(height uint64) ([]*types.BlobTxSidecar, []hash.Hash256, error) {
 	blobs, txHashStr, err := core.dao.GetBlobsByHeight(height)
 	switch errors.Cause(err) {
@@ -2003,6 +2165,17 @@ func (core *coreService) simulateExecution(
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
+	return core.simulateExecutionOn(ctx, ws, height, addr, elp, opts...)
+}
+
+// simulateExecutionOn simulates the execution on the working set, as if it were in the block at the height
+func (core *coreService) simulateExecutionOn(
+	ctx context.Context,
+	ws protocol.StateManager,
+	height uint64,
+	addr address.Address,
+	elp action.Envelope,
+	opts ...protocol.SimulateOption) ([]byte, *action.Receipt, error) {
 	state, err := accountutil.AccountState(ctx, ws, addr)
 	if err != nil {
 		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
@@ -2038,3 +2211,21 @@ func filterReceipts(receipts []*action.Receipt, actHash hash.Hash256) *action.Re
 	}
 	return nil
 }
+
+func (core *coreService) Evidences(height, count uint64, offender string) ([]*evidencepb.Evidence, error) {
+	if core.evidenceReader == nil {
+		return nil, ErrEvidenceNotSupported
+	}
+	if count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > core.cfg.RangeQueryLimit {
+		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	if offender != "" {
+		if _, err := address.FromString(offender); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return core.evidenceReader.Evidences(height, count, offender), nil
+}
@@ -1194,3 +1194,84 @@ func TestTraceTx(t *testing.T) {
 		require.Empty(tracer)
 	})
 }
+
+func TestFinalizedHeight(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bc   = mock_blockchain.NewMockBlockchain(ctrl)
+		core = &coreService{bc: bc}
+	)
+	// the committed blocks are final, no block is read to find the finalized one
+	for _, tip := range []uint64{10, 12, 3} {
+		bc.EXPECT().TipHeight().Return(tip).Times(1)
+		height, err := core.FinalizedHeight()
+		require.NoError(err)
+		require.Equal(tip, height)
+	}
+}
+
+func TestPendingBlock(t *testing.T) {
+	require := require.New(t)
+	svr, bc, _, ap, cleanCallback := setupTestCoreService()
+	defer cleanCallback()
+	core := svr.(*coreService)
+	ctx := context.Background()
+	var (
+		sender    = identityset.Address(27)
+		recipient = identityset.Address(31)
+		amount    = big.NewInt(100)
+	)
+	senderMeta, _, err := core.Account(sender)
+	require.NoError(err)
+	recipientMeta, _, err := core.Account(recipient)
+	require.NoError(err)
+	tsf, err := action.SignedTransfer(recipient.String(), identityset.PrivateKey(27), senderMeta.PendingNonce, amount, nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf))
+
+	tipHeight := bc.TipHeight()
+	blk, err := core.PendingBlock()
+	require.NoError(err)
+	require.Equal(tipHeight+1, blk.Height())
+	require.Equal(tipHeight, bc.TipHeight())
+	tip, err := bc.BlockHeaderByHeight(tipHeight)
+	require.NoError(err)
+	require.Equal(tip.HashBlock(), blk.PrevHash())
+	// the pending block is not signed
+	require.Nil(blk.PublicKey())
+	// the actions are executed
+	require.Equal(tsf, blk.Actions[0])
+	require.Len(blk.Receipts, len(blk.Actions))
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), blk.Receipts[0].Status)
+	require.Equal(block.CalculateReceiptRoot(blk.Receipts), blk.ReceiptRoot())
+
+	// the pending state reflects the executed actions, the latest state does not
+	pending := core.Pending()
+	meta, identifier, err := pending.Account(recipient)
+	require.NoError(err)
+	require.Equal(blk.Height(), identifier.Height)
+	balance, ok := new(big.Int).SetString(recipientMeta.Balance, 10)
+	require.True(ok)
+	require.Equal(new(big.Int).Add(balance, amount).String(), meta.Balance)
+	meta, _, err = core.Account(recipient)
+	require.NoError(err)
+	require.Equal(recipientMeta.Balance, meta.Balance)
+	meta, _, err = pending.Account(sender)
+	require.NoError(err)
+	require.Equal(senderMeta.PendingNonce+1, meta.PendingNonce)
+	// the calls run on a working set on top of the pending state
+	elp := (&action.EnvelopeBuilder{}).SetAction(action.NewExecution(recipient.String(), big.NewInt(0), nil)).
+		SetGasLimit(testutil.TestGasLimit).Build()
+	ret, receipt, err := pending.ReadContract(ctx, sender, elp)
+	require.NoError(err)
+	require.Empty(ret)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+
+	// the pending block is reused within the ttl
+	blk2, err := core.PendingBlock()
+	require.NoError(err)
+	require.Equal(blk, blk2)
+}
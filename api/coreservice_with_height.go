@@ -11,6 +11,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/v2/action"
 	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/tracer"
@@ -23,6 +24,7 @@ type (
 	CoreServiceReaderWithHeight interface {
 		Account(address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error)
 		ReadContract(context.Context, address.Address, action.Envelope) (string, *iotextypes.Receipt, error)
+		ReadContractStorage(context.Context, address.Address, []byte) ([]byte, error)
 	}
 
 	coreServiceReaderWithHeight struct {
@@ -92,3 +94,18 @@ func (core *coreServiceReaderWithHeight) ReadContract(ctx context.Context, calle
 	)
 	return core.cs.readContract(ctx, key, core.height, true, callerAddr, elp)
 }
+
+func (core *coreServiceReaderWithHeight) ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error) {
+	if !core.cs.archiveSupported {
+		return nil, ErrArchiveNotSupported
+	}
+	ctx, err := core.cs.bc.ContextAtHeight(ctx, core.height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ws, err := core.cs.sf.WorkingSetAtHeight(ctx, core.height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return evm.ReadContractStorage(ctx, ws, addr, key)
+}
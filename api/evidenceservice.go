@@ -0,0 +1,51 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+)
+
+type (
+	// EvidenceReader reads the equivocation evidences of the delegates detected by the consensus
+	EvidenceReader interface {
+		Evidences(height, count uint64, offender string) []*evidencepb.Evidence
+	}
+
+	evidenceService struct {
+		core CoreService
+	}
+)
+
+func newEvidenceService(core CoreService) *evidenceService {
+	return &evidenceService{
+		core: core,
+	}
+}
+
+func (service *evidenceService) GetEvidences(_ context.Context, request *evidencepb.GetEvidencesRequest) (*evidencepb.GetEvidencesResponse, error) {
+	evidences, err := service.core.Evidences(request.StartHeight, request.Count, request.Offender)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		switch errors.Cause(err) {
+		case ErrEvidenceNotSupported:
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &evidencepb.GetEvidencesResponse{
+		Evidences: evidences,
+	}, nil
+}
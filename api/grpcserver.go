@@ -42,6 +42,9 @@ import (
 	apitypes "github.com/iotexproject/iotex-core/v2/api/types"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao/blockdaopb"
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory/balancehistorypb"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff/statediffpb"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/recovery"
 	"github.com/iotexproject/iotex-core/v2/pkg/tracer"
@@ -108,6 +111,9 @@ func NewGRPCServer(core CoreService, bds *blockDAOService, grpcPort int) *GRPCSe
 	if bds != nil {
 		blockdaopb.RegisterBlockDAOServiceServer(gSvr, bds)
 	}
+	statediffpb.RegisterStateDiffServiceServer(gSvr, newStateDiffService(core))
+	balancehistorypb.RegisterBalanceHistoryServiceServer(gSvr, newBalanceHistoryService(core))
+	evidencepb.RegisterEvidenceServiceServer(gSvr, newEvidenceService(core))
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(gSvr)
 	reflection.Register(gSvr)
@@ -20,6 +20,9 @@ import (
 	types "github.com/iotexproject/iotex-core/v2/api/types"
 	block "github.com/iotexproject/iotex-core/v2/blockchain/block"
 	genesis "github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	balancehistory "github.com/iotexproject/iotex-core/v2/blockindex/balancehistory"
+	statediff "github.com/iotexproject/iotex-core/v2/blockindex/statediff"
+	evidencepb "github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
 	iotexapi "github.com/iotexproject/iotex-proto/golang/iotexapi"
 	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -140,6 +143,37 @@ func (mr *MockCoreServiceMockRecorder) ActionsInActPool(actHashes interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsInActPool", reflect.TypeOf((*MockCoreService)(nil).ActionsInActPool), actHashes)
 }
 
+// BalanceAt mocks base method.
+func (m *MockCoreService) BalanceAt(addr address.Address, height uint64) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", addr, height)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockCoreServiceMockRecorder) BalanceAt(addr, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockCoreService)(nil).BalanceAt), addr, height)
+}
+
+// BalanceChanges mocks base method.
+func (m *MockCoreService) BalanceChanges(addr address.Address, start, count uint64) ([]*balancehistory.BalanceChange, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceChanges", addr, start, count)
+	ret0, _ := ret[0].([]*balancehistory.BalanceChange)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BalanceChanges indicates an expected call of BalanceChanges.
+func (mr *MockCoreServiceMockRecorder) BalanceChanges(addr, start, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceChanges", reflect.TypeOf((*MockCoreService)(nil).BalanceChanges), addr, start, count)
+}
+
 // BlobSidecarsByHeight mocks base method.
 func (m *MockCoreService) BlobSidecarsByHeight(height uint64) ([]*types.BlobSidecarResult, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +406,21 @@ func (mr *MockCoreServiceMockRecorder) EstimateMigrateStakeGasConsumption(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateMigrateStakeGasConsumption", reflect.TypeOf((*MockCoreService)(nil).EstimateMigrateStakeGasConsumption), arg0, arg1, arg2)
 }
 
+// Evidences mocks base method.
+func (m *MockCoreService) Evidences(height, count uint64, offender string) ([]*evidencepb.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evidences", height, count, offender)
+	ret0, _ := ret[0].([]*evidencepb.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evidences indicates an expected call of Evidences.
+func (mr *MockCoreServiceMockRecorder) Evidences(height, count, offender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evidences", reflect.TypeOf((*MockCoreService)(nil).Evidences), height, count, offender)
+}
+
 // FeeHistory mocks base method.
 func (m *MockCoreService) FeeHistory(ctx context.Context, blocks, lastBlock uint64, rewardPercentiles []float64) (uint64, [][]*big.Int, []*big.Int, []float64, []*big.Int, []float64, error) {
 	m.ctrl.T.Helper()
@@ -392,6 +441,21 @@ func (mr *MockCoreServiceMockRecorder) FeeHistory(ctx, blocks, lastBlock, reward
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockCoreService)(nil).FeeHistory), ctx, blocks, lastBlock, rewardPercentiles)
 }
 
+// FinalizedHeight mocks base method.
+func (m *MockCoreService) FinalizedHeight() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizedHeight")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalizedHeight indicates an expected call of FinalizedHeight.
+func (mr *MockCoreServiceMockRecorder) FinalizedHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizedHeight", reflect.TypeOf((*MockCoreService)(nil).FinalizedHeight))
+}
+
 // Genesis mocks base method.
 func (m *MockCoreService) Genesis() genesis.Genesis {
 	m.ctrl.T.Helper()
@@ -406,6 +470,22 @@ func (mr *MockCoreServiceMockRecorder) Genesis() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Genesis", reflect.TypeOf((*MockCoreService)(nil).Genesis))
 }
 
+// HeightByTimestamp mocks base method.
+func (m *MockCoreService) HeightByTimestamp(timestamp int64) (uint64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeightByTimestamp", timestamp)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HeightByTimestamp indicates an expected call of HeightByTimestamp.
+func (mr *MockCoreServiceMockRecorder) HeightByTimestamp(timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeightByTimestamp", reflect.TypeOf((*MockCoreService)(nil).HeightByTimestamp), timestamp)
+}
+
 // LogsInBlockByHash mocks base method.
 func (m *MockCoreService) LogsInBlockByHash(filter *logfilter.LogFilter, blockHash hash.Hash256) ([]*action.Log, error) {
 	m.ctrl.T.Helper()
@@ -437,6 +517,20 @@ func (mr *MockCoreServiceMockRecorder) LogsInRange(filter, start, end, paginatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogsInRange", reflect.TypeOf((*MockCoreService)(nil).LogsInRange), filter, start, end, paginationSize)
 }
 
+// Pending mocks base method.
+func (m *MockCoreService) Pending() CoreServiceReaderWithHeight {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending")
+	ret0, _ := ret[0].(CoreServiceReaderWithHeight)
+	return ret0
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockCoreServiceMockRecorder) Pending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockCoreService)(nil).Pending))
+}
+
 // PendingActionByActionHash mocks base method.
 func (m *MockCoreService) PendingActionByActionHash(h hash.Hash256) (*action.SealedEnvelope, error) {
 	m.ctrl.T.Helper()
@@ -452,6 +546,21 @@ func (mr *MockCoreServiceMockRecorder) PendingActionByActionHash(h interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingActionByActionHash", reflect.TypeOf((*MockCoreService)(nil).PendingActionByActionHash), h)
 }
 
+// PendingBlock mocks base method.
+func (m *MockCoreService) PendingBlock() (*block.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingBlock")
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingBlock indicates an expected call of PendingBlock.
+func (mr *MockCoreServiceMockRecorder) PendingBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingBlock", reflect.TypeOf((*MockCoreService)(nil).PendingBlock))
+}
+
 // PendingNonce mocks base method.
 func (m *MockCoreService) PendingNonce(arg0 address.Address) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -620,6 +729,21 @@ func (mr *MockCoreServiceMockRecorder) Start(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCoreService)(nil).Start), ctx)
 }
 
+// StateDiff mocks base method.
+func (m *MockCoreService) StateDiff(height uint64) (*statediff.BlockDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateDiff", height)
+	ret0, _ := ret[0].(*statediff.BlockDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateDiff indicates an expected call of StateDiff.
+func (mr *MockCoreServiceMockRecorder) StateDiff(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateDiff", reflect.TypeOf((*MockCoreService)(nil).StateDiff), height)
+}
+
 // Stop mocks base method.
 func (m *MockCoreService) Stop(ctx context.Context) error {
 	m.ctrl.T.Helper()
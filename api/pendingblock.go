@@ -0,0 +1,183 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/v2/actpool"
+	"github.com/iotexproject/iotex-core/v2/blockchain"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/pkg/tracer"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+)
+
+// _pendingBlockTTL is how long a pending block is reused before it is rebuilt from the actpool
+const _pendingBlockTTL = time.Second
+
+type (
+	// pendingBlockBuilder builds the pending block on top of the tip by running the actions in the actpool on
+	// the factory working set, the way the minter does. The block has the receipts, roots and gas used of the
+	// executed actions, but it is not signed: the producer of the tip stands in for the producer of the
+	// pending block in the system actions, and the block has neither a producer nor a hash
+	pendingBlockBuilder struct {
+		mutex   sync.Mutex
+		bc      blockchain.Blockchain
+		sf      factory.Factory
+		ap      actpool.ActPool
+		blk     *block.Block
+		states  protocol.StateForker
+		builtAt time.Time
+	}
+
+	// coreServiceReaderPending reads the state after the pending block
+	coreServiceReaderPending struct {
+		cs *coreService
+	}
+)
+
+func newPendingBlockBuilder(bc blockchain.Blockchain, sf factory.Factory, ap actpool.ActPool) *pendingBlockBuilder {
+	return &pendingBlockBuilder{
+		bc: bc,
+		sf: sf,
+		ap: ap,
+	}
+}
+
+// PendingBlock returns the pending block and the state after it
+func (pb *pendingBlockBuilder) PendingBlock() (*block.Block, protocol.StateForker, error) {
+	pb.mutex.Lock()
+	defer pb.mutex.Unlock()
+	now := time.Now()
+	if pb.blk != nil && pb.blk.Height() == pb.bc.TipHeight()+1 && now.Sub(pb.builtAt) < _pendingBlockTTL {
+		return pb.blk, pb.states, nil
+	}
+	ctx, err := pb.bc.Context(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	tip := protocol.MustGetBlockchainCtx(ctx).Tip
+	if tip.Height == 0 {
+		return nil, nil, errors.Wrap(ErrNotFound, "no pending block on top of the genesis block")
+	}
+	header, err := pb.bc.BlockHeaderByHeight(tip.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		producer = header.PublicKey()
+		height   = tip.Height + 1
+		g        = pb.bc.Genesis()
+	)
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight:    height,
+		BlockTimeStamp: now,
+		Producer:       producer.Address(),
+		GasLimit:       g.BlockGasLimitByHeight(height),
+		BaseFee:        protocol.CalcBaseFee(g.Blockchain, &tip),
+		ExcessBlobGas:  protocol.CalcExcessBlobGas(tip.ExcessBlobGas, tip.BlobGasUsed),
+	}))
+	blk, states, err := pb.sf.MintPending(ctx, pb.ap, producer)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to build the pending block at height %d", height)
+	}
+	pb.blk, pb.states, pb.builtAt = blk, states, now
+	return pb.blk, pb.states, nil
+}
+
+func newCoreServiceReaderPending(cs *coreService) *coreServiceReaderPending {
+	return &coreServiceReaderPending{cs: cs}
+}
+
+func (core *coreServiceReaderPending) Account(addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error) {
+	ctx, span := tracer.NewSpan(context.Background(), "coreServiceReaderPending.Account")
+	defer span.End()
+	addrStr := addr.String()
+	if addrStr == address.RewardingPoolAddr || addrStr == address.StakingBucketPoolAddr {
+		return core.cs.getProtocolAccount(ctx, addrStr)
+	}
+	blk, states, err := core.cs.pending.PendingBlock()
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	g := core.cs.bc.Genesis()
+	ctx = genesis.WithGenesisContext(ctx, g)
+	state, err := accountutil.AccountState(ctx, states, addr)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	var pendingNonce uint64
+	if g.IsSumatra(blk.Height()) {
+		pendingNonce = state.PendingNonceConsideringFreshAccount()
+	} else {
+		pendingNonce = state.PendingNonce()
+	}
+	accountMeta, err := core.cs.accountMeta(ctx, states, state, pendingNonce, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the pending block is not signed, so it has no hash
+	return accountMeta, &iotextypes.BlockIdentifier{Height: blk.Height()}, nil
+}
+
+func (core *coreServiceReaderPending) ReadContract(ctx context.Context, callerAddr address.Address, elp action.Envelope) (string, *iotextypes.Receipt, error) {
+	if _, ok := elp.Action().(*action.Execution); !ok {
+		return "", nil, status.Error(codes.InvalidArgument, "expecting action.Execution")
+	}
+	blk, states, err := core.cs.pending.PendingBlock()
+	if err != nil {
+		return "", nil, status.Error(codes.Internal, err.Error())
+	}
+	// the result is not put into the read cache, as the pending block changes with the actpool
+	g := core.cs.bc.Genesis()
+	blockGasLimit := g.BlockGasLimitByHeight(blk.Height())
+	if elp.Gas() == 0 || blockGasLimit < elp.Gas() {
+		elp.SetGas(blockGasLimit)
+	}
+	ctx, err = core.cs.bc.Context(ctx)
+	if err != nil {
+		return "", nil, status.Error(codes.Internal, err.Error())
+	}
+	ws, err := states.WorkingSet(ctx)
+	if err != nil {
+		return "", nil, status.Error(codes.Internal, err.Error())
+	}
+	retval, receipt, err := core.cs.simulateExecutionOn(ctx, ws, blk.Height(), callerAddr, elp)
+	if err != nil {
+		return "", nil, status.Error(codes.Internal, err.Error())
+	}
+	return hex.EncodeToString(retval), receipt.ConvertToReceiptPb(), nil
+}
+
+func (core *coreServiceReaderPending) ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error) {
+	_, states, err := core.cs.pending.PendingBlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ctx, err = core.cs.bc.Context(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ws, err := states.WorkingSet(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return evm.ReadContractStorage(ctx, ws, addr, key)
+}
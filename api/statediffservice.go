@@ -0,0 +1,83 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff/statediffpb"
+)
+
+type stateDiffService struct {
+	core CoreService
+}
+
+func newStateDiffService(core CoreService) *stateDiffService {
+	return &stateDiffService{
+		core: core,
+	}
+}
+
+func (service *stateDiffService) GetStateDiff(_ context.Context, request *statediffpb.GetStateDiffRequest) (*statediffpb.GetStateDiffResponse, error) {
+	diff, err := service.core.StateDiff(request.Height)
+	switch errors.Cause(err) {
+	case nil:
+	case ErrNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case ErrStateDiffNotSupported:
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &statediffpb.GetStateDiffResponse{
+		Height:   diff.Height,
+		Accounts: make([]*statediffpb.AccountDiff, 0, len(diff.Accounts)),
+		Changes:  make([]*statediffpb.StateChange, 0, len(diff.Changes)),
+	}
+	for _, acct := range diff.Accounts {
+		pb := &statediffpb.AccountDiff{
+			Address:  acct.Address.String(),
+			Previous: accountStateToPb(acct.Previous),
+			Current:  accountStateToPb(acct.Current),
+		}
+		for _, s := range acct.Storage {
+			pb.Storage = append(pb.Storage, &statediffpb.StorageChange{
+				Key:      s.Key[:],
+				Previous: s.Previous,
+				Current:  s.Current,
+			})
+		}
+		resp.Accounts = append(resp.Accounts, pb)
+	}
+	for _, c := range diff.Changes {
+		resp.Changes = append(resp.Changes, &statediffpb.StateChange{
+			Namespace: c.Namespace,
+			Key:       c.Key,
+			Previous:  c.Previous,
+			Current:   c.Current,
+		})
+	}
+	return resp, nil
+}
+
+func accountStateToPb(acct *statediff.AccountState) *statediffpb.AccountState {
+	if acct == nil {
+		return nil
+	}
+	pb := &statediffpb.AccountState{
+		Nonce:    acct.Nonce,
+		CodeHash: acct.CodeHash,
+	}
+	if acct.Balance != nil {
+		pb.Balance = acct.Balance.String()
+	}
+	return pb
+}
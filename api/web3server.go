@@ -13,7 +13,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
-	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/go-pkgs/util"
@@ -88,9 +87,11 @@ var (
 	errHTTPNotSupported  = errors.New("http not supported")
 	errPanic             = errors.New("panic")
 
-	_pendingBlockNumber  = "pending"
-	_latestBlockNumber   = "latest"
-	_earliestBlockNumber = "earliest"
+	_pendingBlockNumber   = "pending"
+	_latestBlockNumber    = "latest"
+	_earliestBlockNumber  = "earliest"
+	_safeBlockNumber      = "safe"
+	_finalizedBlockNumber = "finalized"
 )
 
 func init() {
@@ -254,6 +255,8 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		res, err = svr.unsubscribe(web3Req)
 	case "eth_getBlobSidecars":
 		res, err = svr.getBlobSidecars(web3Req)
+	case "debug_getStateDiff":
+		res, err = svr.getStateDiff(web3Req)
 	//TODO: enable debug api after archive mode is supported
 	// case "debug_traceTransaction":
 	// 	res, err = svr.traceTransaction(ctx, web3Req)
@@ -389,6 +392,9 @@ func (svr *web3Handler) getBlockByNumber(in *gjson.Result) (interface{}, error)
 	if !blkNum.Exists() || !isDetailed.Exists() {
 		return nil, errInvalidFormat
 	}
+	if isPendingBlock(blkNum.String()) {
+		return svr.getPendingBlock(isDetailed.Bool())
+	}
 	num, err := svr.parseBlockNumber(blkNum.String())
 	if err != nil {
 		return nil, err
@@ -414,26 +420,25 @@ func (svr *web3Handler) getBalance(in *gjson.Result) (interface{}, error) {
 		return nil, err
 	}
 	bnParam := in.Get("params.1")
-	bn, err := parseBlockNumber(&bnParam)
+	accountMeta, err := svr.accountAt(ioAddr, &bnParam)
 	if err != nil {
 		return nil, err
 	}
-	var (
-		accountMeta *iotextypes.AccountMeta
-	)
-	height, archive, err := svr.blockNumberOrHashToHeight(rpc.BlockNumberOrHashWithNumber(bn))
+	return intStrToHex(accountMeta.Balance)
+}
+
+// accountAt returns the account at the block parameter
+func (svr *web3Handler) accountAt(addr address.Address, bnParam *gjson.Result) (*iotextypes.AccountMeta, error) {
+	bn, err := parseBlockNumberOrHash(bnParam)
 	if err != nil {
 		return nil, err
 	}
-	if !archive {
-		accountMeta, _, err = svr.coreService.Account(ioAddr)
-	} else {
-		accountMeta, _, err = svr.coreService.WithHeight(height).Account(ioAddr)
-	}
+	reader, err := svr.stateReaderAt(bn)
 	if err != nil {
 		return nil, err
 	}
-	return intStrToHex(accountMeta.Balance)
+	accountMeta, _, err := reader.Account(addr)
+	return accountMeta, err
 }
 
 // getTransactionCount returns the nonce for the given address
@@ -446,8 +451,30 @@ func (svr *web3Handler) getTransactionCount(in *gjson.Result) (interface{}, erro
 	if err != nil {
 		return nil, err
 	}
-	// TODO (liuhaai): returns the nonce in given block height after archive mode is supported
-	// blkNum, err := getStringFromArray(in, 1)
+	// the nonce at the tip includes the actions in the actpool, so that the wallets asking for the latest
+	// nonce do not reuse the nonces of their pending actions
+	bnParam := in.Get("params.1")
+	bn, err := parseBlockNumberOrHash(&bnParam)
+	if err != nil {
+		return nil, err
+	}
+	nonceFrom := func(reader CoreServiceReaderWithHeight) (interface{}, error) {
+		accountMeta, _, err := reader.Account(ioAddr)
+		if err != nil {
+			return nil, err
+		}
+		return uint64ToHex(accountMeta.PendingNonce), nil
+	}
+	if isPendingBlockParam(bn) {
+		return nonceFrom(svr.coreService.Pending())
+	}
+	height, archive, err := svr.blockNumberOrHashToHeight(bn)
+	if err != nil {
+		return nil, err
+	}
+	if archive {
+		return nonceFrom(svr.coreService.WithHeight(height))
+	}
 	pendingNonce, err := svr.coreService.PendingNonce(ioAddr)
 	if err != nil {
 		return nil, err
@@ -503,15 +530,11 @@ func (svr *web3Handler) call(ctx context.Context, in *gjson.Result) (interface{}
 		ret     string
 		receipt *iotextypes.Receipt
 	)
-	height, archive, err := svr.blockNumberOrHashToHeight(callMsg.BlockNumberOrHash)
+	reader, err := svr.stateReaderAt(callMsg.BlockNumberOrHash)
 	if err != nil {
 		return nil, err
 	}
-	if !archive {
-		ret, receipt, err = svr.coreService.ReadContract(context.Background(), callMsg.From, elp)
-	} else {
-		ret, receipt, err = svr.coreService.WithHeight(height).ReadContract(context.Background(), callMsg.From, elp)
-	}
+	ret, receipt, err = reader.ReadContract(context.Background(), callMsg.From, elp)
 	if err != nil {
 		return nil, err
 	}
@@ -640,7 +663,8 @@ func (svr *web3Handler) getCode(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	accountMeta, _, err := svr.coreService.Account(ioAddr)
+	bnParam := in.Get("params.1")
+	accountMeta, err := svr.accountAt(ioAddr, &bnParam)
 	if err != nil {
 		return nil, err
 	}
@@ -814,6 +838,13 @@ func (svr *web3Handler) getBlockTransactionCountByNumber(in *gjson.Result) (inte
 	if !blkNum.Exists() {
 		return nil, errInvalidFormat
 	}
+	if isPendingBlock(blkNum.String()) {
+		blk, err := svr.coreService.PendingBlock()
+		if err != nil {
+			return nil, err
+		}
+		return uint64ToHex(uint64(len(blk.Actions))), nil
+	}
 	num, err := svr.parseBlockNumber(blkNum.String())
 	if err != nil {
 		return nil, err
@@ -857,11 +888,21 @@ func (svr *web3Handler) getTransactionByBlockNumberAndIndex(in *gjson.Result) (i
 	if !blkNum.Exists() || !idxStr.Exists() {
 		return nil, errInvalidFormat
 	}
-	num, err := svr.parseBlockNumber(blkNum.String())
+	idx, err := hexStringToNumber(idxStr.String())
 	if err != nil {
 		return nil, err
 	}
-	idx, err := hexStringToNumber(idxStr.String())
+	if isPendingBlock(blkNum.String()) {
+		blk, err := svr.coreService.PendingBlock()
+		if err != nil {
+			return nil, err
+		}
+		if idx >= uint64(len(blk.Actions)) {
+			return nil, nil
+		}
+		return svr.assemblePendingTransaction(blk.Actions[idx])
+	}
+	num, err := svr.parseBlockNumber(blkNum.String())
 	if err != nil {
 		return nil, err
 	}
@@ -888,7 +929,16 @@ func (svr *web3Handler) getStorageAt(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	val, err := svr.coreService.ReadContractStorage(context.Background(), contractAddr, pos)
+	bnParam := in.Get("params.2")
+	bn, err := parseBlockNumberOrHash(&bnParam)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := svr.stateReaderAt(bn)
+	if err != nil {
+		return nil, err
+	}
+	val, err := reader.ReadContractStorage(context.Background(), contractAddr, pos)
 	if err != nil {
 		return nil, err
 	}
@@ -1106,6 +1156,26 @@ func (svr *web3Handler) getBlobSidecars(in *gjson.Result) (interface{}, error) {
 	}
 }
 
+func (svr *web3Handler) getStateDiff(in *gjson.Result) (interface{}, error) {
+	blkNum := in.Get("params.0")
+	if !blkNum.Exists() {
+		return nil, errInvalidFormat
+	}
+	num, err := svr.parseBlockNumber(blkNum.String())
+	if err != nil {
+		return nil, err
+	}
+	diff, err := svr.coreService.StateDiff(num)
+	switch errors.Cause(err) {
+	case nil:
+		return newStateDiffResult(diff), nil
+	case ErrNotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
 func (svr *web3Handler) traceTransaction(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	actHash, options := in.Get("params.0"), in.Get("params.1")
 	if !actHash.Exists() {
@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -17,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/action"
 	apitypes "github.com/iotexproject/iotex-core/v2/api/types"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 )
 
 const (
@@ -96,6 +98,22 @@ type (
 		BlobGasUsedRatio  []float64  `json:"blobGasUsedRatio"`
 		Reward            [][]string `json:"reward,omitempty"`
 	}
+
+	// stateDiffResult is the state changes of a block, in the format of the stateDiff of trace_replayBlockTransactions
+	stateDiffResult map[common.Address]*accountStateDiff
+
+	accountStateDiff struct {
+		Balance  *valueDiff                 `json:"balance"`
+		Nonce    *valueDiff                 `json:"nonce"`
+		CodeHash *valueDiff                 `json:"codeHash"`
+		Storage  map[common.Hash]*valueDiff `json:"storage"`
+	}
+
+	// valueDiff is the change of a value, a nil value means the value does not exist
+	valueDiff struct {
+		from *string
+		to   *string
+	}
 )
 
 var (
@@ -141,6 +159,78 @@ func (obj *web3Response) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func newStateDiffResult(diff *statediff.BlockDiff) stateDiffResult {
+	res := make(stateDiffResult, len(diff.Accounts))
+	for _, acct := range diff.Accounts {
+		var (
+			prev, curr = acct.Previous, acct.Current
+			d          = &accountStateDiff{
+				Balance:  &valueDiff{},
+				Nonce:    &valueDiff{},
+				CodeHash: &valueDiff{},
+				Storage:  make(map[common.Hash]*valueDiff, len(acct.Storage)),
+			}
+		)
+		if prev != nil {
+			d.Balance.from = toHexBig(prev.Balance)
+			d.Nonce.from = toHexUint64(prev.Nonce)
+			d.CodeHash.from = toHexBytes(prev.CodeHash)
+		}
+		if curr != nil {
+			d.Balance.to = toHexBig(curr.Balance)
+			d.Nonce.to = toHexUint64(curr.Nonce)
+			d.CodeHash.to = toHexBytes(curr.CodeHash)
+		}
+		for _, slot := range acct.Storage {
+			v := &valueDiff{}
+			if slot.Previous != nil {
+				v.from = toHexBytes(common.BytesToHash(slot.Previous).Bytes())
+			}
+			if slot.Current != nil {
+				v.to = toHexBytes(common.BytesToHash(slot.Current).Bytes())
+			}
+			d.Storage[common.Hash(slot.Key)] = v
+		}
+		res[common.BytesToAddress(acct.Address.Bytes())] = d
+	}
+	return res
+}
+
+func toHexBig(v *big.Int) *string {
+	if v == nil {
+		v = big.NewInt(0)
+	}
+	s := hexutil.EncodeBig(v)
+	return &s
+}
+
+func toHexUint64(v uint64) *string {
+	s := hexutil.EncodeUint64(v)
+	return &s
+}
+
+func toHexBytes(v []byte) *string {
+	s := hexutil.Encode(v)
+	return &s
+}
+
+// MarshalJSON marshals the change as "=" if the value is unchanged, {"+": to} if the value is created,
+// {"-": from} if the value is deleted, or {"*": {"from": from, "to": to}} if the value is changed
+func (obj *valueDiff) MarshalJSON() ([]byte, error) {
+	switch {
+	case obj.from == nil && obj.to == nil, obj.from != nil && obj.to != nil && *obj.from == *obj.to:
+		return json.Marshal("=")
+	case obj.from == nil:
+		return json.Marshal(map[string]string{"+": *obj.to})
+	case obj.to == nil:
+		return json.Marshal(map[string]string{"-": *obj.from})
+	default:
+		return json.Marshal(map[string]map[string]string{
+			"*": {"from": *obj.from, "to": *obj.to},
+		})
+	}
+}
+
 func getLogsBloomHex(logsbloom string) string {
 	if len(logsbloom) == 0 {
 		return _zeroLogsBloom
@@ -154,11 +244,10 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 	}
 
 	var (
-		blkHash           hash.Hash256
-		producerAddress   string
-		logsBloomStr      string
-		gasLimit, gasUsed uint64
-		baseFee           *hexutil.Big
+		blkHash, producerAddr *string
+		logsBloomStr          string
+		gasLimit, gasUsed     uint64
+		baseFee               *hexutil.Big
 
 		txs              = make([]interface{}, 0)
 		preHash          = obj.blk.Header.PrevHash()
@@ -168,15 +257,19 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 		blobGasUsed      = hexutil.Uint64(obj.blk.Header.BlobGasUsed())
 		excessBlobGas    = hexutil.Uint64(obj.blk.Header.ExcessBlobGas())
 	)
-	if obj.blk.Height() > 0 {
-		producerAddress = obj.blk.Header.ProducerAddress()
-		blkHash = obj.blk.Header.HashBlock()
-	} else {
-		blkHash = block.GenesisHash()
-	}
-	producerAddr, err := ioAddrToEthAddr(producerAddress)
-	if err != nil {
-		return nil, err
+	switch {
+	case obj.blk.Height() == 0:
+		h := block.GenesisHash()
+		blkHash, producerAddr = toHexBytes(h[:]), toHexBytes(make([]byte, 20))
+	case obj.blk.Header.PublicKey() != nil:
+		h := obj.blk.Header.HashBlock()
+		addr, err := ioAddrToEthAddr(obj.blk.Header.ProducerAddress())
+		if err != nil {
+			return nil, err
+		}
+		blkHash, producerAddr = toHexBytes(h[:]), &addr
+	default:
+		// the pending block is not signed, it has neither a producer nor a hash
 	}
 	for _, tx := range obj.blk.Actions {
 		gasLimit += tx.Gas()
@@ -194,16 +287,16 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 		baseFee = (*hexutil.Big)(obj.blk.Header.BaseFee())
 	}
 	return json.Marshal(&struct {
-		Author           string         `json:"author"`
+		Author           *string        `json:"author"`
 		Number           string         `json:"number"`
-		Hash             string         `json:"hash"`
+		Hash             *string        `json:"hash"`
 		ParentHash       string         `json:"parentHash"`
 		Sha3Uncles       string         `json:"sha3Uncles"`
 		LogsBloom        string         `json:"logsBloom"`
 		TransactionsRoot string         `json:"transactionsRoot"`
 		StateRoot        string         `json:"stateRoot"`
 		ReceiptsRoot     string         `json:"receiptsRoot"`
-		Miner            string         `json:"miner"`
+		Miner            *string        `json:"miner"`
 		Difficulty       string         `json:"difficulty"`
 		TotalDifficulty  string         `json:"totalDifficulty"`
 		ExtraData        string         `json:"extraData"`
@@ -220,7 +313,7 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 	}{
 		Author:           producerAddr,
 		Number:           uint64ToHex(obj.blk.Height()),
-		Hash:             "0x" + hex.EncodeToString(blkHash[:]),
+		Hash:             blkHash,
 		ParentHash:       "0x" + hex.EncodeToString(preHash[:]),
 		Sha3Uncles:       "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
 		LogsBloom:        getLogsBloomHex(logsBloomStr),
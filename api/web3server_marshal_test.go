@@ -227,6 +227,30 @@ func TestBlockObjectMarshal(t *testing.T) {
 		 }
 		`, string(res))
 	})
+
+	t.Run("PendingBlock", func(t *testing.T) {
+		pending := block.NewBuilder(ra).
+			SetHeight(uint64(2)).
+			SetTimestamp(time.Date(2011, 1, 26, 0, 0, 5, 0, time.UTC)).
+			SetPrevBlockHash(blk.HashBlock()).
+			SetReceipts(blk.Receipts).
+			Build()
+		res, err := json.Marshal(&getBlockResult{
+			blk:          &pending,
+			transactions: []interface{}{string("0x2133ee7ff4562535166e3f16fd7407c19e5ed1acd036f78d3528a5a40e40ad42")},
+		})
+		require.NoError(err)
+		var fields map[string]interface{}
+		require.NoError(json.Unmarshal(res, &fields))
+		// the pending block is not signed, it has neither a producer nor a hash
+		for _, k := range []string{"author", "hash", "miner"} {
+			v, ok := fields[k]
+			require.True(ok, k)
+			require.Nil(v, k)
+		}
+		require.Equal("0x2", fields["number"])
+		require.Equal("0x5208", fields["gasUsed"])
+	})
 }
 
 func TestTransactionObjectMarshal(t *testing.T) {
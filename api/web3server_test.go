@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
@@ -30,6 +31,7 @@ import (
 	apitypes "github.com/iotexproject/iotex-core/v2/api/types"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 	"github.com/iotexproject/iotex-core/v2/test/identityset"
 	mock_apitypes "github.com/iotexproject/iotex-core/v2/test/mock/mock_apiresponder"
 	"github.com/iotexproject/iotex-core/v2/testutil"
@@ -254,6 +256,39 @@ func TestGetBlockByNumber(t *testing.T) {
 		require.True(ok)
 		require.Equal(receipts[0], tsrlt.receipt)
 	})
+
+	t.Run("pending block", func(t *testing.T) {
+		pending := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(tsf).Build()).
+			SetHeight(2).
+			SetPrevBlockHash(blk.HashBlock()).
+			SetTimestamp(time.Now()).
+			Build()
+		core.EXPECT().PendingBlock().Return(&pending, nil).Times(3)
+		core.EXPECT().EVMNetworkID().Return(uint32(0)).Times(2)
+		in := gjson.Parse(`{"params":["pending", true]}`)
+		ret, err := web3svr.getBlockByNumber(&in)
+		require.NoError(err)
+		rlt, ok := ret.(*getBlockResult)
+		require.True(ok)
+		require.Equal(pending.Header, rlt.blk.Header)
+		require.Len(rlt.transactions, 1)
+		tsrlt, ok := rlt.transactions[0].(*getTransactionResult)
+		require.True(ok)
+		require.Nil(tsrlt.blockHash)
+		require.Nil(tsrlt.receipt)
+
+		in = gjson.Parse(`{"params":["pending"]}`)
+		ret, err = web3svr.getBlockTransactionCountByNumber(&in)
+		require.NoError(err)
+		require.Equal("0x1", ret.(string))
+
+		in = gjson.Parse(`{"params":["pending", "0x0"]}`)
+		ret, err = web3svr.getTransactionByBlockNumberAndIndex(&in)
+		require.NoError(err)
+		tsrlt, ok = ret.(*getTransactionResult)
+		require.True(ok)
+		require.Nil(tsrlt.blockHash)
+	})
 }
 
 func TestGetBalance(t *testing.T) {
@@ -263,6 +298,7 @@ func TestGetBalance(t *testing.T) {
 	core := NewMockCoreService(ctrl)
 	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
 	balance := "111111111111111111"
+	core.EXPECT().TipHeight().Return(uint64(2))
 	core.EXPECT().WithHeight(gomock.Any()).Return(core).Times(1)
 	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{Balance: balance}, nil, nil)
 
@@ -272,6 +308,13 @@ func TestGetBalance(t *testing.T) {
 	ans, ok := new(big.Int).SetString(balance, 10)
 	require.True(ok)
 	require.Equal("0x"+fmt.Sprintf("%x", ans), ret.(string))
+
+	core.EXPECT().Pending().Return(core).Times(1)
+	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{Balance: "16"}, nil, nil)
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "pending"]}`)
+	ret, err = web3svr.getBalance(&in)
+	require.NoError(err)
+	require.Equal("0x10", ret.(string))
 }
 
 func TestGetTransactionCount(t *testing.T) {
@@ -280,6 +323,7 @@ func TestGetTransactionCount(t *testing.T) {
 	defer ctrl.Finish()
 	core := NewMockCoreService(ctrl)
 	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	core.EXPECT().TipHeight().Return(uint64(1))
 	core.EXPECT().PendingNonce(gomock.Any()).Return(uint64(2), nil)
 
 	inNil := gjson.Parse(`{"params":[]}`)
@@ -290,6 +334,14 @@ func TestGetTransactionCount(t *testing.T) {
 	ret, err := web3svr.getTransactionCount(&in)
 	require.NoError(err)
 	require.Equal("0x2", ret.(string))
+
+	// the pending nonce is read from the state after the pending block
+	core.EXPECT().Pending().Return(core).Times(1)
+	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{PendingNonce: 3}, nil, nil).Times(1)
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "pending"]}`)
+	ret, err = web3svr.getTransactionCount(&in)
+	require.NoError(err)
+	require.Equal("0x3", ret.(string))
 }
 
 func TestCall(t *testing.T) {
@@ -298,6 +350,7 @@ func TestCall(t *testing.T) {
 	defer ctrl.Finish()
 	core := NewMockCoreService(ctrl)
 	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	core.EXPECT().TipHeight().Return(uint64(2)).AnyTimes()
 
 	t.Run("to is StakingProtocol addr", func(t *testing.T) {
 		meta := &iotextypes.AccountMeta{
@@ -454,6 +507,59 @@ func TestSendRawTransaction(t *testing.T) {
 	})
 }
 
+func TestGetStateDiff(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	slot := hash.BytesToHash256([]byte{1})
+	core.EXPECT().StateDiff(uint64(2)).Return(&statediff.BlockDiff{
+		Height: 2,
+		Accounts: []*statediff.AccountDiff{
+			{
+				Address:  identityset.Address(1),
+				Previous: &statediff.AccountState{Balance: big.NewInt(10), Nonce: 1},
+				Current:  &statediff.AccountState{Balance: big.NewInt(5), Nonce: 2},
+			},
+			{
+				Address: identityset.Address(2),
+				Current: &statediff.AccountState{Balance: big.NewInt(5), Nonce: 0, CodeHash: []byte{2}},
+				Storage: []*statediff.StorageChange{{Key: slot, Current: []byte{3}}},
+			},
+		},
+	}, nil)
+	core.EXPECT().StateDiff(uint64(3)).Return(nil, errors.Wrap(ErrNotFound, "no state diff"))
+
+	in := gjson.Parse(`{"params":["0x2"]}`)
+	ret, err := web3svr.getStateDiff(&in)
+	require.NoError(err)
+	data, err := json.Marshal(ret)
+	require.NoError(err)
+	addr1, addr2 := identityset.Address(1).Hex(), identityset.Address(2).Hex()
+	require.JSONEq(fmt.Sprintf(`{
+		"%s": {
+			"balance": {"*": {"from": "0xa", "to": "0x5"}},
+			"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+			"codeHash": "=",
+			"storage": {}
+		},
+		"%s": {
+			"balance": {"+": "0x5"},
+			"nonce": {"+": "0x0"},
+			"codeHash": {"+": "0x02"},
+			"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": {"+": "0x0000000000000000000000000000000000000000000000000000000000000003"}
+			}
+		}
+	}`, strings.ToLower(addr1), strings.ToLower(addr2)), string(data))
+
+	in = gjson.Parse(`{"params":["0x3"]}`)
+	ret, err = web3svr.getStateDiff(&in)
+	require.NoError(err)
+	require.Nil(ret)
+}
+
 func TestGetCode(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
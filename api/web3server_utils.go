@@ -113,6 +113,38 @@ func (svr *web3Handler) getBlockWithTransactions(blk *block.Block, receipts []*a
 	}, nil
 }
 
+// getPendingBlock returns the pending block, which is not signed and has no hash
+func (svr *web3Handler) getPendingBlock(isDetailed bool) (*getBlockResult, error) {
+	blk, err := svr.coreService.PendingBlock()
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]interface{}, 0, len(blk.Actions))
+	for _, selp := range blk.Actions {
+		if isDetailed {
+			tx, err := svr.assemblePendingTransaction(selp)
+			if err != nil {
+				if errors.Cause(err) != errUnsupportedAction {
+					h, _ := selp.Hash()
+					log.Logger("api").Error("failed to get info from action", zap.Error(err), zap.String("actHash", hex.EncodeToString(h[:])))
+				}
+				continue
+			}
+			transactions = append(transactions, tx)
+		} else {
+			actHash, err := selp.Hash()
+			if err != nil {
+				return nil, err
+			}
+			transactions = append(transactions, "0x"+hex.EncodeToString(actHash[:]))
+		}
+	}
+	return &getBlockResult{
+		blk:          blk,
+		transactions: transactions,
+	}, nil
+}
+
 func (svr *web3Handler) assembleConfirmedTransaction(blkHash hash.Hash256, selp *action.SealedEnvelope, receipt *action.Receipt) (*getTransactionResult, error) {
 	// sanity check
 	if receipt == nil {
@@ -152,6 +184,8 @@ func (svr *web3Handler) parseBlockNumber(str string) (uint64, error) {
 		return 1, nil
 	case "", _pendingBlockNumber, _latestBlockNumber:
 		return svr.coreService.TipHeight(), nil
+	case _safeBlockNumber, _finalizedBlockNumber:
+		return svr.coreService.FinalizedHeight()
 	default:
 		return hexStringToNumber(str)
 	}
@@ -396,38 +430,94 @@ func parseCallObject(in *gjson.Result) (*callMsg, error) {
 	}, nil
 }
 
-// TODO: fix this to support eip 1898
-func parseBlockNumber(in *gjson.Result) (rpc.BlockNumber, error) {
+// parseBlockNumberOrHash parses the EIP-1898 block parameter, which is a block number, a block tag, a block
+// hash, or an object with either the blockNumber field or the blockHash and requireCanonical fields
+func parseBlockNumberOrHash(in *gjson.Result) (rpc.BlockNumberOrHash, error) {
 	if !in.Exists() {
-		return rpc.LatestBlockNumber, nil
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+	}
+	if in.Type == gjson.Number {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(in.Int())), nil
+	}
+	var bn rpc.BlockNumberOrHash
+	if err := bn.UnmarshalJSON([]byte(in.Raw)); err != nil {
+		return bn, errors.Wrapf(err, "failed to unmarshal block parameter %s", in.Raw)
+	}
+	return bn, nil
+}
+
+// stateReaderAt returns the state reader of the block parameter. The pending tag is served from the state
+// after the pending block, the others as resolved by blockNumberOrHashToHeight
+func (svr *web3Handler) stateReaderAt(bn rpc.BlockNumberOrHash) (CoreServiceReaderWithHeight, error) {
+	if isPendingBlockParam(bn) {
+		return svr.coreService.Pending(), nil
+	}
+	height, archive, err := svr.blockNumberOrHashToHeight(bn)
+	if err != nil {
+		return nil, err
 	}
-	var height rpc.BlockNumber
-	if err := height.UnmarshalJSON([]byte(in.String())); err != nil {
-		return 0, err
+	if archive {
+		return svr.coreService.WithHeight(height), nil
 	}
-	return height, nil
+	return svr.coreService, nil
 }
 
+// blockNumberOrHashToHeight returns the height of the block parameter, and whether the state at the height
+// has to be read from the archive. The latest tag, and the parameters pointing to the tip, are served from
+// the latest state. The pending tag has no height, its state is read by stateReaderAt
 func (svr *web3Handler) blockNumberOrHashToHeight(bn rpc.BlockNumberOrHash) (uint64, bool, error) {
 	if bn.BlockHash != nil {
+		// only the blocks of the canonical chain are stored, so a block found by hash is always canonical
 		bh := (*bn.BlockHash).String()
 		blk, err := svr.coreService.BlockByHash(util.Remove0xPrefix(bh))
 		if err != nil {
 			return 0, false, errors.Wrapf(err, "failed to get block height by hash %s", bh)
 		}
-		return uint64(blk.Block.Height()), true, nil
+		return svr.heightToRead(blk.Block.Height())
+	}
+	if bn.BlockNumber == nil {
+		return 0, false, errors.Wrap(errInvalidFormat, "empty block parameter")
 	}
-
 	switch *bn.BlockNumber {
-	case rpc.SafeBlockNumber, rpc.FinalizedBlockNumber, rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+	case rpc.LatestBlockNumber:
 		return 0, false, nil
+	case rpc.PendingBlockNumber:
+		return 0, false, errors.Wrap(errInvalidFormat, "the pending block has no height")
+	case rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		height, err := svr.coreService.FinalizedHeight()
+		if err != nil {
+			return 0, false, err
+		}
+		return svr.heightToRead(height)
 	case rpc.EarliestBlockNumber:
 		return 1, true, nil
 	default:
-		return uint64(*bn.BlockNumber), true, nil
+		return svr.heightToRead(uint64(*bn.BlockNumber))
+	}
+}
+
+func (svr *web3Handler) heightToRead(height uint64) (uint64, bool, error) {
+	tipHeight := svr.coreService.TipHeight()
+	switch {
+	case height > tipHeight:
+		return 0, false, errors.Wrapf(ErrNotFound, "block %d is higher than the tip %d", height, tipHeight)
+	case height == tipHeight:
+		return 0, false, nil
+	default:
+		return height, true, nil
 	}
 }
 
+// isPendingBlock returns true if the block parameter is the pending tag
+func isPendingBlock(str string) bool {
+	return str == _pendingBlockNumber
+}
+
+// isPendingBlockParam returns true if the block parameter is the pending tag
+func isPendingBlockParam(bn rpc.BlockNumberOrHash) bool {
+	return bn.BlockNumber != nil && *bn.BlockNumber == rpc.PendingBlockNumber
+}
+
 func (call *callMsg) toUnsignedTx(chainID uint32) (*types.Transaction, error) {
 	var (
 		tx     *types.Transaction
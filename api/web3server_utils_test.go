@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
@@ -10,6 +11,10 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+
+	apitypes "github.com/iotexproject/iotex-core/v2/api/types"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
 )
 
 func TestParseCallObject(t *testing.T) {
@@ -176,4 +181,80 @@ func TestParseBlockNumber(t *testing.T) {
 		num, _ := web3svr.parseBlockNumber("")
 		require.Equal(num, uint64(0x1))
 	})
+
+	t.Run("safe and finalized block number", func(t *testing.T) {
+		core.EXPECT().FinalizedHeight().Return(uint64(0x5), nil).Times(2)
+		for _, tag := range []string{"safe", "finalized"} {
+			num, err := web3svr.parseBlockNumber(tag)
+			require.NoError(err)
+			require.Equal(uint64(0x5), num)
+		}
+	})
+}
+
+func TestBlockNumberOrHashToHeight(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	core.EXPECT().TipHeight().Return(uint64(10)).AnyTimes()
+	core.EXPECT().FinalizedHeight().Return(uint64(8), nil).AnyTimes()
+	blk, err := block.NewTestingBuilder().SetHeight(7).SignAndBuild(identityset.PrivateKey(0))
+	require.NoError(err)
+	blkHash := blk.HashBlock()
+	core.EXPECT().BlockByHash(hex.EncodeToString(blkHash[:])).Return(&apitypes.BlockWithReceipts{Block: &blk}, nil).AnyTimes()
+
+	for _, test := range []struct {
+		param   string
+		height  uint64
+		archive bool
+	}{
+		{``, 0, false},
+		{`"latest"`, 0, false},
+		{`"earliest"`, 1, true},
+		{`"safe"`, 8, true},
+		{`"finalized"`, 8, true},
+		{`"0x3"`, 3, true},
+		{`3`, 3, true},
+		{`"0xa"`, 0, false},
+		{`{"blockNumber": "0x3"}`, 3, true},
+		{`{"blockNumber": "finalized"}`, 8, true},
+		{`"0x` + hex.EncodeToString(blkHash[:]) + `"`, 7, true},
+		{`{"blockHash": "0x` + hex.EncodeToString(blkHash[:]) + `", "requireCanonical": true}`, 7, true},
+	} {
+		in := gjson.Parse(`{"params":[` + test.param + `]}`).Get("params.0")
+		bn, err := parseBlockNumberOrHash(&in)
+		require.NoError(err, test.param)
+		height, archive, err := web3svr.blockNumberOrHashToHeight(bn)
+		require.NoError(err, test.param)
+		require.Equal(test.height, height, test.param)
+		require.Equal(test.archive, archive, test.param)
+	}
+
+	t.Run("invalid block parameter", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[{"blockNumber": "0x1", "blockHash": "0x01"}]}`).Get("params.0")
+		_, err := parseBlockNumberOrHash(&in)
+		require.Error(err)
+	})
+
+	t.Run("pending block", func(t *testing.T) {
+		in := gjson.Parse(`{"params":["pending"]}`).Get("params.0")
+		bn, err := parseBlockNumberOrHash(&in)
+		require.NoError(err)
+		_, _, err = web3svr.blockNumberOrHashToHeight(bn)
+		require.ErrorIs(err, errInvalidFormat)
+		core.EXPECT().Pending().Return(core).Times(1)
+		reader, err := web3svr.stateReaderAt(bn)
+		require.NoError(err)
+		require.Equal(core, reader)
+	})
+
+	t.Run("block higher than tip", func(t *testing.T) {
+		in := gjson.Parse(`{"params":["0xb"]}`).Get("params.0")
+		bn, err := parseBlockNumberOrHash(&in)
+		require.NoError(err)
+		_, _, err = web3svr.blockNumberOrHashToHeight(bn)
+		require.ErrorIs(err, ErrNotFound)
+	})
 }
@@ -76,7 +76,7 @@ func (b *Block) RunnableActions() RunnableActions {
 
 // Finalize creates a footer for the block
 func (b *Block) Finalize(endorsements []*endorsement.Endorsement, ts time.Time) error {
-	if len(b.endorsements) != 0 {
+	if len(b.endorsements) != 0 || b.aggregate != nil {
 		return errors.New("the block has been finalized")
 	}
 	b.endorsements = endorsements
@@ -85,6 +85,17 @@ func (b *Block) Finalize(endorsements []*endorsement.Endorsement, ts time.Time)
 	return nil
 }
 
+// FinalizeWithAggregate creates a footer for the block with the aggregate BLS endorsement, and the endorsements not
+// aggregated
+func (b *Block) FinalizeWithAggregate(aggregate *endorsement.AggregateEndorsement, endorsements []*endorsement.Endorsement, ts time.Time) error {
+	if err := b.Finalize(endorsements, ts); err != nil {
+		return err
+	}
+	b.aggregate = aggregate
+
+	return nil
+}
+
 // TransactionLog returns transaction logs in the block
 func (b *Block) TransactionLog() *BlkTransactionLog {
 	if len(b.Receipts) == 0 {
@@ -120,6 +120,11 @@ func (b *Builder) SignAndBuild(signerPrvKey crypto.PrivateKey) (Block, error) {
 	return b.blk, nil
 }
 
+// Build builds an unsigned block, which has neither a producer nor a hash
+func (b *Builder) Build() Block {
+	return b.blk
+}
+
 // GetCurrentBlockHeader returns the current hash of Block Header Core
 func (b *Builder) GetCurrentBlockHeader() Header {
 	return b.blk.Header
@@ -19,6 +19,9 @@ import (
 type Footer struct {
 	endorsements []*endorsement.Endorsement
 	commitTime   time.Time
+	// aggregate is the BLS signatures of the commit endorsements aggregated into one, the endorsements not
+	// aggregated are kept in endorsements
+	aggregate *endorsement.AggregateEndorsement
 }
 
 // Proto converts BlockFooter
@@ -30,6 +33,9 @@ func (f *Footer) Proto() *iotextypes.BlockFooter {
 	for _, en := range f.endorsements {
 		pb.Endorsements = append(pb.Endorsements, en.Proto())
 	}
+	if f.aggregate != nil {
+		pb.Aggregate = f.aggregate.Proto()
+	}
 	return &pb
 }
 
@@ -43,6 +49,13 @@ func (f *Footer) ConvertFromBlockFooterPb(pb *iotextypes.BlockFooter) error {
 	}
 	commitTime := pb.GetTimestamp().AsTime()
 	f.commitTime = commitTime
+	if aggPb := pb.GetAggregate(); aggPb != nil {
+		agg := &endorsement.AggregateEndorsement{}
+		if err := agg.LoadProto(aggPb); err != nil {
+			return err
+		}
+		f.aggregate = agg
+	}
 	pbEndorsements := pb.GetEndorsements()
 	if pbEndorsements == nil {
 		return nil
@@ -69,6 +82,11 @@ func (f *Footer) Endorsements() []*endorsement.Endorsement {
 	return f.endorsements
 }
 
+// Aggregate returns the aggregate BLS endorsement of the delegates, nil if the endorsements are not aggregated
+func (f *Footer) Aggregate() *endorsement.AggregateEndorsement {
+	return f.aggregate
+}
+
 // Serialize returns the serialized byte stream of the block footer
 func (f *Footer) Serialize() ([]byte, error) {
 	return proto.Marshal(f.Proto())
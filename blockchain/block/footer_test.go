@@ -19,7 +19,7 @@ import (
 
 func TestConvertToBlockFooterPb(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{nil, time.Now(), nil}
 	blockFooter := footer.Proto()
 	require.NotNil(blockFooter)
 	require.Equal(0, len(blockFooter.Endorsements))
@@ -43,7 +43,7 @@ func TestConvertFromBlockFooterPb(t *testing.T) {
 
 func TestSerDesFooter(t *testing.T) {
 	require := require.New(t)
-	footer := &Footer{nil, time.Now()}
+	footer := &Footer{nil, time.Now(), nil}
 	ser, err := footer.Serialize()
 	require.NoError(err)
 	require.NoError(footer.Deserialize(ser))
@@ -55,12 +55,28 @@ func TestSerDesFooter(t *testing.T) {
 	require.NoError(err)
 	require.NoError(footer.Deserialize(ser))
 	require.Equal(1, len(footer.endorsements))
+	require.Nil(footer.Aggregate())
+
+	// the aggregate endorsement rides along
+	footer.aggregate = endorsement.NewAggregateEndorsement([]byte{0x0b}, []byte("signature"))
+	ser, err = footer.Serialize()
+	require.NoError(err)
+	footer = &Footer{}
+	require.NoError(footer.Deserialize(ser))
+	require.Equal(1, len(footer.endorsements))
+	require.Equal([]byte{0x0b}, footer.Aggregate().Bitmap())
+	require.Equal([]byte("signature"), footer.Aggregate().Signature())
+	indexes, err := footer.Aggregate().Endorsers(4)
+	require.NoError(err)
+	require.Equal([]int{0, 1, 3}, indexes)
+	_, err = footer.Aggregate().Endorsers(9)
+	require.ErrorIs(err, endorsement.ErrInvalidAggregate)
 }
 
 func makeFooter() (f *Footer) {
 	endors := make([]*endorsement.Endorsement, 0)
 	endor := endorsement.NewEndorsement(time.Now(), identityset.PrivateKey(27).PublicKey(), nil)
 	endors = append(endors, endor)
-	f = &Footer{endors, time.Now()}
+	f = &Footer{endors, time.Now(), nil}
 	return
 }
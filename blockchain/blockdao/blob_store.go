@@ -241,6 +241,37 @@ func (bs *blobStore) expireBlob(height uint64) error {
 	return bs.kvStore.WriteBatch(b)
 }
 
+// Rewind deletes the blobs stored above the height. Blobs already expired
+// below the height are not restored.
+func (bs *blobStore) Rewind(_ context.Context, height uint64) error {
+	if atomic.LoadUint64(&bs.currWriteBlock) <= height {
+		return nil
+	}
+	var (
+		b   = batch.NewBatch()
+		key = keyForBlock(height)
+	)
+	ek, ev, err := bs.kvStore.Filter(_heightIndexNS, func(k, v []byte) bool {
+		return byteutil.BytesToUint64BigEndian(k) > height
+	}, key, nil)
+	switch errors.Cause(err) {
+	case nil, db.ErrNotExist, db.ErrBucketNotExist:
+	default:
+		return err
+	}
+	for i, k := range ek {
+		if err := bs.deleteBlob(k, ev[i], b); err != nil {
+			return errors.Wrapf(err, "failed to delete blob")
+		}
+	}
+	b.Put(_hashHeightNS, _writeHeight, key, "failed to put write height")
+	if err := bs.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrapf(err, "failed to write batch")
+	}
+	atomic.StoreUint64(&bs.currWriteBlock, height)
+	return nil
+}
+
 func decodeBlob(raw []byte) ([]*types.BlobTxSidecar, []string, error) {
 	pb := iotextypes.BlobTxSidecars{}
 	if err := proto.Unmarshal(raw, &pb); err != nil {
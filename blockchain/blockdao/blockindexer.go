@@ -7,11 +7,14 @@ package blockdao
 
 import (
 	"context"
+	"math/big"
 	"time"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/v2/action"
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
@@ -34,14 +37,22 @@ type (
 		StartHeight() uint64
 	}
 
+	// BlockIndexerWithTransactionLog defines an interface of block indexer which reads the transaction logs in
+	// the receipts, the checker loads them from dao for the blocks to catch up with
+	BlockIndexerWithTransactionLog interface {
+		BlockIndexer
+		// NeedTransactionLog returns true if the indexer reads the transaction logs
+		NeedTransactionLog() bool
+	}
+
 	// BlockIndexerChecker defines a checker of block indexer
 	BlockIndexerChecker struct {
-		dao BlockDAO
+		dao BlockStore
 	}
 )
 
 // NewBlockIndexerChecker creates a new block indexer checker
-func NewBlockIndexerChecker(dao BlockDAO) *BlockIndexerChecker {
+func NewBlockIndexerChecker(dao BlockStore) *BlockIndexerChecker {
 	return &BlockIndexerChecker{dao: dao}
 }
 
@@ -94,6 +105,11 @@ func (bic *BlockIndexerChecker) CheckIndexer(ctx context.Context, indexer BlockI
 			if err != nil {
 				return err
 			}
+			if indexerTL, ok := indexer.(BlockIndexerWithTransactionLog); ok && indexerTL.NeedTransactionLog() {
+				if err := bic.loadTransactionLogs(blk); err != nil {
+					return err
+				}
+			}
 		}
 		pk := blk.PublicKey()
 		if pk == nil {
@@ -142,3 +158,37 @@ func (bic *BlockIndexerChecker) CheckIndexer(ctx context.Context, indexer BlockI
 	}
 	return nil
 }
+
+// loadTransactionLogs adds the transaction logs of the block stored in dao to its receipts
+func (bic *BlockIndexerChecker) loadTransactionLogs(blk *block.Block) error {
+	if !bic.dao.ContainsTransactionLog() {
+		return errors.Errorf("cannot index block %d without transaction logs, the chain db does not store them", blk.Height())
+	}
+	logs, err := bic.dao.TransactionLogs(blk.Height())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get transaction logs at height %d", blk.Height())
+	}
+	receipts := make(map[hash.Hash256]*action.Receipt, len(blk.Receipts))
+	for _, r := range blk.Receipts {
+		receipts[r.ActionHash] = r
+	}
+	for _, l := range logs.GetLogs() {
+		r, ok := receipts[hash.BytesToHash256(l.ActionHash)]
+		if !ok {
+			return errors.Errorf("failed to find receipt of action %x at height %d", l.ActionHash, blk.Height())
+		}
+		for _, tx := range l.Transactions {
+			amount, ok := new(big.Int).SetString(tx.Amount, 10)
+			if !ok {
+				return errors.Errorf("invalid amount %s of action %x", tx.Amount, l.ActionHash)
+			}
+			r.AddTransactionLogs(&action.TransactionLog{
+				Type:      tx.Type,
+				Amount:    amount,
+				Sender:    tx.Sender,
+				Recipient: tx.Recipient,
+			})
+		}
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/test/identityset"
 	"github.com/iotexproject/iotex-core/v2/test/mock/mock_blockdao"
 )
@@ -289,3 +290,37 @@ func TestBlockIndexerChecker_CheckIndexer(t *testing.T) {
 		})
 	})
 }
+
+type testTransactionLogIndexer struct {
+	*mock_blockdao.MockBlockIndexer
+}
+
+func (testTransactionLogIndexer) NeedTransactionLog() bool {
+	return true
+}
+
+func TestCheckIndexerTransactionLog(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{})
+	ctx = genesis.WithGenesisContext(ctx, genesis.TestDefault())
+	store := mock_blockdao.NewMockBlockDAO(ctrl)
+	checker := NewBlockIndexerChecker(store)
+	indexer := testTransactionLogIndexer{mock_blockdao.NewMockBlockIndexer(ctrl)}
+	store.EXPECT().Height().Return(uint64(1), nil).AnyTimes()
+	store.EXPECT().GetBlockByHeight(gomock.Any()).Return(&block.Block{}, nil).AnyTimes()
+	store.EXPECT().GetReceipts(gomock.Any()).Return(nil, nil).AnyTimes()
+	indexer.EXPECT().Height().Return(uint64(0), nil).AnyTimes()
+	indexer.EXPECT().PutBlock(gomock.Any(), gomock.Any()).Times(0)
+
+	// the indexer is refused if the chain db does not store the transaction logs
+	store.EXPECT().ContainsTransactionLog().Return(false).Times(1)
+	r.ErrorContains(checker.CheckIndexer(ctx, indexer, 0, nil), "without transaction logs")
+
+	// the missing transaction logs of a block are not skipped
+	store.EXPECT().ContainsTransactionLog().Return(true).Times(1)
+	store.EXPECT().TransactionLogs(gomock.Any()).Return(nil, db.ErrNotExist).Times(1)
+	r.ErrorIs(checker.CheckIndexer(ctx, indexer, 0, nil), db.ErrNotExist)
+}
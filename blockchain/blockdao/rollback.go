@@ -0,0 +1,242 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+)
+
+var (
+	// ErrCannotRewind indicates a store cannot be rolled back to the target height
+	ErrCannotRewind = errors.New("store cannot rewind")
+)
+
+type (
+	// BlockIndexerWithRewind defines a block indexer which can remove its tip block
+	BlockIndexerWithRewind interface {
+		BlockIndexer
+		// DeleteTipBlock removes the tip block from the index
+		DeleteTipBlock(context.Context, *block.Block) error
+	}
+
+	// BlockIndexerWithReset defines a block indexer which can drop all the
+	// indexed data, it is rolled back by rebuilding from the chain db
+	BlockIndexerWithReset interface {
+		BlockIndexer
+		// Reset deletes all the indexed data
+		Reset(context.Context) error
+	}
+
+	// rewindChecker is implemented by the indexers which can remove the tip
+	// blocks only down to some height
+	rewindChecker interface {
+		CheckRewind(uint64) error
+	}
+
+	// HeightRewinder defines a store keyed by block height, which can drop
+	// everything above a height at once
+	HeightRewinder interface {
+		Rewind(context.Context, uint64) error
+	}
+
+	// RollbackStep is a store to roll back, and the height it is at
+	RollbackStep struct {
+		Name   string
+		Height uint64
+		// Err is set if the store cannot be rolled back
+		Err error
+	}
+
+	// Rollbacker rolls the chain db and all its indexers back to a target height
+	Rollbacker struct {
+		store     BlockStore
+		indexers  []namedIndexer
+		rewinders []namedRewinder
+	}
+
+	namedIndexer struct {
+		name    string
+		indexer BlockIndexer
+	}
+
+	namedRewinder struct {
+		name     string
+		rewinder HeightRewinder
+	}
+)
+
+// NewRollbacker creates a new rollbacker of the chain db
+func NewRollbacker(store BlockStore) *Rollbacker {
+	return &Rollbacker{store: store}
+}
+
+// AddIndexer adds a block indexer to roll back, the indexer must implement
+// BlockIndexerWithRewind or BlockIndexerWithReset if it is above the target
+// height
+func (r *Rollbacker) AddIndexer(name string, indexer BlockIndexer) {
+	r.indexers = append(r.indexers, namedIndexer{name, indexer})
+}
+
+// AddRewinder adds a height-keyed store to roll back
+func (r *Rollbacker) AddRewinder(name string, rewinder HeightRewinder) {
+	r.rewinders = append(r.rewinders, namedRewinder{name, rewinder})
+}
+
+// Plan returns the stores to roll back to the target height, in the order they
+// are rolled back. The chain db comes last.
+func (r *Rollbacker) Plan(target uint64) ([]*RollbackStep, error) {
+	tip, err := r.store.Height()
+	if err != nil {
+		return nil, err
+	}
+	if target > tip {
+		return nil, errors.Errorf("target height %d is higher than chain height %d", target, tip)
+	}
+	var steps []*RollbackStep
+	for _, v := range r.indexers {
+		height, err := v.indexer.Height()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get height of %s", v.name)
+		}
+		step := &RollbackStep{Name: v.name, Height: height}
+		if height > tip {
+			step.Err = errors.Errorf("height %d is higher than chain height %d", height, tip)
+		} else if height > target {
+			step.Err = checkRewind(v.indexer, target)
+			if step.Err == nil && needTransactionLog(v.indexer) && !r.store.ContainsTransactionLog() {
+				step.Err = errors.Wrap(ErrCannotRewind, "chain db does not store the transaction logs")
+			}
+		}
+		steps = append(steps, step)
+	}
+	// height-keyed stores follow the chain tip
+	for _, v := range r.rewinders {
+		steps = append(steps, &RollbackStep{Name: v.name, Height: tip})
+	}
+	return append(steps, &RollbackStep{Name: "chain db", Height: tip}), nil
+}
+
+// Rollback removes all blocks above the target height from the indexers, the
+// height-keyed stores and finally the chain db. Nothing is touched if any store
+// cannot be rolled back. Each block is removed from the indexers atomically,
+// and the reset indexers are rebuilt before the chain db is truncated, so an
+// interrupted rollback can be resumed by running it again.
+func (r *Rollbacker) Rollback(ctx context.Context, target uint64) error {
+	steps, err := r.Plan(target)
+	if err != nil {
+		return err
+	}
+	var refused []string
+	for _, s := range steps {
+		if s.Err != nil {
+			refused = append(refused, s.Name)
+		}
+	}
+	if len(refused) > 0 {
+		return errors.Wrapf(ErrCannotRewind, "cannot roll back %s to height %d", strings.Join(refused, ", "), target)
+	}
+	for _, v := range r.indexers {
+		if err := r.rewindIndexer(ctx, v, target); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.rewinders {
+		if err := v.rewinder.Rewind(ctx, target); err != nil {
+			return errors.Wrapf(err, "failed to rewind %s to height %d", v.name, target)
+		}
+		log.L().Info("rewound store", zap.String("store", v.name), zap.Uint64("height", target))
+	}
+	fd, ok := r.store.(filedao.FileDAO)
+	if !ok {
+		return errors.Wrap(ErrCannotRewind, "chain db does not support truncation")
+	}
+	if err := filedao.TruncateFileDAO(fd, target); err != nil {
+		return errors.Wrapf(err, "failed to truncate chain db to height %d", target)
+	}
+	log.L().Info("truncated chain db", zap.Uint64("height", target))
+	return nil
+}
+
+func checkRewind(indexer BlockIndexer, target uint64) error {
+	if _, ok := indexer.(BlockIndexerWithRewind); ok {
+		if checker, ok := indexer.(rewindChecker); ok {
+			if err := checker.CheckRewind(target); err != nil {
+				return errors.Wrap(ErrCannotRewind, err.Error())
+			}
+		}
+		return nil
+	}
+	if _, ok := indexer.(BlockIndexerWithReset); ok {
+		return nil
+	}
+	return ErrCannotRewind
+}
+
+func needTransactionLog(indexer BlockIndexer) bool {
+	indexerTL, ok := indexer.(BlockIndexerWithTransactionLog)
+	return ok && indexerTL.NeedTransactionLog()
+}
+
+func (r *Rollbacker) rewindIndexer(ctx context.Context, v namedIndexer, target uint64) error {
+	height, err := v.indexer.Height()
+	if err != nil {
+		return err
+	}
+	if height <= target {
+		return nil
+	}
+	indexer, ok := v.indexer.(BlockIndexerWithRewind)
+	if !ok {
+		return r.rebuildIndexer(ctx, v, target)
+	}
+	needLogs := needTransactionLog(v.indexer)
+	for ; height > target; height-- {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "terminate the rollback")
+		}
+		blk, err := r.store.GetBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		if blk.Receipts == nil {
+			if blk.Receipts, err = r.store.GetReceipts(height); err != nil {
+				return err
+			}
+			if needLogs {
+				if err := NewBlockIndexerChecker(r.store).loadTransactionLogs(blk); err != nil {
+					return err
+				}
+			}
+		}
+		if err := indexer.DeleteTipBlock(ctx, blk); err != nil {
+			return errors.Wrapf(err, "failed to delete block %d from %s", height, v.name)
+		}
+	}
+	log.L().Info("rewound indexer", zap.String("indexer", v.name), zap.Uint64("height", target))
+	return nil
+}
+
+// rebuildIndexer resets the indexer, and replays the blocks up to the target
+// height from the chain db. The ctx must carry the blockchain and genesis ctx.
+func (r *Rollbacker) rebuildIndexer(ctx context.Context, v namedIndexer, target uint64) error {
+	indexer := v.indexer.(BlockIndexerWithReset)
+	if err := indexer.Reset(ctx); err != nil {
+		return errors.Wrapf(err, "failed to reset %s", v.name)
+	}
+	if err := NewBlockIndexerChecker(r.store).CheckIndexer(ctx, indexer, target, nil); err != nil {
+		return errors.Wrapf(err, "failed to rebuild %s to height %d", v.name, target)
+	}
+	log.L().Info("rebuilt indexer", zap.String("indexer", v.name), zap.Uint64("height", target))
+	return nil
+}
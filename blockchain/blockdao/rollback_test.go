@@ -0,0 +1,306 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/test/mock/mock_blockdao"
+)
+
+type testRewindIndexer struct {
+	height  uint64
+	deleted []uint64
+}
+
+func (x *testRewindIndexer) Start(context.Context) error { return nil }
+
+func (x *testRewindIndexer) Stop(context.Context) error { return nil }
+
+func (x *testRewindIndexer) Height() (uint64, error) { return x.height, nil }
+
+func (x *testRewindIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.height = blk.Height()
+	return nil
+}
+
+func (x *testRewindIndexer) DeleteTipBlock(_ context.Context, blk *block.Block) error {
+	if blk.Height() != x.height {
+		return errors.Errorf("wrong block height %d, expecting %d", blk.Height(), x.height)
+	}
+	x.deleted = append(x.deleted, blk.Height())
+	x.height--
+	return nil
+}
+
+type testResetIndexer struct {
+	height uint64
+	puts   []uint64
+}
+
+func (x *testResetIndexer) Start(context.Context) error { return nil }
+
+func (x *testResetIndexer) Stop(context.Context) error { return nil }
+
+func (x *testResetIndexer) Height() (uint64, error) { return x.height, nil }
+
+func (x *testResetIndexer) StartHeight() uint64 { return 2 }
+
+func (x *testResetIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	x.height = blk.Height()
+	x.puts = append(x.puts, blk.Height())
+	return nil
+}
+
+func (x *testResetIndexer) Reset(context.Context) error {
+	x.height = 0
+	x.puts = nil
+	return nil
+}
+
+type testLogIndexer struct {
+	testRewindIndexer
+	logs []int
+}
+
+func (x *testLogIndexer) NeedTransactionLog() bool { return true }
+
+func (x *testLogIndexer) DeleteTipBlock(ctx context.Context, blk *block.Block) error {
+	var n int
+	for _, receipt := range blk.Receipts {
+		n += len(receipt.TransactionLogs())
+	}
+	x.logs = append(x.logs, n)
+	return x.testRewindIndexer.DeleteTipBlock(ctx, blk)
+}
+
+type testNoLogStore struct {
+	BlockStore
+}
+
+func (s *testNoLogStore) ContainsTransactionLog() bool { return false }
+
+// testFlushedStore reads the blocks as if they were flushed from the staging
+// buffer, the receipts do not carry the transaction logs
+type testFlushedStore struct {
+	filedao.FileDAO
+}
+
+func (s *testFlushedStore) GetBlockByHeight(height uint64) (*block.Block, error) {
+	blk, err := s.FileDAO.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	flushed := *blk
+	flushed.Receipts = nil
+	return &flushed, nil
+}
+
+func (s *testFlushedStore) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	receipts, err := s.FileDAO.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	flushed := make([]*action.Receipt, len(receipts))
+	for i, r := range receipts {
+		flushed[i] = &action.Receipt{Status: r.Status, BlockHeight: r.BlockHeight, ActionHash: r.ActionHash}
+	}
+	return flushed, nil
+}
+
+type testCheckedIndexer struct {
+	testRewindIndexer
+	bottom uint64
+}
+
+func (x *testCheckedIndexer) CheckRewind(target uint64) error {
+	if target < x.bottom {
+		return errors.Errorf("cannot rewind below %d", x.bottom)
+	}
+	return nil
+}
+
+func TestRollbacker(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	store, err := filedao.NewFileDAOInMemForTest()
+	r.NoError(err)
+	r.NoError(store.Start(ctx))
+	defer store.Stop(ctx)
+	cfg := db.DefaultConfig
+	cfg.DbPath = filepath.Join(t.TempDir(), "blob.db")
+	blobStore := NewBlobStore(db.NewBoltDB(cfg), 10)
+	r.NoError(blobStore.Start(ctx))
+	defer blobStore.Stop(ctx)
+	for _, blk := range getTestBlocks(t) {
+		r.NoError(store.PutBlock(ctx, blk))
+		r.NoError(blobStore.PutBlock(blk))
+	}
+
+	rewindable := &testRewindIndexer{height: 3}
+	behind := &testRewindIndexer{height: 1}
+	fixed := mock_blockdao.NewMockBlockIndexer(ctrl)
+	fixed.EXPECT().Height().Return(uint64(3), nil).AnyTimes()
+
+	rb := NewRollbacker(store)
+	rb.AddIndexer("index", rewindable)
+	rb.AddIndexer("behind", behind)
+	rb.AddIndexer("fixed", fixed)
+	rb.AddRewinder("blob", blobStore)
+
+	// target above the chain tip
+	_, err = rb.Plan(4)
+	r.Error(err)
+
+	steps, err := rb.Plan(1)
+	r.NoError(err)
+	r.Len(steps, 5)
+	for i, v := range []struct {
+		name   string
+		height uint64
+		err    error
+	}{
+		{"index", 3, nil},
+		{"behind", 1, nil},
+		{"fixed", 3, ErrCannotRewind},
+		{"blob", 3, nil},
+		{"chain db", 3, nil},
+	} {
+		r.Equal(v.name, steps[i].Name)
+		r.Equal(v.height, steps[i].Height)
+		r.Equal(v.err, steps[i].Err)
+	}
+
+	// refuse to roll back, nothing is touched
+	err = rb.Rollback(ctx, 1)
+	r.Equal(ErrCannotRewind, errors.Cause(err))
+	r.Contains(err.Error(), "fixed")
+	r.Empty(rewindable.deleted)
+	height, err := store.Height()
+	r.NoError(err)
+	r.EqualValues(3, height)
+
+	// the fixed indexer is not above the target
+	r.NoError(rb.Rollback(ctx, 3))
+	fixed2 := mock_blockdao.NewMockBlockIndexer(ctrl)
+	fixed2.EXPECT().Height().Return(uint64(0), nil).AnyTimes()
+	rb = NewRollbacker(store)
+	rb.AddIndexer("index", rewindable)
+	rb.AddIndexer("behind", behind)
+	rb.AddIndexer("fixed", fixed2)
+	rb.AddRewinder("blob", blobStore)
+	r.NoError(rb.Rollback(ctx, 1))
+	r.Equal([]uint64{3, 2}, rewindable.deleted)
+	r.Empty(behind.deleted)
+	height, err = store.Height()
+	r.NoError(err)
+	r.EqualValues(1, height)
+	r.EqualValues(1, blobStore.currWriteBlock)
+}
+
+func TestRollbackerRebuild(t *testing.T) {
+	r := require.New(t)
+	ctx := genesis.WithGenesisContext(protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{}), genesis.TestDefault())
+
+	store, err := filedao.NewFileDAOInMemForTest()
+	r.NoError(err)
+	r.NoError(store.Start(ctx))
+	defer store.Stop(ctx)
+	for _, blk := range getTestBlocks(t) {
+		r.NoError(store.PutBlock(ctx, blk))
+	}
+
+	rebuilt := &testResetIndexer{height: 3}
+	checked := &testCheckedIndexer{testRewindIndexer: testRewindIndexer{height: 3}, bottom: 2}
+	rb := NewRollbacker(store)
+	rb.AddIndexer("rebuilt", rebuilt)
+	rb.AddIndexer("checked", checked)
+
+	// the checked indexer cannot go below its bottom
+	steps, err := rb.Plan(1)
+	r.NoError(err)
+	r.NoError(steps[0].Err)
+	r.Equal(ErrCannotRewind, errors.Cause(steps[1].Err))
+	r.Equal(ErrCannotRewind, errors.Cause(rb.Rollback(ctx, 1)))
+	r.EqualValues(3, rebuilt.height)
+
+	// the indexer is reset and the blocks from its start height are replayed
+	r.NoError(rb.Rollback(ctx, 2))
+	r.Equal([]uint64{2}, rebuilt.puts)
+	r.Equal([]uint64{3}, checked.deleted)
+	height, err := store.Height()
+	r.NoError(err)
+	r.EqualValues(2, height)
+}
+
+func TestRollbackerTransactionLog(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	store, err := filedao.NewFileDAOInMemForTest()
+	r.NoError(err)
+	r.NoError(store.Start(ctx))
+	defer store.Stop(ctx)
+	for _, blk := range getTestBlocks(t) {
+		for _, act := range blk.Actions {
+			h, err := act.Hash()
+			r.NoError(err)
+			receipt := &action.Receipt{
+				Status:      uint64(iotextypes.ReceiptStatus_Success),
+				BlockHeight: blk.Height(),
+				ActionHash:  h,
+			}
+			blk.Receipts = append(blk.Receipts, receipt.AddTransactionLogs(&action.TransactionLog{
+				Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+				Sender:    act.SenderAddress().String(),
+				Recipient: identityset.Address(31).String(),
+				Amount:    big.NewInt(1),
+			}))
+		}
+		r.NoError(store.PutBlock(ctx, blk))
+	}
+
+	// the chain db without the transaction logs cannot roll back the indexer
+	indexer := &testLogIndexer{testRewindIndexer: testRewindIndexer{height: 3}}
+	rb := NewRollbacker(&testNoLogStore{store})
+	rb.AddIndexer("logs", indexer)
+	steps, err := rb.Plan(1)
+	r.NoError(err)
+	r.Equal(ErrCannotRewind, errors.Cause(steps[0].Err))
+	r.Equal(ErrCannotRewind, errors.Cause(rb.Rollback(ctx, 1)))
+	r.Empty(indexer.logs)
+
+	// the logs of each block are loaded before it is deleted from the indexer
+	rb = NewRollbacker(&testFlushedStore{store})
+	rb.AddIndexer("logs", indexer)
+	r.NoError(rb.Rollback(ctx, 2))
+	r.Equal([]uint64{3}, indexer.deleted)
+	r.Equal([]int{3}, indexer.logs)
+
+	// the blocks in the staging buffer carry the logs already
+	rb = NewRollbacker(store)
+	rb.AddIndexer("logs", indexer)
+	r.NoError(rb.Rollback(ctx, 1))
+	r.Equal([]uint64{3, 2}, indexer.deleted)
+	r.Equal([]int{3, 3}, indexer.logs)
+}
@@ -19,8 +19,10 @@ import (
 	"go.uber.org/config"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/signer"
 )
 
 type (
@@ -39,7 +41,10 @@ type (
 		ContractStakingIndexDBPath string           `yaml:"contractStakingIndexDBPath"`
 		BlobStoreDBPath            string           `yaml:"blobStoreDBPath"`
 		BlobStoreRetentionDays     uint32           `yaml:"blobStoreRetentionDays"`
+		StateDiffDBPath            string           `yaml:"stateDiffDBPath"`
+		StateDiffRetentionDays     uint32           `yaml:"stateDiffRetentionDays"`
 		HistoryIndexPath           string           `yaml:"historyIndexPath"`
+		BalanceHistoryIndexDBPath  string           `yaml:"balanceHistoryIndexDBPath"`
 		ID                         uint32           `yaml:"id"`
 		EVMNetworkID               uint32           `yaml:"evmNetworkID"`
 		Address                    string           `yaml:"address"`
@@ -55,7 +60,7 @@ type (
 		EnableTrielessStateDB bool `yaml:"enableTrielessStateDB"`
 		// EnableStateDBCaching enables cachedStateDBOption
 		EnableStateDBCaching bool `yaml:"enableStateDBCaching"`
-		// EnableArchiveMode is only meaningful when EnableTrielessStateDB is false
+		// EnableArchiveMode keeps the states of every height in a versioned pebble DB, pruned per DB.HistoryStateRetention
 		EnableArchiveMode bool `yaml:"enableArchiveMode"`
 		// EnableAsyncIndexWrite enables writing the block actions' and receipts' index asynchronously
 		EnableAsyncIndexWrite bool `yaml:"enableAsyncIndexWrite"`
@@ -85,6 +90,29 @@ type (
 		FactoryDBType string `yaml:"factoryDBType"`
 		// MintTimeout is the timeout for minting
 		MintTimeout time.Duration `yaml:"-"`
+		// BlockBuilder is the strategy to pick pending actions into a new block, one of "gasPrice",
+		// "effectiveTip" and "fcfs"
+		BlockBuilder string `yaml:"blockBuilder"`
+		// ReservedGas is the block gas reserved for staking and system staking contract actions
+		ReservedGas uint64 `yaml:"reservedGas"`
+		// ExecutionWorkers is the number of workers to execute the actions of a block optimistically
+		// in parallel, the actions are executed sequentially if it is less than 2
+		ExecutionWorkers int `yaml:"executionWorkers"`
+		// EnableStatePrefetch enables reading the states of a block received from peers, while its parent is
+		// being committed
+		EnableStatePrefetch bool `yaml:"enableStatePrefetch"`
+		// HistoryStateDepth is the max number of blocks below the tip, at which the states are reconstructed by
+		// reverting the recorded state diffs, it requires StateDiffDBPath and cannot exceed the blocks of
+		// StateDiffRetentionDays, 0 means disabled
+		HistoryStateDepth uint64 `yaml:"historyStateDepth"`
+		// HistoryStateCacheSize is the max number of the reconstructed history states kept in LRU cache
+		HistoryStateCacheSize int `yaml:"historyStateCacheSize"`
+		// ProducerBLSPrivKey is the comma-separated BLS private keys signing the commit endorsements to be aggregated,
+		// each of which belongs to the producer private key at the same position
+		ProducerBLSPrivKey string `yaml:"producerBLSPrivKey"`
+
+		// remoteKeys are the keys held by the remote signer, if the private key schema is remoteSigner
+		remoteKeys []crypto.PrivateKey
 	}
 )
 
@@ -103,6 +131,9 @@ var (
 		ContractStakingIndexDBPath: "/var/data/contractstaking.index.db",
 		BlobStoreDBPath:            "/var/data/blob.db",
 		BlobStoreRetentionDays:     21,
+		StateDiffDBPath:            "",
+		StateDiffRetentionDays:     7,
+		BalanceHistoryIndexDBPath:  "",
 		ID:                         1,
 		EVMNetworkID:               4689,
 		Address:                    "",
@@ -130,6 +161,12 @@ var (
 		FixAliasForNonStopHeight:      19778036,
 		FactoryDBType:                 db.DBBolt,
 		MintTimeout:                   700 * time.Millisecond,
+		BlockBuilder:                  "gasPrice",
+		ReservedGas:                   0,
+		ExecutionWorkers:              0,
+		EnableStatePrefetch:           false,
+		HistoryStateDepth:             0,
+		HistoryStateCacheSize:         16,
 	}
 
 	// ErrConfig config error
@@ -152,28 +189,58 @@ func (cfg *Config) ProducerAddress() []address.Address {
 
 // ProducerPrivateKeys returns the configured private keys
 func (cfg *Config) ProducerPrivateKeys() []crypto.PrivateKey {
-	pks := strings.Split(cfg.ProducerPrivKey, ",")
-	if len(pks) == 0 {
-		log.L().Panic("Error when decoding private key")
-	}
-	privateKeys := make([]crypto.PrivateKey, 0, len(pks))
-	for _, pk := range pks {
-		sk, err := crypto.HexStringToPrivateKey(pk)
-		if err != nil {
-			log.L().Panic(
-				"Error when decoding private key",
-				zap.Error(err),
-			)
+	var privateKeys []crypto.PrivateKey
+	if cfg.remoteKeys != nil {
+		privateKeys = cfg.remoteKeys
+	} else {
+		pks := strings.Split(cfg.ProducerPrivKey, ",")
+		if len(pks) == 0 {
+			log.L().Panic("Error when decoding private key")
 		}
-
+		privateKeys = make([]crypto.PrivateKey, 0, len(pks))
+		for _, pk := range pks {
+			sk, err := crypto.HexStringToPrivateKey(pk)
+			if err != nil {
+				log.L().Panic(
+					"Error when decoding private key",
+					zap.Error(err),
+				)
+			}
+			privateKeys = append(privateKeys, sk)
+		}
+	}
+	for _, sk := range privateKeys {
 		if !cfg.whitelistSignatureScheme(sk) {
 			log.L().Panic("The private key's signature scheme is not whitelisted")
 		}
+	}
+
+	start, end := cfg.producerKeyRange(len(privateKeys))
+	return privateKeys[start:end]
+}
+
+// ProducerBLSPrivateKeys returns the configured BLS private keys, in the same range as the producer private keys
+func (cfg *Config) ProducerBLSPrivateKeys() []*bls.PrivateKey {
+	if cfg.ProducerBLSPrivKey == "" {
+		return nil
+	}
+	sks := strings.Split(cfg.ProducerBLSPrivKey, ",")
+	privateKeys := make([]*bls.PrivateKey, 0, len(sks))
+	for _, s := range sks {
+		sk, err := bls.HexStringToPrivateKey(s)
+		if err != nil {
+			log.L().Panic("Error when decoding BLS private key", zap.Error(err))
+		}
 		privateKeys = append(privateKeys, sk)
 	}
+	start, end := cfg.producerKeyRange(len(privateKeys))
+	return privateKeys[start:end]
+}
 
+// producerKeyRange returns the range of the producer keys in use out of the size configured
+func (cfg *Config) producerKeyRange(size int) (int, int) {
 	if cfg.ProducerPrivKeyRange == "" {
-		return privateKeys
+		return 0, size
 	}
 	// Expecting format "[$start:$end]"
 	r := strings.Trim(cfg.ProducerPrivKeyRange, "[]")
@@ -181,7 +248,7 @@ func (cfg *Config) ProducerPrivateKeys() []crypto.PrivateKey {
 	if len(parts) != 2 {
 		log.L().Panic("invalid format", zap.String("ProducerPrivKeyRange", cfg.ProducerPrivKeyRange))
 	}
-	start, end := 0, len(privateKeys)
+	start, end := 0, size
 	var err error
 	if parts[0] != "" {
 		start, err = strconv.Atoi(parts[0])
@@ -195,11 +262,10 @@ func (cfg *Config) ProducerPrivateKeys() []crypto.PrivateKey {
 			log.L().Panic("invalid end", zap.String("end", parts[1]), zap.Error(err))
 		}
 	}
-	if start < 0 || end > len(privateKeys) || start > end {
-		log.L().Panic("ProducerPrivKeyRange out of bounds", zap.Int("start", start), zap.Int("end", end), zap.Int("len", len(privateKeys)))
+	if start < 0 || end > size || start > end {
+		log.L().Panic("ProducerPrivKeyRange out of bounds", zap.Int("start", start), zap.Int("end", end), zap.Int("len", size))
 	}
-
-	return privateKeys[start:end]
+	return start, end
 }
 
 // SetProducerPrivKey set producer privKey by PrivKeyConfigFile info
@@ -226,6 +292,23 @@ func (cfg *Config) SetProducerPrivKey() error {
 			return errors.Wrap(err, "failed to load producer private key")
 		}
 		cfg.ProducerPrivKey = key
+	case "remoteSigner":
+		yaml, err := config.NewYAML(config.Expand(os.LookupEnv), config.File(cfg.ProducerPrivKey))
+		if err != nil {
+			return errors.Wrap(err, "failed to init remote signer config")
+		}
+		signerCfg := signer.DefaultConfig
+		if err := yaml.Get(config.Root).Populate(&signerCfg); err != nil {
+			return errors.Wrap(err, "failed to unmarshal YAML config to remote signer config struct")
+		}
+		signers, err := signer.Dial(signerCfg)
+		if err != nil {
+			return errors.Wrap(err, "failed to connect to the remote signer")
+		}
+		cfg.remoteKeys = make([]crypto.PrivateKey, 0, len(signers))
+		for _, s := range signers {
+			cfg.remoteKeys = append(cfg.remoteKeys, signer.PrivateKey(s))
+		}
 	default:
 		return errors.Wrap(ErrConfig, "invalid private key schema")
 	}
@@ -250,10 +333,11 @@ func GenerateRandomKey(scheme string) string {
 func (cfg *Config) whitelistSignatureScheme(sk crypto.PrivateKey) bool {
 	var sigScheme string
 
-	switch sk.EcdsaPrivateKey().(type) {
-	case *ecdsa.PrivateKey:
+	// the key held by a remote signer is not accessible, its scheme is told by the public key
+	switch sk.PublicKey().EcdsaPublicKey().(type) {
+	case *ecdsa.PublicKey:
 		sigScheme = SigP256k1
-	case *crypto.P256sm2PrvKey:
+	case *crypto.P256sm2PubKey:
 		sigScheme = SigP256sm2
 	}
 
@@ -22,6 +22,14 @@ address: http://127.0.0.1:8200
 token: secret/data/test
 path: secret/data/test
 key: my key
+`
+
+	remoteSignerTestCfg = `
+endpoint: 127.0.0.1:9443
+timeout: 1s
+caCert: /nonexistent/ca.pem
+cert: /nonexistent/client.pem
+key: /nonexistent/client.key
 `
 
 	vaultTestKey   = "my key"
@@ -116,4 +124,19 @@ func TestSetProducerPrivKey(t *testing.T) {
 		err = cfg.SetProducerPrivKey()
 		r.Contains(err.Error(), "dial tcp 127.0.0.1:8200: connect: connection refused")
 	})
+	t.Run("PrivateConfigFileHasRemoteSigner", func(t *testing.T) {
+		cfg := DefaultConfig
+		tmp, err := os.CreateTemp("", testfile)
+		r.NoError(err)
+		defer os.Remove(tmp.Name())
+
+		_, err = tmp.WriteString(remoteSignerTestCfg)
+		r.NoError(err)
+		err = tmp.Close()
+		r.NoError(err)
+		cfg.ProducerPrivKey = tmp.Name()
+		cfg.ProducerPrivKeySchema = "remoteSigner"
+		err = cfg.SetProducerPrivKey()
+		r.Contains(err.Error(), "failed to load the client certificate")
+	})
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/iotexproject/go-pkgs/crypto"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 )
 
 func TestProducer(t *testing.T) {
@@ -110,3 +112,33 @@ func TestProducerPrivateKeys_RangeParsing(t *testing.T) {
 	_, panicked = getKeys(privKeys, "[1:5:7]")
 	r.True(panicked)
 }
+
+func TestProducerBLSPrivateKeys(t *testing.T) {
+	r := require.New(t)
+	cfg := DefaultConfig
+	r.Nil(cfg.ProducerBLSPrivateKeys())
+
+	var (
+		sks  []*bls.PrivateKey
+		strs []string
+	)
+	for i := 0; i < 3; i++ {
+		sk, err := bls.GenerateKey()
+		r.NoError(err)
+		sks = append(sks, sk)
+		strs = append(strs, sk.HexString())
+	}
+	cfg.ProducerBLSPrivKey = strings.Join(strs, ",")
+	keys := cfg.ProducerBLSPrivateKeys()
+	r.Len(keys, 3)
+	r.Equal(sks[2].Bytes(), keys[2].Bytes())
+
+	// the keys are in the same range as the producer keys
+	cfg.ProducerPrivKeyRange = "[1:3]"
+	keys = cfg.ProducerBLSPrivateKeys()
+	r.Len(keys, 2)
+	r.Equal(sks[1].Bytes(), keys[0].Bytes())
+
+	cfg.ProducerBLSPrivKey = "invalid"
+	r.Panics(func() { cfg.ProducerBLSPrivateKeys() })
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/pkg/compress"
+	"github.com/iotexproject/iotex-core/v2/pkg/unit"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+)
+
+var _erc20Transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// makeBlockBatch creates a batch of blocks with signed transfers and token
+// contract calls, and their receipts with event logs
+func makeBlockBatch(tb testing.TB, start, size uint64, numActions int) *stagingBuffer {
+	r := require.New(tb)
+	var (
+		buffer   = newStagingBuffer(size, start)
+		prev     = hash.ZeroHash256
+		contract = identityset.Address(31).String()
+		ts       = time.Unix(1700000000, 0)
+	)
+	for height := start; height < start+size; height++ {
+		var (
+			acts     []*action.SealedEnvelope
+			receipts []*action.Receipt
+		)
+		for i := 0; i < numActions; i++ {
+			var (
+				n      = int(height)*numActions + i
+				sender = n % 20
+				to     = identityset.Address((n + 7) % 20)
+				amount = unit.ConvertIotxToRau(int64(1 + n%1000))
+				nonce  = uint64(n/20 + 1)
+				selp   *action.SealedEnvelope
+				err    error
+			)
+			if i%2 == 0 {
+				selp, err = action.SignedTransfer(to.String(), identityset.PrivateKey(sender), nonce, amount, nil, 21000, big.NewInt(unit.Qev))
+			} else {
+				// transfer(address,uint256) of a token contract
+				data := append([]byte{0xa9, 0x05, 0x9c, 0xbb}, common.LeftPadBytes(to.Bytes(), 32)...)
+				data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+				selp, err = action.SignedExecution(contract, identityset.PrivateKey(sender), nonce, big.NewInt(0), 60000, big.NewInt(unit.Qev), data)
+			}
+			r.NoError(err)
+			h, err := selp.Hash()
+			r.NoError(err)
+			receipt := &action.Receipt{
+				Status:          1,
+				BlockHeight:     height,
+				ActionHash:      h,
+				GasConsumed:     21000 + uint64(i%2)*30000,
+				ContractAddress: contract,
+			}
+			if i%2 == 1 {
+				receipt.AddLogs(&action.Log{
+					Address: contract,
+					Topics: action.Topics{
+						hash.Hash256(_erc20Transfer),
+						hash.BytesToHash256(identityset.Address(sender).Bytes()),
+						hash.BytesToHash256(to.Bytes()),
+					},
+					Data:        common.LeftPadBytes(amount.Bytes(), 32),
+					BlockHeight: height,
+					ActionHash:  h,
+					Index:       uint32(i),
+					TxIndex:     uint32(i),
+				})
+			}
+			acts = append(acts, selp)
+			receipts = append(receipts, receipt)
+		}
+		blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(acts...).Build()).
+			SetHeight(height).
+			SetPrevBlockHash(prev).
+			SetTimestamp(ts.Add(time.Duration(height) * 5 * time.Second)).
+			SetReceipts(receipts).
+			SetReceiptRoot(block.CalculateReceiptRoot(receipts)).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		_, err = buffer.Put(height, &block.Store{Block: &blk, Receipts: receipts})
+		r.NoError(err)
+		prev = blk.HashBlock()
+	}
+	return buffer
+}
+
+type codec struct {
+	name   string
+	comp   func([]byte) ([]byte, error)
+	decomp func([]byte) ([]byte, error)
+}
+
+func testCodecs(tb testing.TB) []codec {
+	// dictionary is trained on a batch before the one being compressed
+	samples, err := makeBlockBatch(tb, 1, _blockStoreBatchSize, 20).Samples()
+	require.NoError(tb, err)
+	d, err := compress.TrainZstdDict(samples, compress.ZstdDictSize)
+	require.NoError(tb, err)
+	zd, err := compress.NewZstdCodec(d)
+	require.NoError(tb, err)
+	return []codec{
+		{compress.Gzip, compress.CompGzip, compress.DecompGzip},
+		{compress.Snappy, compress.CompSnappy, compress.DecompSnappy},
+		{compress.Zstd, compress.CompZstd, compress.DecompZstd},
+		{compress.ZstdDict, zd.Compress, zd.Decompress},
+	}
+}
+
+func TestBlockBatchCompression(t *testing.T) {
+	r := require.New(t)
+	ser, err := makeBlockBatch(t, _blockStoreBatchSize+1, _blockStoreBatchSize, 20).Serialize()
+	r.NoError(err)
+	for _, c := range testCodecs(t) {
+		compressed, err := c.comp(ser)
+		r.NoError(err)
+		r.Less(len(compressed), len(ser))
+		v, err := c.decomp(compressed)
+		r.NoError(err)
+		r.Equal(ser, v)
+		t.Logf("%-8s %d -> %d bytes", c.name, len(ser), len(compressed))
+	}
+}
+
+func BenchmarkBlockBatchCompression(b *testing.B) {
+	ser, err := makeBlockBatch(b, _blockStoreBatchSize+1, _blockStoreBatchSize, 20).Serialize()
+	require.NoError(b, err)
+	for _, c := range testCodecs(b) {
+		compressed, err := c.comp(ser)
+		require.NoError(b, err)
+		ratio := float64(len(ser)) / float64(len(compressed))
+		b.Run(c.name+"/compress", func(b *testing.B) {
+			b.SetBytes(int64(len(ser)))
+			b.ReportMetric(ratio, "ratio")
+			for i := 0; i < b.N; i++ {
+				if _, err := c.comp(ser); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(c.name+"/decompress", func(b *testing.B) {
+			b.SetBytes(int64(len(ser)))
+			b.ReportMetric(ratio, "ratio")
+			for i := 0; i < b.N; i++ {
+				if _, err := c.decomp(compressed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/routine"
 )
 
 const (
@@ -77,7 +78,9 @@ type (
 		cfg               db.Config
 		currFd            BaseFileDAO
 		legacyFd          FileDAO
-		v2Fd              *FileV2Manager // a collection of v2 db files
+		v2Fd              *FileV2Manager         // a collection of v2 db files
+		remote            *remoteFileManager     // offloads sealed v2 files to object storage
+		offloader         *routine.RecurringTask // offloads the files sealed while running
 		blockDeserializer *block.Deserializer
 	}
 )
@@ -126,6 +129,9 @@ func (fd *fileDAO) Start(ctx context.Context) error {
 		if err := fd.v2Fd.Start(ctx); err != nil {
 			return err
 		}
+		if err := fd.offload(ctx); err != nil {
+			return err
+		}
 	}
 
 	if fd.v2Fd != nil {
@@ -133,17 +139,56 @@ func (fd *fileDAO) Start(ctx context.Context) error {
 	} else {
 		fd.currFd = fd.legacyFd
 	}
+	if fd.remote != nil {
+		fd.offloader = routine.NewRecurringTask(func() {
+			if err := fd.offload(context.Background()); err != nil {
+				log.L().Error("Failed to offload chain db files.", zap.Error(err))
+			}
+		}, fd.cfg.BlockOffload.Interval)
+		return fd.offloader.Start(ctx)
+	}
 	return nil
 }
 
 func (fd *fileDAO) Stop(ctx context.Context) error {
+	if fd.offloader != nil {
+		if err := fd.offloader.Stop(ctx); err != nil {
+			return err
+		}
+	}
 	if fd.legacyFd != nil {
 		if err := fd.legacyFd.Stop(ctx); err != nil {
 			return err
 		}
 	}
 	if fd.v2Fd != nil {
-		return fd.v2Fd.Stop(ctx)
+		if err := fd.v2Fd.Stop(ctx); err != nil {
+			return err
+		}
+	}
+	if fd.remote != nil {
+		return fd.remote.Stop(ctx)
+	}
+	return nil
+}
+
+// offload uploads the sealed v2 files below the offload height to object
+// storage, the master file and the top file are always kept on local disk
+func (fd *fileDAO) offload(ctx context.Context) error {
+	if fd.remote == nil || fd.v2Fd == nil {
+		return nil
+	}
+	indices := fd.v2Fd.snapshot()
+	for i := 0; i < len(indices)-1; i++ {
+		local, ok := indices[i].fd.(*fileDAOv2)
+		if !ok || local.filename == fd.cfg.DbPath || indices[i].end >= fd.cfg.BlockOffload.Height {
+			continue
+		}
+		remote, err := fd.remote.offload(ctx, local, indices[i].start, indices[i].end)
+		if err != nil {
+			return errors.Wrapf(err, "failed to offload %s", local.filename)
+		}
+		fd.v2Fd.replaceFd(local, remote)
 	}
 	return nil
 }
@@ -357,7 +402,7 @@ func (fd *fileDAO) addNewV2File(height uint64) error {
 	}
 
 	// create v2 manager
-	fd.v2Fd, _ = newFileV2Manager([]*fileDAOv2{v2})
+	fd.v2Fd, _ = newFileV2Manager([]v2File{v2})
 	err = fd.v2Fd.Start(ctx)
 	return err
 }
@@ -369,8 +414,18 @@ func (fd *fileDAO) DeleteTipBlock() error {
 // CreateFileDAO creates FileDAO according to master file
 func CreateFileDAO(legacy bool, cfg db.Config, deser *block.Deserializer) (FileDAO, error) {
 	fd := fileDAO{splitHeight: 1, cfg: cfg, blockDeserializer: deser}
-	fds := []*fileDAOv2{}
+	fds := []v2File{}
 	v2Top, v2Files := checkAuxFiles(cfg.DbPath, FileV2)
+	if cfg.BlockOffload.Height > 0 {
+		remote, err := newRemoteFileManager(cfg, deser)
+		if err != nil {
+			return nil, err
+		}
+		if fds, err = remote.remoteFiles(); err != nil {
+			return nil, err
+		}
+		fd.remote = remote
+	}
 	if legacy {
 		legacyFd, err := newFileDAOLegacy(cfg, deser)
 		if err != nil {
@@ -380,7 +435,7 @@ func CreateFileDAO(legacy bool, cfg db.Config, deser *block.Deserializer) (FileD
 		fd.topIndex, _ = checkAuxFiles(cfg.DbPath, FileLegacyAuxiliary)
 
 		// legacy master file with no v2 files, early exit
-		if len(v2Files) == 0 {
+		if len(v2Files) == 0 && len(fds) == 0 {
 			return &fd, nil
 		}
 	} else {
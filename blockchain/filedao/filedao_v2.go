@@ -20,6 +20,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/compress"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 )
 
@@ -32,6 +33,7 @@ const (
 
 var (
 	_fileHeaderKey = []byte("fh")
+	_zstdDictKey   = []byte("zd")
 )
 
 type (
@@ -47,6 +49,7 @@ type (
 		hashStore       db.CountingIndex // store block hash
 		blkStore        db.CountingIndex // store raw blocks
 		sysStore        db.CountingIndex // store transaction log
+		zstd            *compress.ZstdCodec
 		deser           *block.Deserializer
 	}
 )
@@ -124,6 +127,10 @@ func (fd *fileDAOv2) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err = fd.loadZstdDict(); err != nil {
+		return errors.Wrap(err, "failed to load zstd dictionary")
+	}
+
 	// populate staging buffer
 	if fd.blkBuffer, err = fd.populateStagingBuffer(); err != nil {
 		return err
@@ -213,7 +220,7 @@ func (fd *fileDAOv2) TransactionLogs(height uint64) (*iotextypes.TransactionLogs
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get transaction log at height %d", height)
 	}
-	value, err = decompBytes(value, fd.header.Compressor)
+	value, err = fd.decompBytes(value)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get transaction log at height %d", height)
 	}
@@ -3,6 +3,7 @@ package filedao
 import (
 	"context"
 	"sort"
+	"sync"
 
 	"github.com/iotexproject/go-pkgs/hash"
 
@@ -13,17 +14,18 @@ import (
 type (
 	fileV2Index struct {
 		start, end uint64
-		fd         *fileDAOv2
+		fd         v2File
 	}
 
 	// FileV2Manager manages collection of v2 files
 	FileV2Manager struct {
+		lock    sync.RWMutex // guards Indices, a sealed file can be offloaded while running
 		Indices []*fileV2Index
 	}
 )
 
 // newFileV2Manager creates an instance of FileV2Manager
-func newFileV2Manager(fds []*fileDAOv2) (*FileV2Manager, error) {
+func newFileV2Manager(fds []v2File) (*FileV2Manager, error) {
 	if len(fds) == 0 {
 		return nil, ErrNotSupported
 	}
@@ -74,6 +76,8 @@ func (fm *FileV2Manager) Stop(ctx context.Context) error {
 
 // FileDAOByHeight returns FileDAO for the given height
 func (fm *FileV2Manager) FileDAOByHeight(height uint64) BaseFileDAO {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
 	if height == 0 {
 		return fm.Indices[0].fd
 	}
@@ -100,7 +104,7 @@ func (fm *FileV2Manager) FileDAOByHeight(height uint64) BaseFileDAO {
 
 // GetBlockHeight returns height by hash
 func (fm *FileV2Manager) GetBlockHeight(hash hash.Hash256) (uint64, error) {
-	for _, file := range fm.Indices {
+	for _, file := range fm.snapshot() {
 		if height, err := file.fd.GetBlockHeight(hash); err == nil {
 			return height, nil
 		}
@@ -110,7 +114,7 @@ func (fm *FileV2Manager) GetBlockHeight(hash hash.Hash256) (uint64, error) {
 
 // GetBlock returns block by hash
 func (fm *FileV2Manager) GetBlock(hash hash.Hash256) (*block.Block, error) {
-	for _, file := range fm.Indices {
+	for _, file := range fm.snapshot() {
 		if blk, err := file.fd.GetBlock(hash); err == nil {
 			return blk, nil
 		}
@@ -120,6 +124,8 @@ func (fm *FileV2Manager) GetBlock(hash hash.Hash256) (*block.Block, error) {
 
 // AddFileDAO add a new v2 file
 func (fm *FileV2Manager) AddFileDAO(fd *fileDAOv2, start uint64) error {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
 	// update current top's end
 	top := fm.Indices[len(fm.Indices)-1]
 	end, err := top.fd.Height()
@@ -138,6 +144,32 @@ func (fm *FileV2Manager) AddFileDAO(fd *fileDAOv2, start uint64) error {
 
 // TopFd returns the top (with maximum height) v2 file
 func (fm *FileV2Manager) TopFd() (BaseFileDAO, uint64) {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
 	top := fm.Indices[len(fm.Indices)-1]
 	return top.fd, top.start
 }
+
+// snapshot returns a copy of the indices, so the files can be read without
+// holding the lock
+func (fm *FileV2Manager) snapshot() []fileV2Index {
+	fm.lock.RLock()
+	defer fm.lock.RUnlock()
+	indices := make([]fileV2Index, len(fm.Indices))
+	for i := range fm.Indices {
+		indices[i] = *fm.Indices[i]
+	}
+	return indices
+}
+
+// replaceFd replaces a file with the same blocks stored elsewhere
+func (fm *FileV2Manager) replaceFd(old, fd v2File) {
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+	for _, index := range fm.Indices {
+		if index.fd == old {
+			index.fd = fd
+			return
+		}
+	}
+}
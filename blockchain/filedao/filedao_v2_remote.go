@@ -0,0 +1,525 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/bloom"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/objectstore"
+)
+
+const (
+	_manifestSuffix    = ".offload.json"
+	_bloomSuffix       = ".bloom"
+	_partSuffix        = ".part"
+	_downloadChunkSize = 16 << 20
+	// bloom filter of block hashes, 10 bits per block gives ~1% false positive
+	_bloomBitsPerBlock = 10
+	_bloomNumHash      = 7
+)
+
+type (
+	// v2File is a v2 chain db file managed by FileV2Manager
+	v2File interface {
+		BaseFileDAO
+		Bottom() (uint64, error)
+	}
+
+	// remoteFile is the manifest entry of an offloaded v2 file
+	remoteFile struct {
+		Name  string `json:"name"`
+		Start uint64 `json:"start"`
+		End   uint64 `json:"end"`
+		Size  int64  `json:"size"`
+	}
+
+	// remoteFileManager offloads sealed v2 files to object storage, and keeps
+	// the manifest of offloaded files next to the chain db file
+	remoteFileManager struct {
+		cfg      db.Config
+		manifest string
+		files    []*remoteFile
+		store    objectstore.Store
+		cache    *remoteFileCache
+	}
+
+	// fileDAOv2Remote is a sealed v2 file offloaded to object storage, which is
+	// downloaded into the local cache on demand
+	fileDAOv2Remote struct {
+		file  *remoteFile
+		bloom bloom.BloomFilter // block hashes in the file
+		cache *remoteFileCache
+	}
+
+	// remoteFileCache is an LRU of offloaded files downloaded to local disk
+	remoteFileCache struct {
+		lock     sync.Mutex
+		dir      string
+		capacity int
+		cfg      db.Config
+		store    objectstore.Store
+		deser    *block.Deserializer
+		files    map[string]*cachedFile
+		order    *list.List // front is the most recently used
+	}
+
+	cachedFile struct {
+		name  string
+		fd    *fileDAOv2
+		err   error
+		refs  int
+		ready chan struct{}
+		elem  *list.Element
+	}
+)
+
+func newRemoteFileManager(cfg db.Config, deser *block.Deserializer) (*remoteFileManager, error) {
+	store, err := objectstore.NewS3Store(cfg.BlockOffload.Store)
+	if err != nil {
+		return nil, err
+	}
+	dir := cfg.BlockOffload.CacheDir
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(cfg.DbPath), "offload")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create cache dir %s", dir)
+	}
+	capacity := cfg.BlockOffload.CacheSize
+	if capacity <= 0 {
+		capacity = 1
+	}
+	rm := remoteFileManager{
+		cfg:      cfg,
+		manifest: cfg.DbPath + _manifestSuffix,
+		store:    store,
+		cache: &remoteFileCache{
+			dir:      dir,
+			capacity: capacity,
+			cfg:      cfg,
+			store:    store,
+			deser:    deser,
+			files:    make(map[string]*cachedFile),
+			order:    list.New(),
+		},
+	}
+	data, err := os.ReadFile(rm.manifest)
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(data, &rm.files); err != nil {
+			return nil, errors.Wrapf(err, "failed to read manifest %s", rm.manifest)
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+	return &rm, nil
+}
+
+// remoteFiles returns the offloaded files which do not exist on local disk
+func (rm *remoteFileManager) remoteFiles() ([]v2File, error) {
+	var fds []v2File
+	dir := filepath.Dir(rm.cfg.DbPath)
+	for _, f := range rm.files {
+		if fileExists(filepath.Join(dir, f.Name)) == nil {
+			// offload was interrupted before the local file is removed
+			continue
+		}
+		fd, err := rm.newRemoteFd(f)
+		if err != nil {
+			return nil, err
+		}
+		fds = append(fds, fd)
+	}
+	return fds, nil
+}
+
+func (rm *remoteFileManager) newRemoteFd(f *remoteFile) (*fileDAOv2Remote, error) {
+	data, err := os.ReadFile(rm.bloomFileName(f.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read bloom filter of %s", f.Name)
+	}
+	bf, err := bloom.NewBloomFilter(_bloomBitsPerBlock, _bloomNumHash)
+	if err != nil {
+		return nil, err
+	}
+	if err = bf.FromBytes(data); err != nil {
+		return nil, errors.Wrapf(err, "invalid bloom filter of %s", f.Name)
+	}
+	return &fileDAOv2Remote{
+		file:  f,
+		bloom: bf,
+		cache: rm.cache,
+	}, nil
+}
+
+// offload uploads a sealed local file to object storage, and moves the local
+// file into the cache. The file is kept open and handed over to the cache, so
+// that the reads in progress on it are not affected
+func (rm *remoteFileManager) offload(ctx context.Context, fd *fileDAOv2, start, end uint64) (*fileDAOv2Remote, error) {
+	name := filepath.Base(fd.filename)
+	bf, err := bloom.NewBloomFilter((end-start+1)*_bloomBitsPerBlock, _bloomNumHash)
+	if err != nil {
+		return nil, err
+	}
+	for h := start; h <= end; h++ {
+		blkHash, err := fd.GetBlockHash(h)
+		if err != nil {
+			return nil, err
+		}
+		bf.Add(blkHash[:])
+	}
+	file, err := os.Open(fd.filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if err = rm.store.Put(ctx, name, file, info.Size()); err != nil {
+		return nil, errors.Wrapf(err, "failed to upload %s", name)
+	}
+	if err = os.WriteFile(rm.bloomFileName(name), bf.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+	rf := &remoteFile{Name: name, Start: start, End: end, Size: info.Size()}
+	files := []*remoteFile{}
+	for _, f := range rm.files {
+		if f.Name != name {
+			files = append(files, f)
+		}
+	}
+	files = append(files, rf)
+	if err = rm.writeManifest(files); err != nil {
+		return nil, err
+	}
+	rm.files = files
+	// keep the file as a cached copy, the cache evicts it when needed
+	if err = os.Rename(fd.filename, filepath.Join(rm.cache.dir, name)); err != nil {
+		if err = fd.Stop(ctx); err != nil {
+			return nil, err
+		}
+		if err = os.Remove(fd.filename); err != nil {
+			return nil, err
+		}
+	} else {
+		rm.cache.add(rf, fd)
+	}
+	log.L().Info("Offloaded chain db file.", zap.String("file", name), zap.Uint64("start", start), zap.Uint64("end", end))
+	return &fileDAOv2Remote{
+		file:  rf,
+		bloom: bf,
+		cache: rm.cache,
+	}, nil
+}
+
+func (rm *remoteFileManager) bloomFileName(name string) string {
+	return filepath.Join(filepath.Dir(rm.cfg.DbPath), name+_bloomSuffix)
+}
+
+func (rm *remoteFileManager) writeManifest(files []*remoteFile) error {
+	data, err := json.MarshalIndent(files, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := rm.manifest + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, rm.manifest)
+}
+
+// Stop closes all cached files
+func (rm *remoteFileManager) Stop(ctx context.Context) error {
+	return rm.cache.Stop(ctx)
+}
+
+func (fd *fileDAOv2Remote) Start(context.Context) error { return nil }
+
+func (fd *fileDAOv2Remote) Stop(context.Context) error { return nil }
+
+func (fd *fileDAOv2Remote) Height() (uint64, error) {
+	return fd.file.End, nil
+}
+
+func (fd *fileDAOv2Remote) Bottom() (uint64, error) {
+	return fd.file.Start, nil
+}
+
+func (fd *fileDAOv2Remote) ContainsHeight(height uint64) bool {
+	return fd.file.Start <= height && height <= fd.file.End
+}
+
+func (fd *fileDAOv2Remote) GetBlockHash(height uint64) (hash.Hash256, error) {
+	if height == 0 {
+		return block.GenesisHash(), nil
+	}
+	if !fd.ContainsHeight(height) {
+		return hash.ZeroHash256, db.ErrNotExist
+	}
+	var h hash.Hash256
+	err := fd.cache.withFile(fd.file, func(local *fileDAOv2) (err error) {
+		h, err = local.GetBlockHash(height)
+		return err
+	})
+	return h, err
+}
+
+func (fd *fileDAOv2Remote) GetBlockHeight(h hash.Hash256) (uint64, error) {
+	if h == block.GenesisHash() {
+		return 0, nil
+	}
+	if !fd.bloom.Exist(h[:]) {
+		return 0, errors.Wrap(db.ErrNotExist, "failed to get block height")
+	}
+	var height uint64
+	err := fd.cache.withFile(fd.file, func(local *fileDAOv2) (err error) {
+		height, err = local.GetBlockHeight(h)
+		return err
+	})
+	return height, err
+}
+
+func (fd *fileDAOv2Remote) GetBlock(h hash.Hash256) (*block.Block, error) {
+	height, err := fd.GetBlockHeight(h)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+	return fd.GetBlockByHeight(height)
+}
+
+func (fd *fileDAOv2Remote) GetBlockByHeight(height uint64) (*block.Block, error) {
+	if height == 0 {
+		return block.GenesisBlock(), nil
+	}
+	var blk *block.Block
+	err := fd.cache.withFile(fd.file, func(local *fileDAOv2) (err error) {
+		blk, err = local.GetBlockByHeight(height)
+		return err
+	})
+	return blk, err
+}
+
+func (fd *fileDAOv2Remote) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	var receipts []*action.Receipt
+	err := fd.cache.withFile(fd.file, func(local *fileDAOv2) (err error) {
+		receipts, err = local.GetReceipts(height)
+		return err
+	})
+	return receipts, err
+}
+
+func (fd *fileDAOv2Remote) ContainsTransactionLog() bool {
+	return true
+}
+
+func (fd *fileDAOv2Remote) TransactionLogs(height uint64) (*iotextypes.TransactionLogs, error) {
+	var logs *iotextypes.TransactionLogs
+	err := fd.cache.withFile(fd.file, func(local *fileDAOv2) (err error) {
+		logs, err = local.TransactionLogs(height)
+		return err
+	})
+	return logs, err
+}
+
+func (fd *fileDAOv2Remote) PutBlock(context.Context, *block.Block) error {
+	return ErrNotSupported
+}
+
+func (fd *fileDAOv2Remote) DeleteTipBlock() error {
+	return ErrNotSupported
+}
+
+// withFile calls fn with the local copy of the remote file, the file is
+// downloaded if not in cache, and will not be evicted while fn is running
+func (c *remoteFileCache) withFile(f *remoteFile, fn func(*fileDAOv2) error) error {
+	c.lock.Lock()
+	e, ok := c.files[f.Name]
+	if ok {
+		e.refs++
+		c.order.MoveToFront(e.elem)
+		c.lock.Unlock()
+		<-e.ready
+	} else {
+		e = &cachedFile{name: f.Name, refs: 1, ready: make(chan struct{})}
+		e.elem = c.order.PushFront(e)
+		c.files[f.Name] = e
+		c.lock.Unlock()
+		e.fd, e.err = c.load(f)
+		close(e.ready)
+	}
+	defer c.release(e)
+	if e.err != nil {
+		return e.err
+	}
+	return fn(e.fd)
+}
+
+// add registers an open file already moved into the cache dir, so it is
+// evicted like a downloaded one
+func (c *remoteFileCache) add(f *remoteFile, fd *fileDAOv2) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.files[f.Name]; ok {
+		if err := fd.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to close cached file.", zap.String("file", f.Name), zap.Error(err))
+		}
+		return
+	}
+	e := &cachedFile{name: f.Name, fd: fd, ready: make(chan struct{})}
+	close(e.ready)
+	e.elem = c.order.PushFront(e)
+	c.files[f.Name] = e
+	c.evict()
+}
+
+func (c *remoteFileCache) release(e *cachedFile) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e.refs--
+	if e.err != nil && c.files[e.name] == e {
+		// remove failed entry so the next access retries
+		c.order.Remove(e.elem)
+		delete(c.files, e.name)
+	}
+	c.evict()
+}
+
+// evict removes the least recently used files not in use, must be called with
+// lock held
+func (c *remoteFileCache) evict() {
+	for elem := c.order.Back(); elem != nil && len(c.files) > c.capacity; {
+		e := elem.Value.(*cachedFile)
+		elem = elem.Prev()
+		if e.refs > 0 {
+			continue
+		}
+		c.order.Remove(e.elem)
+		delete(c.files, e.name)
+		if err := e.fd.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to close cached file.", zap.String("file", e.name), zap.Error(err))
+		}
+		if err := os.Remove(filepath.Join(c.dir, e.name)); err != nil {
+			log.L().Error("Failed to remove cached file.", zap.String("file", e.name), zap.Error(err))
+		}
+	}
+}
+
+// load downloads the file if it is not on local disk, and opens it
+func (c *remoteFileCache) load(f *remoteFile) (*fileDAOv2, error) {
+	name := filepath.Join(c.dir, f.Name)
+	if info, err := os.Stat(name); err != nil || info.Size() != f.Size {
+		if err = c.download(f, name); err != nil {
+			return nil, errors.Wrapf(err, "failed to download %s", f.Name)
+		}
+	}
+	cfg := c.cfg
+	cfg.DbPath = name
+	fd := openFileDAOv2(cfg, c.deser)
+	if err := fd.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// download fetches the file by ranged reads, an interrupted download resumes
+// from the partially downloaded file
+func (c *remoteFileCache) download(f *remoteFile, name string) error {
+	part := name + _partSuffix
+	file, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+	if offset > f.Size {
+		offset = 0
+		if err = file.Truncate(0); err != nil {
+			return err
+		}
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	for offset < f.Size {
+		length := int64(_downloadChunkSize)
+		if offset+length > f.Size {
+			length = f.Size - offset
+		}
+		if err = c.downloadChunk(file, f.Name, offset, length); err != nil {
+			return err
+		}
+		offset += length
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(part, name)
+}
+
+// downloadChunk fetches a range of the file, each request is bounded by the
+// timeout of the object storage
+func (c *remoteFileCache) downloadChunk(w io.Writer, name string, offset, length int64) error {
+	timeout := c.cfg.BlockOffload.Store.Timeout
+	if timeout <= 0 {
+		timeout = objectstore.DefaultConfig.Timeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	rc, err := c.store.GetRange(ctx, name, offset, length)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		return err
+	}
+	if n != length {
+		return errors.Errorf("short read at offset %d, expect %d bytes, got %d", offset, length, n)
+	}
+	return nil
+}
+
+// Stop closes all cached files
+func (c *remoteFileCache) Stop(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for name, e := range c.files {
+		if e.fd != nil {
+			if err := e.fd.Stop(ctx); err != nil {
+				return err
+			}
+		}
+		delete(c.files, name)
+	}
+	c.order.Init()
+	return nil
+}
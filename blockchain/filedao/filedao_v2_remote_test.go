@@ -0,0 +1,196 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/objectstore"
+)
+
+func TestFileDAOOffload(t *testing.T) {
+	r := require.New(t)
+	fake, storeCfg := objectstore.NewFakeS3()
+	defer fake.Close()
+
+	dir := t.TempDir()
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(dir, "chain.db")
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	ctx := context.Background()
+
+	// block 1~10 in chain.db, 11~20 in chain-00000001.db, 21~30 in
+	// chain-00000002.db, 31~35 in chain-00000003.db
+	fd, err := NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	r.NoError(testCommitBlocks(t, fd, 1, 35, hash.ZeroHash256))
+	r.NoError(fd.Stop(ctx))
+	size := testDirSize(t, dir)
+
+	// restart with offload enabled
+	cfg.BlockOffload.Height = 32
+	cfg.BlockOffload.CacheSize = 1
+	cfg.BlockOffload.Store = storeCfg
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	// master file and top file stay local
+	for i, offloaded := range []bool{false, true, true, false} {
+		name := filepath.Join(dir, "chain.db")
+		if i > 0 {
+			name = kthAuxFileName(name, uint64(i))
+		}
+		_, ok := fake.Object(filepath.Base(name))
+		r.Equal(offloaded, ok)
+		r.Equal(offloaded, fileExists(name) != nil)
+	}
+	// offloaded files moved into the cache are bounded by the cache size
+	fm := fd.(*fileDAO)
+	r.Len(fm.remote.cache.files, 1)
+	r.Contains(fm.remote.cache.files, filepath.Base(kthAuxFileName(cfg.DbPath, 2)))
+	r.Error(fileExists(filepath.Join(dir, "offload", filepath.Base(kthAuxFileName(cfg.DbPath, 1)))))
+	r.Less(testDirSize(t, dir), size)
+	testVerifyChainDB(t, fd, 1, 35)
+	r.NoError(fd.Stop(ctx))
+
+	// restart again, the cached file was kept in cache dir
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	fm = fd.(*fileDAO)
+	r.EqualValues(3, fm.topIndex)
+	r.Len(fm.v2Fd.Indices, 4)
+	r.Len(fm.remote.cache.files, 0)
+	gets := fake.Requests[http.MethodGet]
+	blk, err := fd.GetBlockByHeight(15)
+	r.NoError(err)
+	r.EqualValues(15, blk.Height())
+	r.Equal(gets, fake.Requests[http.MethodGet])
+	// reading the other file evicts the least recently used one
+	_, err = fd.GetBlockByHeight(25)
+	r.NoError(err)
+	r.Len(fm.remote.cache.files, 1)
+	r.Contains(fm.remote.cache.files, filepath.Base(kthAuxFileName(cfg.DbPath, 2)))
+	r.Error(fileExists(filepath.Join(dir, "offload", filepath.Base(kthAuxFileName(cfg.DbPath, 1)))))
+	// evicted file is downloaded on demand
+	h, err := fd.GetBlockHash(16)
+	r.NoError(err)
+	r.Greater(fake.Requests[http.MethodGet], gets)
+	gets = fake.Requests[http.MethodGet]
+	height, err := fd.GetBlockHeight(h)
+	r.NoError(err)
+	r.EqualValues(16, height)
+	r.Equal(gets, fake.Requests[http.MethodGet])
+	// offloaded file is read-only
+	remote := fm.v2Fd.FileDAOByHeight(15)
+	r.Equal(ErrNotSupported, remote.PutBlock(ctx, blk))
+	r.Equal(ErrNotSupported, remote.DeleteTipBlock())
+	// unknown hash is filtered without download
+	gets = fake.Requests[http.MethodGet]
+	_, err = fd.GetBlockHeight(hash.Hash256b([]byte("unknown")))
+	r.Equal(db.ErrNotExist, errors.Cause(err))
+	r.Equal(gets, fake.Requests[http.MethodGet])
+	// new blocks are written to the top file
+	r.NoError(testCommitBlocks(t, fd, 36, 40, blk.HashBlock()))
+	height, err = fd.Height()
+	r.NoError(err)
+	r.EqualValues(40, height)
+	r.NoError(fd.Stop(ctx))
+}
+
+func TestFileDAOOffloadWhileRunning(t *testing.T) {
+	r := require.New(t)
+	fake, storeCfg := objectstore.NewFakeS3()
+	defer fake.Close()
+
+	dir := t.TempDir()
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(dir, "chain.db")
+	cfg.BlockOffload.Height = 32
+	cfg.BlockOffload.CacheSize = 1
+	cfg.BlockOffload.Interval = 10 * time.Millisecond
+	cfg.BlockOffload.Store = storeCfg
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	ctx := context.Background()
+
+	fd, err := NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer func() {
+		r.NoError(fd.Stop(ctx))
+	}()
+	// the files sealed after start are offloaded by the recurring task
+	r.NoError(testCommitBlocks(t, fd, 1, 35, hash.ZeroHash256))
+	r.Eventually(func() bool {
+		_, ok1 := fake.Object(filepath.Base(kthAuxFileName(cfg.DbPath, 1)))
+		_, ok2 := fake.Object(filepath.Base(kthAuxFileName(cfg.DbPath, 2)))
+		return ok1 && ok2
+	}, 5*time.Second, 10*time.Millisecond)
+	fm := fd.(*fileDAO)
+	r.Eventually(func() bool {
+		_, ok := fm.v2Fd.FileDAOByHeight(25).(*fileDAOv2Remote)
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	_, ok := fm.v2Fd.FileDAOByHeight(5).(*fileDAOv2)
+	r.True(ok)
+	_, ok = fm.v2Fd.FileDAOByHeight(35).(*fileDAOv2)
+	r.True(ok)
+	testVerifyChainDB(t, fd, 1, 35)
+}
+
+type stallingStore struct {
+	objectstore.Store
+}
+
+func (stallingStore) GetRange(ctx context.Context, _ string, _, _ int64) (io.ReadCloser, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("no deadline")
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestRemoteFileCacheDownloadTimeout(t *testing.T) {
+	r := require.New(t)
+	cfg := db.DefaultConfig
+	cfg.BlockOffload.Store.Timeout = 50 * time.Millisecond
+	c := &remoteFileCache{
+		dir:   t.TempDir(),
+		cfg:   cfg,
+		store: stallingStore{},
+	}
+	err := c.download(&remoteFile{Name: "chain-00000001.db", Size: 100}, filepath.Join(c.dir, "chain-00000001.db"))
+	r.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func testDirSize(t *testing.T, dir string) int64 {
+	var size int64
+	require.NoError(t, filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	}))
+	return size
+}
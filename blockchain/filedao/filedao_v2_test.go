@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -263,7 +264,7 @@ func TestNewFdInterface(t *testing.T) {
 	genesis.SetGenesisTimestamp(g.Timestamp)
 	block.LoadGenesisHash(&g)
 
-	for _, compress := range []string{"", compress.Snappy} {
+	for _, compress := range []string{"", compress.Snappy, compress.Zstd, compress.ZstdDict} {
 		for _, start := range []uint64{1, 5, _blockStoreBatchSize + 1, 4 * _blockStoreBatchSize} {
 			cfg.Compressor = compress
 			t.Run("test fileDAOv2 interface", func(t *testing.T) {
@@ -341,7 +342,7 @@ func TestNewFdStart(t *testing.T) {
 
 	cfg := db.DefaultConfig
 	cfg.DbPath = testPath
-	for _, compress := range []string{"", compress.Gzip} {
+	for _, compress := range []string{"", compress.Gzip, compress.ZstdDict} {
 		for _, start := range []uint64{1, 5, _blockStoreBatchSize + 1, 4 * _blockStoreBatchSize} {
 			cfg.Compressor = compress
 			t.Run("test fileDAOv2 start", func(t *testing.T) {
@@ -417,3 +418,49 @@ func TestBlockWithSidecar(t *testing.T) {
 		}
 	}
 }
+
+func TestFileDAOv2ZstdDict(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	block.LoadGenesisHash(&g)
+
+	cfg := db.DefaultConfig
+	cfg.DbPath = filepath.Join(t.TempDir(), "zstd.db")
+	cfg.Compressor = compress.ZstdDict
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	fd, err := newFileDAOv2(1, cfg, deser)
+	r.NoError(err)
+	ctx := context.Background()
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+
+	// dictionary is trained on the first full batch
+	r.NoError(testCommitBlocks(t, fd, 1, _blockStoreBatchSize-1, hash.ZeroHash256))
+	r.Nil(fd.zstd)
+	h, err := fd.GetBlockHash(_blockStoreBatchSize - 1)
+	r.NoError(err)
+	r.NoError(testCommitBlocks(t, fd, _blockStoreBatchSize, 2*_blockStoreBatchSize+3, h))
+	r.NotNil(fd.zstd)
+	d, err := fd.kvStore.Get(_headerDataNs, _zstdDictKey)
+	r.NoError(err)
+	r.Equal(fd.zstd.Bytes(), d)
+	r.NoError(fd.Stop(ctx))
+
+	// blocks compressed with and without the dictionary are readable after restart
+	fd = openFileDAOv2(cfg, deser)
+	r.NoError(fd.Start(ctx))
+	r.NotNil(fd.zstd)
+	r.Equal(compress.ZstdDict, fd.header.Compressor)
+	for i := uint64(1); i <= 2*_blockStoreBatchSize+3; i++ {
+		h, err := fd.GetBlockHash(i)
+		r.NoError(err)
+		blk, err := fd.GetBlockByHeight(i)
+		r.NoError(err)
+		r.Equal(h, blk.HashBlock())
+		receipts, err := fd.GetReceipts(i)
+		r.NoError(err)
+		r.Equal(i, receipts[0].BlockHeight)
+		_, err = fd.TransactionLogs(i)
+		r.NoError(err)
+	}
+}
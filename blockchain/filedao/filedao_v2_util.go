@@ -7,6 +7,7 @@ package filedao
 
 import (
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/db/batch"
 	"github.com/iotexproject/iotex-core/v2/pkg/compress"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 )
 
@@ -30,7 +32,7 @@ func (fd *fileDAOv2) populateStagingBuffer() (*stagingBuffer, error) {
 			return nil, err
 		}
 
-		v, err = decompBytes(v, fd.header.Compressor)
+		v, err = fd.decompBytes(v)
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +83,7 @@ func (fd *fileDAOv2) putBlock(blk *block.Block) error {
 	if err != nil {
 		return err
 	}
-	blkBytes, err := compBytes(ser, fd.header.Compressor)
+	blkBytes, err := fd.compBytes(ser)
 	if err != nil {
 		return err
 	}
@@ -98,10 +100,13 @@ func (fd *fileDAOv2) putBlock(blk *block.Block) error {
 	}
 
 	// pack blocks together, write to block store
+	if err = fd.trainZstdDict(); err != nil {
+		return err
+	}
 	if ser, err = fd.blkBuffer.Serialize(); err != nil {
 		return err
 	}
-	if blkBytes, err = compBytes(ser, fd.header.Compressor); err != nil {
+	if blkBytes, err = fd.compBytes(ser); err != nil {
 		return err
 	}
 	return addOneEntryToBatch(fd.blkStore, blkBytes, fd.batch)
@@ -112,7 +117,7 @@ func (fd *fileDAOv2) putTransactionLog(blk *block.Block) error {
 	if sysLog == nil {
 		sysLog = &block.BlkTransactionLog{}
 	}
-	logBytes, err := compBytes(sysLog.Serialize(), fd.header.Compressor)
+	logBytes, err := fd.compBytes(sysLog.Serialize())
 	if err != nil {
 		return err
 	}
@@ -129,18 +134,77 @@ func addOneEntryToBatch(c db.CountingIndex, v []byte, b batch.KVStoreBatch) erro
 	return c.Finalize()
 }
 
-func compBytes(v []byte, comp string) ([]byte, error) {
-	if comp != "" {
-		return compress.Compress(v, comp)
+func (fd *fileDAOv2) compBytes(v []byte) ([]byte, error) {
+	switch fd.header.Compressor {
+	case "":
+		return v, nil
+	case compress.ZstdDict:
+		// blocks before the dictionary is trained use plain zstd
+		if fd.zstd != nil {
+			return fd.zstd.Compress(v)
+		}
+		return compress.CompZstd(v)
+	default:
+		return compress.Compress(v, fd.header.Compressor)
+	}
+}
+
+func (fd *fileDAOv2) decompBytes(v []byte) ([]byte, error) {
+	switch fd.header.Compressor {
+	case "":
+		return v, nil
+	case compress.ZstdDict:
+		if fd.zstd != nil {
+			return fd.zstd.Decompress(v)
+		}
+		return compress.DecompZstd(v)
+	default:
+		return compress.Decompress(v, fd.header.Compressor)
+	}
+}
+
+// loadZstdDict loads the zstd dictionary of the file, if it has been trained
+func (fd *fileDAOv2) loadZstdDict() error {
+	if fd.header.Compressor != compress.ZstdDict {
+		return nil
+	}
+	d, err := fd.kvStore.Get(_headerDataNs, _zstdDictKey)
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return nil
+	default:
+		return err
 	}
-	return v, nil
+	fd.zstd, err = compress.NewZstdCodec(d)
+	return err
 }
 
-func decompBytes(v []byte, comp string) ([]byte, error) {
-	if comp != "" {
-		return compress.Decompress(v, comp)
+// trainZstdDict trains the zstd dictionary on the first full batch of blocks.
+// The dictionary is written before any data compressed with it.
+func (fd *fileDAOv2) trainZstdDict() error {
+	if fd.header.Compressor != compress.ZstdDict || fd.zstd != nil {
+		return nil
 	}
-	return v, nil
+	samples, err := fd.blkBuffer.Samples()
+	if err != nil {
+		return err
+	}
+	d, err := compress.TrainZstdDict(samples, compress.ZstdDictSize)
+	if err != nil {
+		// not enough data to train, try again on next batch
+		log.L().Debug("failed to train zstd dictionary", zap.Error(err))
+		return nil
+	}
+	codec, err := compress.NewZstdCodec(d)
+	if err != nil {
+		return err
+	}
+	if err := fd.kvStore.Put(_headerDataNs, _zstdDictKey, d); err != nil {
+		return errors.Wrap(err, "failed to put zstd dictionary")
+	}
+	fd.zstd = codec
+	return nil
 }
 
 // blockStoreKey is the slot of block in block storage (each item containing blockStorageBatchSize of blocks)
@@ -223,7 +287,7 @@ func (fd *fileDAOv2) getBlockStore(height uint64) (*iotextypes.BlockStore, error
 	if err != nil {
 		return nil, err
 	}
-	value, err = decompBytes(value, fd.header.Compressor)
+	value, err = fd.decompBytes(value)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,253 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+)
+
+type (
+	// BlockChecker checks a block read from the chain db against other stores,
+	// like the block indexer or blob store
+	BlockChecker func(*block.Block) error
+
+	// FileReport is the verification result of a chain db file
+	FileReport struct {
+		Name  string
+		Start uint64
+		End   uint64
+		// BadHeight is the first corrupted height in the file, 0 if the file is intact
+		BadHeight uint64
+		Err       error
+	}
+
+	// VerifyReport is the verification result of the chain db
+	VerifyReport struct {
+		Files []*FileReport
+		// LastGood is the height up to which all blocks are intact
+		LastGood uint64
+	}
+
+	fileSegment struct {
+		name       string
+		start, end uint64
+		fd         BaseFileDAO
+	}
+)
+
+// VerifyFileDAO walks every block in the legacy and v2 files, recomputes the
+// tx root, receipt root and block hash, and checks each block links to its
+// parent. The checkers are called on every block that passes the checks.
+func VerifyFileDAO(fd FileDAO, checkers ...BlockChecker) (*VerifyReport, error) {
+	segments, err := fileSegments(fd)
+	if err != nil {
+		return nil, err
+	}
+	report := &VerifyReport{}
+	intact := true
+	for _, seg := range segments {
+		fr := &FileReport{Name: seg.name, Start: seg.start, End: seg.end}
+		for h := seg.start; h <= seg.end; h++ {
+			if err := verifyBlock(fd, seg.fd, h, checkers); err != nil {
+				fr.BadHeight, fr.Err = h, err
+				break
+			}
+		}
+		if intact {
+			if fr.BadHeight == 0 {
+				report.LastGood = seg.end
+			} else {
+				report.LastGood = fr.BadHeight - 1
+				intact = false
+			}
+		}
+		report.Files = append(report.Files, fr)
+	}
+	return report, nil
+}
+
+func verifyBlock(fd FileDAO, seg BaseFileDAO, height uint64, checkers []BlockChecker) error {
+	blk, err := seg.GetBlockByHeight(height)
+	if err != nil {
+		return err
+	}
+	if blk.Height() != height {
+		return errors.Wrapf(ErrDataCorruption, "block at height %d has height %d", height, blk.Height())
+	}
+	if err := blk.VerifyTxRoot(); err != nil {
+		return errors.Wrapf(err, "failed to verify tx root at height %d", height)
+	}
+	receipts, err := seg.GetReceipts(height)
+	if err != nil {
+		return err
+	}
+	if !blk.VerifyReceiptRoot(block.CalculateReceiptRoot(receipts)) {
+		return errors.Wrapf(block.ErrReceiptRootMismatch, "height %d", height)
+	}
+	if !blk.VerifySignature() {
+		return errors.Wrapf(ErrDataCorruption, "invalid block signature at height %d", height)
+	}
+	// block hash must match the height <-> hash mapping
+	h := blk.HashBlock()
+	stored, err := seg.GetBlockHash(height)
+	if err != nil {
+		return err
+	}
+	if stored != h {
+		return errors.Wrapf(ErrDataCorruption, "block hash at height %d = %x, stored %x", height, h, stored)
+	}
+	mapped, err := seg.GetBlockHeight(h)
+	if err != nil {
+		return err
+	}
+	if mapped != height {
+		return errors.Wrapf(ErrDataCorruption, "block hash %x maps to height %d, expect %d", h, mapped, height)
+	}
+	// prev hash must link to the parent
+	parent := block.GenesisHash()
+	if height > 1 {
+		if parent, err = fd.GetBlockHash(height - 1); err != nil {
+			return err
+		}
+	}
+	if blk.PrevHash() != parent {
+		return errors.Wrapf(ErrDataCorruption, "prev hash at height %d = %x, parent hash %x", height, blk.PrevHash(), parent)
+	}
+	for _, check := range checkers {
+		if err := check(blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileSegments returns the chain db files with the heights stored in them
+func fileSegments(fd FileDAO) ([]*fileSegment, error) {
+	tip, err := fd.Height()
+	if err != nil {
+		return nil, err
+	}
+	fm, ok := fd.(*fileDAO)
+	if !ok {
+		return []*fileSegment{{start: 1, end: tip, fd: fd}}, nil
+	}
+	var segments []*fileSegment
+	if fm.legacyFd != nil {
+		end := tip
+		if fm.v2Fd != nil {
+			end = fm.v2Fd.Indices[0].start - 1
+		}
+		segments = append(segments, &fileSegment{name: fm.cfg.DbPath, start: 1, end: end, fd: fm.legacyFd})
+	}
+	if fm.v2Fd != nil {
+		for _, v := range fm.v2Fd.Indices {
+			// the end of top file grows as blocks are committed
+			end, err := v.fd.Height()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, &fileSegment{name: v2FileName(v.fd), start: v.start, end: end, fd: v.fd})
+		}
+	}
+	return segments, nil
+}
+
+func v2FileName(fd v2File) string {
+	switch v := fd.(type) {
+	case *fileDAOv2:
+		return v.filename
+	case *fileDAOv2Remote:
+		return v.file.Name
+	default:
+		return ""
+	}
+}
+
+// TruncateFileDAO removes all blocks above the height from the chain db. v2
+// files lying entirely above the height are deleted, except the master file.
+// Files offloaded to object storage cannot be truncated.
+func TruncateFileDAO(fd FileDAO, height uint64) error {
+	fm, ok := fd.(*fileDAO)
+	if !ok {
+		return truncateTip(fd, height)
+	}
+	fm.lock.Lock()
+	defer fm.lock.Unlock()
+
+	if fm.v2Fd != nil {
+		for i := len(fm.v2Fd.Indices) - 1; i >= 0; i-- {
+			v := fm.v2Fd.Indices[i]
+			if v.end <= height {
+				break
+			}
+			local, ok := v.fd.(*fileDAOv2)
+			if !ok {
+				return errors.Wrapf(ErrNotSupported, "cannot truncate offloaded file %s", v2FileName(v.fd))
+			}
+			if v.start <= height || (i == 0 && fm.legacyFd == nil) {
+				// the file keeps blocks up to height, or is the master file
+				if err := truncateTip(local, height); err != nil {
+					return err
+				}
+				end, err := local.Height()
+				if err != nil {
+					return err
+				}
+				v.end = end
+				break
+			}
+			if err := local.Stop(context.Background()); err != nil {
+				return err
+			}
+			if err := os.Remove(local.filename); err != nil {
+				return errors.Wrapf(err, "failed to remove file %s", local.filename)
+			}
+			fm.v2Fd.Indices = fm.v2Fd.Indices[:i]
+		}
+		if len(fm.v2Fd.Indices) == 0 {
+			fm.v2Fd = nil
+		}
+	}
+	if fm.v2Fd == nil {
+		if err := truncateTip(fm.legacyFd, height); err != nil {
+			return err
+		}
+		fm.currFd, fm.splitHeight = fm.legacyFd, 1
+		fm.topIndex = fm.legacyFd.(*fileDAOLegacy).topIndex.Load().(uint64)
+		return nil
+	}
+	// the remaining top file decides the top index, files are still open so
+	// checkAuxFiles() cannot be used here
+	fm.currFd, fm.splitHeight = fm.v2Fd.TopFd()
+	top := fm.v2Fd.Indices[len(fm.v2Fd.Indices)-1].fd
+	if index, ok := isAuxFile(filepath.Base(v2FileName(top)), filepath.Base(fm.cfg.DbPath)); ok {
+		fm.topIndex = index
+	} else {
+		fm.topIndex = 0
+	}
+	return nil
+}
+
+func truncateTip(fd BaseFileDAO, height uint64) error {
+	for {
+		tip, err := fd.Height()
+		if err != nil {
+			return err
+		}
+		if tip <= height {
+			return nil
+		}
+		if err := fd.DeleteTipBlock(); err != nil {
+			return errors.Wrapf(err, "failed to delete block at height %d", tip)
+		}
+	}
+}
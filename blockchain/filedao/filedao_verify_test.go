@@ -0,0 +1,131 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package filedao
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+func createVerifiableBlock(height uint64, prev hash.Hash256) (*block.Block, error) {
+	receipts := []*action.Receipt{{Status: 1, BlockHeight: height, ActionHash: prev}}
+	blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().Build()).
+		SetHeight(height).
+		SetPrevBlockHash(prev).
+		SetTimestamp(testutil.TimestampNow().UTC()).
+		SetReceipts(receipts).
+		SetReceiptRoot(block.CalculateReceiptRoot(receipts)).
+		SignAndBuild(identityset.PrivateKey(27))
+	if err != nil {
+		return nil, err
+	}
+	return &blk, nil
+}
+
+func commitVerifiableBlocks(fd FileDAO, start, end uint64, prev hash.Hash256) error {
+	for i := start; i <= end; i++ {
+		blk, err := createVerifiableBlock(i, prev)
+		if err != nil {
+			return err
+		}
+		if err := fd.PutBlock(context.Background(), blk); err != nil {
+			return err
+		}
+		prev = blk.HashBlock()
+	}
+	return nil
+}
+
+func TestVerifyFileDAO(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	block.LoadGenesisHash(&g)
+
+	dir := t.TempDir()
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(dir, "chain.db")
+	ctx := context.Background()
+	fd, err := NewFileDAO(cfg, block.NewDeserializer(_defaultEVMNetworkID))
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+
+	// block 15 does not link to its parent
+	r.NoError(commitVerifiableBlocks(fd, 1, 14, block.GenesisHash()))
+	r.NoError(commitVerifiableBlocks(fd, 15, 25, hash.ZeroHash256))
+
+	report, err := VerifyFileDAO(fd)
+	r.NoError(err)
+	r.Len(report.Files, 3)
+	r.EqualValues(14, report.LastGood)
+	for i, v := range []struct {
+		name             string
+		start, end, fail uint64
+	}{
+		{"chain.db", 1, 10, 0},
+		{"chain-00000001.db", 11, 20, 15},
+		{"chain-00000002.db", 21, 25, 0},
+	} {
+		fr := report.Files[i]
+		r.Equal(filepath.Join(dir, v.name), fr.Name)
+		r.Equal(v.start, fr.Start)
+		r.Equal(v.end, fr.End)
+		r.Equal(v.fail, fr.BadHeight)
+		if v.fail > 0 {
+			r.Equal(ErrDataCorruption, errors.Cause(fr.Err))
+		}
+	}
+
+	// checker reports the first bad height per file
+	errBad := errors.New("bad block")
+	report, err = VerifyFileDAO(fd, func(blk *block.Block) error {
+		if blk.Height() == 8 || blk.Height() == 22 {
+			return errBad
+		}
+		return nil
+	})
+	r.NoError(err)
+	r.EqualValues(7, report.LastGood)
+	r.EqualValues(8, report.Files[0].BadHeight)
+	r.Equal(errBad, report.Files[0].Err)
+	r.EqualValues(15, report.Files[1].BadHeight)
+	r.EqualValues(22, report.Files[2].BadHeight)
+
+	// truncate to the last good height, chain-00000002.db is removed
+	r.NoError(TruncateFileDAO(fd, 14))
+	r.Error(fileExists(kthAuxFileName(cfg.DbPath, 2)))
+	height, err := fd.Height()
+	r.NoError(err)
+	r.EqualValues(14, height)
+	report, err = VerifyFileDAO(fd)
+	r.NoError(err)
+	r.Len(report.Files, 2)
+	r.EqualValues(14, report.LastGood)
+	r.Zero(report.Files[1].BadHeight)
+
+	// chain db continues to grow after truncation
+	prev, err := fd.GetBlockHash(14)
+	r.NoError(err)
+	r.NoError(commitVerifiableBlocks(fd, 15, 22, prev))
+	r.NoError(fileExists(kthAuxFileName(cfg.DbPath, 2)))
+	report, err = VerifyFileDAO(fd)
+	r.NoError(err)
+	r.Len(report.Files, 3)
+	r.EqualValues(22, report.LastGood)
+}
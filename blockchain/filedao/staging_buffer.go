@@ -69,3 +69,22 @@ func (s *stagingBuffer) Serialize() ([]byte, error) {
 	}
 	return proto.Marshal(allBlks)
 }
+
+// Samples returns the serialized blocks in the buffer, to train a compression
+// dictionary
+func (s *stagingBuffer) Samples() ([][]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	samples := make([][]byte, 0, len(s.buffer))
+	for _, v := range s.buffer {
+		if v == nil {
+			continue
+		}
+		ser, err := proto.Marshal(v.ToProtoWithoutSidecar())
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, ser)
+	}
+	return samples, nil
+}
@@ -79,6 +79,7 @@ func defaultConfig() Genesis {
 			VanuatuBlockHeight:        33730921,
 			WakeBlockHeight:           36893881,
 			ToBeEnabledBlockHeight:    math.MaxUint64,
+			BLSEndorsementBlockHeight: math.MaxUint64,
 		},
 		Account: Account{
 			InitBalanceMap:          map[string]string{},
@@ -371,6 +372,10 @@ type (
 		// ToBeEnabledBlockHeight is a fake height that acts as a gating factor for WIP features
 		// upon next release, change IsToBeEnabled() to IsNextHeight() for features to be released
 		ToBeEnabledBlockHeight uint64 `yaml:"toBeEnabledHeight"`
+		// BLSEndorsementBlockHeight is the start height to
+		// 1. register the BLS public keys of the candidates
+		// 2. aggregate the BLS signatures of the commit endorsements in the block footer
+		BLSEndorsementBlockHeight uint64 `yaml:"blsEndorsementHeight"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -752,6 +757,11 @@ func (g *Blockchain) IsToBeEnabled(height uint64) bool {
 	return g.isPost(g.ToBeEnabledBlockHeight, height)
 }
 
+// IsBLSEndorsement checks whether height is equal to or larger than the BLS endorsement height
+func (g *Blockchain) IsBLSEndorsement(height uint64) bool {
+	return g.isPost(g.BLSEndorsementBlockHeight, height)
+}
+
 // BlockGasLimitByHeight returns the block gas limit by height
 func (g *Blockchain) BlockGasLimitByHeight(height uint64) uint64 {
 	if g.isPost(g.WakeBlockHeight, height) {
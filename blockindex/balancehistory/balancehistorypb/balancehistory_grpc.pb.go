@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.1
+// source: balancehistory.proto
+
+package balancehistorypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BalanceHistoryServiceClient is the client API for BalanceHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BalanceHistoryServiceClient interface {
+	GetBalanceChanges(ctx context.Context, in *GetBalanceChangesRequest, opts ...grpc.CallOption) (*GetBalanceChangesResponse, error)
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error)
+	GetHeightByTimestamp(ctx context.Context, in *GetHeightByTimestampRequest, opts ...grpc.CallOption) (*GetHeightByTimestampResponse, error)
+}
+
+type balanceHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBalanceHistoryServiceClient(cc grpc.ClientConnInterface) BalanceHistoryServiceClient {
+	return &balanceHistoryServiceClient{cc}
+}
+
+func (c *balanceHistoryServiceClient) GetBalanceChanges(ctx context.Context, in *GetBalanceChangesRequest, opts ...grpc.CallOption) (*GetBalanceChangesResponse, error) {
+	out := new(GetBalanceChangesResponse)
+	err := c.cc.Invoke(ctx, "/balancehistorypb.BalanceHistoryService/GetBalanceChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *balanceHistoryServiceClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error) {
+	out := new(GetBalanceAtResponse)
+	err := c.cc.Invoke(ctx, "/balancehistorypb.BalanceHistoryService/GetBalanceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *balanceHistoryServiceClient) GetHeightByTimestamp(ctx context.Context, in *GetHeightByTimestampRequest, opts ...grpc.CallOption) (*GetHeightByTimestampResponse, error) {
+	out := new(GetHeightByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/balancehistorypb.BalanceHistoryService/GetHeightByTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BalanceHistoryServiceServer is the server API for BalanceHistoryService service.
+// All implementations should embed UnimplementedBalanceHistoryServiceServer
+// for forward compatibility
+type BalanceHistoryServiceServer interface {
+	GetBalanceChanges(context.Context, *GetBalanceChangesRequest) (*GetBalanceChangesResponse, error)
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error)
+	GetHeightByTimestamp(context.Context, *GetHeightByTimestampRequest) (*GetHeightByTimestampResponse, error)
+}
+
+// UnimplementedBalanceHistoryServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBalanceHistoryServiceServer struct {
+}
+
+func (UnimplementedBalanceHistoryServiceServer) GetBalanceChanges(context.Context, *GetBalanceChangesRequest) (*GetBalanceChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceChanges not implemented")
+}
+func (UnimplementedBalanceHistoryServiceServer) GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedBalanceHistoryServiceServer) GetHeightByTimestamp(context.Context, *GetHeightByTimestampRequest) (*GetHeightByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeightByTimestamp not implemented")
+}
+
+// UnsafeBalanceHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BalanceHistoryServiceServer will
+// result in compilation errors.
+type UnsafeBalanceHistoryServiceServer interface {
+	mustEmbedUnimplementedBalanceHistoryServiceServer()
+}
+
+func RegisterBalanceHistoryServiceServer(s grpc.ServiceRegistrar, srv BalanceHistoryServiceServer) {
+	s.RegisterService(&BalanceHistoryService_ServiceDesc, srv)
+}
+
+func _BalanceHistoryService_GetBalanceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceHistoryServiceServer).GetBalanceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/balancehistorypb.BalanceHistoryService/GetBalanceChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceHistoryServiceServer).GetBalanceChanges(ctx, req.(*GetBalanceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BalanceHistoryService_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceHistoryServiceServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/balancehistorypb.BalanceHistoryService/GetBalanceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceHistoryServiceServer).GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BalanceHistoryService_GetHeightByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeightByTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceHistoryServiceServer).GetHeightByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/balancehistorypb.BalanceHistoryService/GetHeightByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceHistoryServiceServer).GetHeightByTimestamp(ctx, req.(*GetHeightByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BalanceHistoryService_ServiceDesc is the grpc.ServiceDesc for BalanceHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BalanceHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "balancehistorypb.BalanceHistoryService",
+	HandlerType: (*BalanceHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBalanceChanges",
+			Handler:    _BalanceHistoryService_GetBalanceChanges_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _BalanceHistoryService_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "GetHeightByTimestamp",
+			Handler:    _BalanceHistoryService_GetHeightByTimestamp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "balancehistory.proto",
+}
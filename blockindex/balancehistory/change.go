@@ -0,0 +1,64 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package balancehistory
+
+import (
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory/balancehistorypb"
+)
+
+// BalanceChange is a change of the balance of an account
+type BalanceChange struct {
+	Height     uint64
+	Timestamp  int64
+	ActionHash hash.Hash256
+	// Amount is negative if the balance decreases
+	Amount *big.Int
+	Type   iotextypes.TransactionLogType
+	// Total is the sum of the amounts of the account's changes up to this one
+	Total *big.Int
+}
+
+// Serialize returns the serialized bytes of the change
+func (c *BalanceChange) Serialize() ([]byte, error) {
+	return proto.Marshal(&balancehistorypb.BalanceChange{
+		Height:     c.Height,
+		Timestamp:  c.Timestamp,
+		ActionHash: c.ActionHash[:],
+		Amount:     c.Amount.String(),
+		Type:       c.Type,
+		Total:      c.Total.String(),
+	})
+}
+
+// Deserialize deserializes the bytes into the change
+func (c *BalanceChange) Deserialize(buf []byte) error {
+	pb := &balancehistorypb.BalanceChange{}
+	if err := proto.Unmarshal(buf, pb); err != nil {
+		return err
+	}
+	amount, ok := new(big.Int).SetString(pb.Amount, 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pb.Amount)
+	}
+	total, ok := new(big.Int).SetString(pb.Total, 10)
+	if !ok {
+		return errors.Errorf("invalid total %s", pb.Total)
+	}
+	c.Height = pb.Height
+	c.Timestamp = pb.Timestamp
+	c.ActionHash = hash.BytesToHash256(pb.ActionHash)
+	c.Amount = amount
+	c.Type = pb.Type
+	c.Total = total
+	return nil
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package balancehistory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+var (
+	// _blockTimeBucket is the counting index of the block timestamps, keyed by height. The buckets of the
+	// accounts are keyed by their 20-byte addresses
+	_blockTimeBucket = []byte("bt")
+
+	// ErrNotExist indicates the balance history is not recorded
+	ErrNotExist = errors.New("balance history does not exist")
+)
+
+type (
+	// Indexer records every balance change of the accounts, with the height, action hash, amount and type
+	// of it, from the transaction logs in the receipts. The balance of an account at a height is the
+	// current balance minus the sum of the changes after the height
+	Indexer struct {
+		mutex       sync.RWMutex
+		kvStore     db.KVStoreWithRange
+		genesisTime int64
+		blocks      db.CountingIndex
+	}
+
+	// accountIndex is the counting index of the changes of an account being written
+	accountIndex struct {
+		changes db.CountingIndex
+		total   *big.Int
+	}
+)
+
+var (
+	_ blockdao.BlockIndexerWithTransactionLog = (*Indexer)(nil)
+	_ blockdao.BlockIndexerWithRewind         = (*Indexer)(nil)
+)
+
+// NewIndexer creates a new balance history indexer, genesisTime is the unix timestamp of the genesis block
+func NewIndexer(kv db.KVStore, genesisTime int64) (*Indexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("indexer can only be created from KVStoreWithRange")
+	}
+	return &Indexer{
+		kvStore:     kvRange,
+		genesisTime: genesisTime,
+	}, nil
+}
+
+// Start starts the indexer
+func (idx *Indexer) Start(ctx context.Context) error {
+	if err := idx.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	if err := idx.loadBlocks(); err != nil {
+		return err
+	}
+	if idx.blocks.Size() == 0 {
+		// insert genesis block
+		return idx.blocks.Add(byteutil.Uint64ToBytesBigEndian(uint64(idx.genesisTime)), false)
+	}
+	return nil
+}
+
+// Stop stops the indexer
+func (idx *Indexer) Stop(ctx context.Context) error {
+	return idx.kvStore.Stop(ctx)
+}
+
+// Height returns the height of the last indexed block
+func (idx *Indexer) Height() (uint64, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return idx.blocks.Size() - 1, nil
+}
+
+// NeedTransactionLog returns true, the changes are read from the transaction logs in the receipts
+func (idx *Indexer) NeedTransactionLog() bool {
+	return true
+}
+
+// PutBlock records the balance changes in the block
+func (idx *Indexer) PutBlock(_ context.Context, blk *block.Block) error {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	// the block to be indexed must be exactly current top + 1, otherwise counting index would not work correctly
+	height := blk.Height()
+	if height != idx.blocks.Size() {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, idx.blocks.Size())
+	}
+	b := batch.NewBatch()
+	if err := idx.blocks.UseBatch(b); err != nil {
+		return err
+	}
+	timestamp := blk.Timestamp().Unix()
+	if err := idx.blocks.Add(byteutil.Uint64ToBytesBigEndian(uint64(timestamp)), true); err != nil {
+		return errors.Wrapf(err, "failed to put block %d timestamp", height)
+	}
+	dirty := make(map[hash.Hash160]*accountIndex)
+	for _, r := range blk.Receipts {
+		for _, l := range r.TransactionLogs() {
+			if l.Amount == nil || l.Amount.Sign() == 0 {
+				continue
+			}
+			for _, e := range []struct {
+				addr   string
+				amount *big.Int
+			}{
+				{l.Sender, new(big.Int).Neg(l.Amount)},
+				{l.Recipient, new(big.Int).Set(l.Amount)},
+			} {
+				addr, ok := accountHash(e.addr)
+				if !ok {
+					continue
+				}
+				ai, err := idx.accountIndex(dirty, addr, b)
+				if err != nil {
+					return err
+				}
+				ai.total.Add(ai.total, e.amount)
+				data, err := (&BalanceChange{
+					Height:     height,
+					Timestamp:  timestamp,
+					ActionHash: r.ActionHash,
+					Amount:     e.amount,
+					Type:       l.Type,
+					Total:      ai.total,
+				}).Serialize()
+				if err != nil {
+					return err
+				}
+				if err := ai.changes.Add(data, true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, ai := range dirty {
+		if err := ai.changes.Finalize(); err != nil {
+			return err
+		}
+	}
+	if err := idx.blocks.Finalize(); err != nil {
+		return err
+	}
+	if err := idx.kvStore.WriteBatch(b); err != nil {
+		// reload the size of the block index, which has been increased
+		if loadErr := idx.loadBlocks(); loadErr != nil {
+			return loadErr
+		}
+		return errors.Wrapf(err, "failed to write balance changes at height %d", height)
+	}
+	return nil
+}
+
+// DeleteTipBlock removes the balance changes of the tip block, the accounts are read from the transaction
+// logs in the receipts of the block
+func (idx *Indexer) DeleteTipBlock(_ context.Context, blk *block.Block) error {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	height := blk.Height()
+	if tip := idx.blocks.Size() - 1; height != tip || height == 0 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting tip height %d", height, tip)
+	}
+	reverted := make(map[hash.Hash160]bool)
+	for _, r := range blk.Receipts {
+		for _, l := range r.TransactionLogs() {
+			if l.Amount == nil || l.Amount.Sign() == 0 {
+				continue
+			}
+			for _, a := range []string{l.Sender, l.Recipient} {
+				addr, ok := accountHash(a)
+				if !ok || reverted[addr] {
+					continue
+				}
+				if err := idx.revertAccount(addr, height); err != nil {
+					return err
+				}
+				reverted[addr] = true
+			}
+		}
+	}
+	// the block is removed last, so a failed delete can be retried
+	return idx.blocks.Revert(1)
+}
+
+// revertAccount removes the changes of the account at the height, which are the last ones of it
+func (idx *Indexer) revertAccount(addr hash.Hash160, height uint64) error {
+	changes, err := db.GetCountingIndex(idx.kvStore, addr[:])
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return nil
+	default:
+		return err
+	}
+	var count uint64
+	for size := changes.Size(); count < size; count++ {
+		c, err := getChange(changes, size-count-1)
+		if err != nil {
+			return err
+		}
+		if c.Height < height {
+			break
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return changes.Revert(count)
+}
+
+// BalanceChanges returns the balance changes of the address in [start, start+count) in the order they
+// happen, and the total number of the changes
+func (idx *Indexer) BalanceChanges(addr address.Address, start, count uint64) ([]*BalanceChange, uint64, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	changes, err := idx.changesOf(addr)
+	if err != nil || changes == nil {
+		return nil, 0, err
+	}
+	total := changes.Size()
+	if total == 0 {
+		// all the changes have been reverted by DeleteTipBlock
+		return nil, 0, nil
+	}
+	if start >= total {
+		return nil, total, errors.Wrapf(db.ErrInvalid, "start = %d >= total = %d", start, total)
+	}
+	if start+count > total {
+		count = total - start
+	}
+	values, err := changes.Range(start, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	ret := make([]*BalanceChange, len(values))
+	for i, v := range values {
+		ret[i] = &BalanceChange{}
+		if err := ret[i].Deserialize(v); err != nil {
+			return nil, 0, err
+		}
+	}
+	return ret, total, nil
+}
+
+// TotalAt returns the sum of the balance changes of the address up to the height
+func (idx *Indexer) TotalAt(addr address.Address, height uint64) (*big.Int, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	if tip := idx.blocks.Size() - 1; height > tip {
+		return nil, errors.Wrapf(ErrNotExist, "height %d is higher than the indexed height %d", height, tip)
+	}
+	changes, err := idx.changesOf(addr)
+	if err != nil {
+		return nil, err
+	}
+	if changes == nil {
+		return big.NewInt(0), nil
+	}
+	var searchErr error
+	// the number of the changes up to the height
+	n := sort.Search(int(changes.Size()), func(i int) bool {
+		c, err := getChange(changes, uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return c.Height > height
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if n == 0 {
+		return big.NewInt(0), nil
+	}
+	c, err := getChange(changes, uint64(n-1))
+	if err != nil {
+		return nil, err
+	}
+	return c.Total, nil
+}
+
+// HeightByTimestamp returns the height of the last block produced at or before the unix timestamp
+func (idx *Indexer) HeightByTimestamp(timestamp int64) (uint64, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	if timestamp < idx.genesisTime {
+		return 0, errors.Wrapf(ErrNotExist, "timestamp %d is before genesis", timestamp)
+	}
+	var searchErr error
+	n := sort.Search(int(idx.blocks.Size()), func(i int) bool {
+		ts, err := idx.blockTimestamp(uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return ts > timestamp
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	return uint64(n - 1), nil
+}
+
+// BlockTimestamp returns the unix timestamp of the block at the height
+func (idx *Indexer) BlockTimestamp(height uint64) (int64, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return idx.blockTimestamp(height)
+}
+
+func (idx *Indexer) blockTimestamp(height uint64) (int64, error) {
+	value, err := idx.blocks.Get(height)
+	switch errors.Cause(err) {
+	case nil:
+		return int64(byteutil.BytesToUint64BigEndian(value)), nil
+	case db.ErrNotExist:
+		return 0, errors.Wrapf(ErrNotExist, "block %d is not indexed", height)
+	default:
+		return 0, err
+	}
+}
+
+func (idx *Indexer) loadBlocks() error {
+	var err error
+	idx.blocks, err = db.NewCountingIndexNX(idx.kvStore, _blockTimeBucket)
+	return err
+}
+
+// changesOf returns the counting index of the changes of the address, nil if the address has no changes
+func (idx *Indexer) changesOf(addr address.Address) (db.CountingIndex, error) {
+	changes, err := db.GetCountingIndex(idx.kvStore, addr.Bytes())
+	switch errors.Cause(err) {
+	case nil:
+		return changes, nil
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// accountIndex returns the index of the account in the block being written
+func (idx *Indexer) accountIndex(dirty map[hash.Hash160]*accountIndex, addr hash.Hash160, b batch.KVStoreBatch) (*accountIndex, error) {
+	if ai, ok := dirty[addr]; ok {
+		return ai, nil
+	}
+	changes, err := db.NewCountingIndexNX(idx.kvStore, addr[:])
+	if err != nil {
+		return nil, err
+	}
+	ai := &accountIndex{
+		changes: changes,
+		total:   big.NewInt(0),
+	}
+	if size := changes.Size(); size > 0 {
+		last, err := getChange(changes, size-1)
+		if err != nil {
+			return nil, err
+		}
+		ai.total.Set(last.Total)
+	}
+	if err := changes.UseBatch(b); err != nil {
+		return nil, err
+	}
+	dirty[addr] = ai
+	return ai, nil
+}
+
+func getChange(changes db.CountingIndex, i uint64) (*BalanceChange, error) {
+	value, err := changes.Get(i)
+	if err != nil {
+		return nil, err
+	}
+	c := &BalanceChange{}
+	if err := c.Deserialize(value); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// accountHash returns the hash of the address in the transaction log, the burned amount and the special
+// pool addresses are not accounts
+func accountHash(addr string) (hash.Hash160, bool) {
+	if len(addr) == 0 || address.IsAddrV1Special(addr) {
+		return hash.ZeroHash160, false
+	}
+	a, err := address.FromString(addr)
+	if err != nil {
+		return hash.ZeroHash160, false
+	}
+	return hash.BytesToHash160(a.Bytes()), true
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package balancehistory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+)
+
+func TestIndexer(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	const genesisTime = 1000
+	indexer, err := NewIndexer(db.NewMemKVStore(), genesisTime)
+	r.NoError(err)
+	r.NoError(indexer.Start(ctx))
+	defer func() {
+		r.NoError(indexer.Stop(ctx))
+	}()
+	height, err := indexer.Height()
+	r.NoError(err)
+	r.Zero(height)
+
+	var (
+		alice = identityset.Address(1)
+		bob   = identityset.Address(2)
+		carol = identityset.Address(3)
+	)
+	transfer := func(from, to string, amount int64, typ iotextypes.TransactionLogType) *action.TransactionLog {
+		return &action.TransactionLog{
+			Type:      typ,
+			Amount:    big.NewInt(amount),
+			Sender:    from,
+			Recipient: to,
+		}
+	}
+	blocks := make(map[uint64]*block.Block)
+	putBlock := func(height uint64, logs ...[]*action.TransactionLog) {
+		receipts := make([]*action.Receipt, len(logs))
+		for i := range logs {
+			receipts[i] = (&action.Receipt{
+				BlockHeight: height,
+				ActionHash:  hash.Hash256b([]byte{byte(height), byte(i)}),
+			}).AddTransactionLogs(logs[i]...)
+		}
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(time.Unix(genesisTime+10*int64(height), 0)).
+			SetReceipts(receipts).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(indexer.PutBlock(ctx, &blk))
+		blocks[height] = &blk
+	}
+
+	// block 1: alice claims reward
+	putBlock(1, []*action.TransactionLog{
+		transfer(address.RewardingPoolAddr, alice.String(), 100, iotextypes.TransactionLogType_CLAIM_FROM_REWARDING_FUND),
+	})
+	// block 2: alice pays gas and sends bob 30, bob sends carol 10, a zero amount log is skipped
+	putBlock(2, []*action.TransactionLog{
+		transfer(alice.String(), "", 1, iotextypes.TransactionLogType_GAS_FEE),
+		transfer(alice.String(), bob.String(), 30, iotextypes.TransactionLogType_NATIVE_TRANSFER),
+	}, []*action.TransactionLog{
+		transfer(bob.String(), carol.String(), 10, iotextypes.TransactionLogType_NATIVE_TRANSFER),
+		transfer(bob.String(), carol.String(), 0, iotextypes.TransactionLogType_NATIVE_TRANSFER),
+	})
+	// block 3 has no changes
+	putBlock(3)
+	// block 4: alice deposits to staking
+	putBlock(4, []*action.TransactionLog{
+		transfer(alice.String(), address.StakingBucketPoolAddr, 20, iotextypes.TransactionLogType_DEPOSIT_TO_BUCKET),
+	})
+	// the block must be the next one
+	r.ErrorIs(indexer.PutBlock(ctx, &block.Block{}), db.ErrInvalid)
+	height, err = indexer.Height()
+	r.NoError(err)
+	r.EqualValues(4, height)
+
+	changes, total, err := indexer.BalanceChanges(alice, 0, 10)
+	r.NoError(err)
+	r.EqualValues(4, total)
+	r.Len(changes, 4)
+	for i, e := range []struct {
+		height uint64
+		amount int64
+		typ    iotextypes.TransactionLogType
+		total  int64
+	}{
+		{1, 100, iotextypes.TransactionLogType_CLAIM_FROM_REWARDING_FUND, 100},
+		{2, -1, iotextypes.TransactionLogType_GAS_FEE, 99},
+		{2, -30, iotextypes.TransactionLogType_NATIVE_TRANSFER, 69},
+		{4, -20, iotextypes.TransactionLogType_DEPOSIT_TO_BUCKET, 49},
+	} {
+		r.Equal(e.height, changes[i].Height)
+		r.Equal(genesisTime+10*int64(e.height), changes[i].Timestamp)
+		r.Equal(e.amount, changes[i].Amount.Int64())
+		r.Equal(e.typ, changes[i].Type)
+		r.Equal(e.total, changes[i].Total.Int64())
+	}
+	r.Equal(hash.Hash256b([]byte{2, 0}), changes[2].ActionHash)
+
+	// pagination
+	changes, total, err = indexer.BalanceChanges(alice, 3, 10)
+	r.NoError(err)
+	r.EqualValues(4, total)
+	r.Len(changes, 1)
+	r.EqualValues(4, changes[0].Height)
+	_, _, err = indexer.BalanceChanges(alice, 4, 1)
+	r.ErrorIs(err, db.ErrInvalid)
+	changes, total, err = indexer.BalanceChanges(identityset.Address(4), 0, 10)
+	r.NoError(err)
+	r.Zero(total)
+	r.Empty(changes)
+
+	// sum of the changes up to a height
+	for _, e := range []struct {
+		addr   address.Address
+		height uint64
+		total  int64
+	}{
+		{alice, 0, 0},
+		{alice, 1, 100},
+		{alice, 2, 69},
+		{alice, 3, 69},
+		{alice, 4, 49},
+		{bob, 1, 0},
+		{bob, 2, 20},
+		{carol, 4, 10},
+		{identityset.Address(4), 4, 0},
+	} {
+		total, err := indexer.TotalAt(e.addr, e.height)
+		r.NoError(err)
+		r.Equal(e.total, total.Int64())
+	}
+	_, err = indexer.TotalAt(alice, 5)
+	r.ErrorIs(err, ErrNotExist)
+
+	// time to height
+	for _, e := range []struct {
+		timestamp int64
+		height    uint64
+	}{
+		{genesisTime, 0},
+		{genesisTime + 9, 0},
+		{genesisTime + 10, 1},
+		{genesisTime + 25, 2},
+		{genesisTime + 40, 4},
+		{genesisTime + 1000, 4},
+	} {
+		height, err := indexer.HeightByTimestamp(e.timestamp)
+		r.NoError(err)
+		r.Equal(e.height, height)
+	}
+	_, err = indexer.HeightByTimestamp(genesisTime - 1)
+	r.True(errors.Is(err, ErrNotExist))
+	ts, err := indexer.BlockTimestamp(3)
+	r.NoError(err)
+	r.EqualValues(genesisTime+30, ts)
+	_, err = indexer.BlockTimestamp(5)
+	r.ErrorIs(err, ErrNotExist)
+
+	// delete the tip blocks
+	r.ErrorIs(indexer.DeleteTipBlock(ctx, blocks[3]), db.ErrInvalid)
+	r.NoError(indexer.DeleteTipBlock(ctx, blocks[4]))
+	height, err = indexer.Height()
+	r.NoError(err)
+	r.EqualValues(3, height)
+	_, err = indexer.TotalAt(alice, 4)
+	r.ErrorIs(err, ErrNotExist)
+	_, total, err = indexer.BalanceChanges(alice, 0, 10)
+	r.NoError(err)
+	r.EqualValues(3, total)
+	r.NoError(indexer.DeleteTipBlock(ctx, blocks[3]))
+	r.NoError(indexer.DeleteTipBlock(ctx, blocks[2]))
+	for _, e := range []struct {
+		addr  address.Address
+		total uint64
+	}{
+		{alice, 1},
+		{bob, 0},
+		{carol, 0},
+	} {
+		_, total, err := indexer.BalanceChanges(e.addr, 0, 10)
+		r.NoError(err)
+		r.Equal(e.total, total)
+	}
+	_, err = indexer.BlockTimestamp(2)
+	r.ErrorIs(err, ErrNotExist)
+	// the deleted block can be indexed again
+	r.NoError(indexer.PutBlock(ctx, blocks[2]))
+	total2, err := indexer.TotalAt(alice, 2)
+	r.NoError(err)
+	r.EqualValues(69, total2.Int64())
+	total2, err = indexer.TotalAt(carol, 2)
+	r.NoError(err)
+	r.EqualValues(10, total2.Int64())
+}
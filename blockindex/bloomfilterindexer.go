@@ -179,14 +179,64 @@ func (bfx *bloomfilterIndexer) PutBlock(ctx context.Context, blk *block.Block) (
 }
 
 // DeleteTipBlock deletes tip height from underlying DB if necessary
+// Elements of the deleted block cannot be removed from the range bloomfilter,
+// they only add to the false positive rate of the range
 func (bfx *bloomfilterIndexer) DeleteTipBlock(_ context.Context, blk *block.Block) (err error) {
 	bfx.mutex.Lock()
 	defer bfx.mutex.Unlock()
 	height := blk.Height()
-	if err := bfx.kvStore.Delete(BlockBloomFilterNamespace, byteutil.Uint64ToBytesBigEndian(height)); err != nil {
+	tip, err := bfx.Height()
+	if err != nil {
+		return err
+	}
+	if height == 0 || height != tip {
+		return errors.Errorf("wrong block height %d, expecting %d", height, tip)
+	}
+	var (
+		br       = bfx.curRangeBloomfilter
+		index    = byteutil.BytesToUint64BigEndian(bfx.currRangeBfKey)
+		removed  []uint64
+		b        = batch.NewBatch()
+		rangeKey []byte
+	)
+	// go back to the range containing blocks below the tip
+	for br.Start() >= height && index > 0 {
+		removed = append(removed, br.Start())
+		index--
+		if br, err = newBloomRange(bfx.bfSize, bfx.bfNumHash); err != nil {
+			return err
+		}
+		if err := bfx.loadBloomRangeFromDB(br, byteutil.Uint64ToBytesBigEndian(index)); err != nil {
+			return err
+		}
+	}
+	rangeKey = byteutil.Uint64ToBytesBigEndian(index)
+	if br.Start() >= height {
+		// the first range becomes empty
+		if br, err = newBloomRange(bfx.bfSize, bfx.bfNumHash); err != nil {
+			return err
+		}
+		br.SetStart(1)
+		b.Delete(RangeBloomFilterNamespace, rangeKey, "failed to delete range bloom filter")
+	} else {
+		br.SetEnd(height - 1)
+		bfBytes, err := br.Bytes()
+		if err != nil {
+			return err
+		}
+		b.Put(RangeBloomFilterNamespace, rangeKey, bfBytes, "failed to put range bloom filter")
+	}
+	b.Delete(BlockBloomFilterNamespace, byteutil.Uint64ToBytesBigEndian(height), "failed to delete block bloom filter")
+	b.Put(RangeBloomFilterNamespace, []byte(CurrentHeightKey), byteutil.Uint64ToBytesBigEndian(height-1), "failed to put current height")
+	if err := bfx.kvStore.WriteBatch(b); err != nil {
 		return err
 	}
-	bfx.curRangeBloomfilter = nil
+	for _, start := range removed {
+		if err := bfx.totalRange.Delete(start); err != nil {
+			return errors.Wrapf(err, "failed to delete bloomfilter index at height %d", start)
+		}
+	}
+	bfx.curRangeBloomfilter, bfx.currRangeBfKey = br, rangeKey
 	return nil
 }
 
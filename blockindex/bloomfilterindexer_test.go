@@ -9,10 +9,12 @@ import (
 	"context"
 	"hash/fnv"
 	"math/big"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/hash"
@@ -342,3 +344,69 @@ func BenchmarkBloomfilterIndexer(b *testing.B) {
 	require.NoError(err)
 	require.Equal(blkNum-1, len(res))
 }
+
+func TestBloomfilterIndexerDeleteTipBlock(t *testing.T) {
+	require := require.New(t)
+	blks := getTestLogBlocks(t)
+	filters := []*iotexapi.LogsFilter{
+		{
+			Address: []string{identityset.Address(28).String()},
+			Topics:  []*iotexapi.Topics{{Topic: [][]byte{_data1[:], _data2[:]}}, nil},
+		},
+		{
+			Address: []string{identityset.Address(18).String()},
+			Topics:  []*iotexapi.Topics{{Topic: [][]byte{_data1[:]}}, nil},
+		},
+	}
+	expected := [][]uint64{{1, 2, 5}, {3}}
+	expectedTo3 := [][]uint64{{1, 2}, {3}}
+
+	// range size 1 starts a new range after every block
+	for _, rangeSize := range []uint64{1, 16} {
+		ctx := context.Background()
+		cfg := DefaultConfig
+		cfg.RangeBloomFilterNumElements = rangeSize
+		cfg.RangeBloomFilterSize = 4096
+		cfg.RangeBloomFilterNumHash = 4
+		dbCfg := db.DefaultConfig
+		dbCfg.DbPath = filepath.Join(t.TempDir(), "bloomfilter.db")
+		indexer, err := NewBloomfilterIndexer(db.NewBoltDB(dbCfg), cfg)
+		require.NoError(err)
+		require.NoError(indexer.Start(ctx))
+		for _, blk := range blks {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		bfx := indexer.(*bloomfilterIndexer)
+		// only the tip block can be deleted
+		require.Error(bfx.DeleteTipBlock(ctx, blks[3]))
+		require.NoError(bfx.DeleteTipBlock(ctx, blks[4]))
+		require.NoError(bfx.DeleteTipBlock(ctx, blks[3]))
+		_, err = indexer.BlockFilterByHeight(4)
+		require.Equal(db.ErrNotExist, errors.Cause(err))
+
+		// deletion survives restart
+		require.NoError(indexer.Stop(ctx))
+		indexer, err = NewBloomfilterIndexer(db.NewBoltDB(dbCfg), cfg)
+		require.NoError(err)
+		require.NoError(indexer.Start(ctx))
+		height, err := indexer.Height()
+		require.NoError(err)
+		require.EqualValues(3, height)
+		for i, l := range filters {
+			res, err := indexer.FilterBlocksInRange(logfilter.NewLogFilter(l), 1, 3, 0)
+			require.NoError(err)
+			require.Equal(expectedTo3[i], res)
+		}
+
+		// index the blocks again
+		for _, blk := range blks[3:] {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		for i, l := range filters {
+			res, err := indexer.FilterBlocksInRange(logfilter.NewLogFilter(l), 1, 5, 0)
+			require.NoError(err)
+			require.Equal(expected[i], res)
+		}
+		require.NoError(indexer.Stop(ctx))
+	}
+}
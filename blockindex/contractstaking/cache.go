@@ -293,7 +293,7 @@ func (s *contractStakingCache) LoadFromDB(kvstore db.KVStore) error {
 
 	// load bucket info
 	ks, vs, err := kvstore.Filter(_StakingBucketInfoNS, func(k, v []byte) bool { return true }, nil, nil)
-	if err != nil && !errors.Is(err, db.ErrBucketNotExist) {
+	if err != nil && !errors.Is(err, db.ErrBucketNotExist) && !errors.Is(err, db.ErrNotExist) {
 		return err
 	}
 	for i := range vs {
@@ -306,7 +306,7 @@ func (s *contractStakingCache) LoadFromDB(kvstore db.KVStore) error {
 
 	// load bucket type
 	ks, vs, err = kvstore.Filter(_StakingBucketTypeNS, func(k, v []byte) bool { return true }, nil, nil)
-	if err != nil && !errors.Is(err, db.ErrBucketNotExist) {
+	if err != nil && !errors.Is(err, db.ErrBucketNotExist) && !errors.Is(err, db.ErrNotExist) {
 		return err
 	}
 	for i := range vs {
@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
 	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 )
@@ -226,6 +227,28 @@ func (s *Indexer) PutBlock(ctx context.Context, blk *block.Block) error {
 	return s.commit(handler, blk.Height())
 }
 
+// Reset deletes all the indexed data, so the index can be rebuilt from the chain db
+func (s *Indexer) Reset(context.Context) error {
+	b := batch.NewBatch()
+	for _, ns := range []string{_StakingNS, _StakingBucketInfoNS, _StakingBucketTypeNS} {
+		keys, _, err := s.kvstore.Filter(ns, func(k, v []byte) bool { return true }, nil, nil)
+		if err != nil {
+			if errors.Is(err, db.ErrNotExist) || errors.Is(err, db.ErrBucketNotExist) {
+				continue
+			}
+			return err
+		}
+		for _, k := range keys {
+			b.Delete(ns, k, "failed to delete index data")
+		}
+	}
+	if err := s.kvstore.WriteBatch(b); err != nil {
+		return err
+	}
+	s.cache = newContractStakingCache(s.config)
+	return nil
+}
+
 func (s *Indexer) commit(handler *contractStakingEventHandler, height uint64) error {
 	batch, delta := handler.Result()
 	// update cache
@@ -9,6 +9,7 @@ import (
 	"cmp"
 	"context"
 	"math/big"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"sync"
@@ -149,6 +150,54 @@ func TestContractStakingIndexerLoadCache(t *testing.T) {
 	r.NoError(newIndexer.Stop(context.Background()))
 }
 
+func TestContractStakingIndexerReset(t *testing.T) {
+	r := require.New(t)
+	cfg := db.DefaultConfig
+	cfg.DbPath = filepath.Join(t.TempDir(), "staking.db")
+	g := genesis.TestDefault()
+	config := Config{
+		ContractAddress:      _testStakingContractAddress,
+		ContractDeployHeight: 0,
+		CalculateVoteWeight:  calculateVoteWeightGen(g.VoteWeightCalConsts),
+		BlocksToDuration:     _blockDurationFn,
+	}
+	indexer, err := NewContractStakingIndexer(db.NewBoltDB(cfg), config)
+	r.NoError(err)
+	r.NoError(indexer.Start(context.Background()))
+	// reset an empty index
+	r.NoError(indexer.Reset(context.Background()))
+
+	height := uint64(1)
+	handler := newContractStakingEventHandler(indexer.cache)
+	activateBucketType(r, handler, 10, 100, height)
+	stake(r, handler, identityset.Address(0), identityset.Address(1), 1, 10, 100, height)
+	r.NoError(indexer.commit(handler, height))
+
+	r.NoError(indexer.Reset(context.Background()))
+	h, err := indexer.Height()
+	r.NoError(err)
+	r.Zero(h)
+	tbc, err := indexer.TotalBucketCount(0)
+	r.NoError(err)
+	r.Zero(tbc)
+	bts, err := indexer.BucketTypes(0)
+	r.NoError(err)
+	r.Empty(bts)
+	r.NoError(indexer.Stop(context.Background()))
+
+	// nothing is loaded from db after restart
+	indexer, err = NewContractStakingIndexer(db.NewBoltDB(cfg), config)
+	r.NoError(err)
+	r.NoError(indexer.Start(context.Background()))
+	h, err = indexer.Height()
+	r.NoError(err)
+	r.Zero(h)
+	buckets, err := indexer.Buckets(0)
+	r.NoError(err)
+	r.Empty(buckets)
+	r.NoError(indexer.Stop(context.Background()))
+}
+
 func TestContractStakingIndexerDirty(t *testing.T) {
 	r := require.New(t)
 	testDBPath, err := testutil.PathOfTempFile("staking.db")
@@ -142,6 +142,75 @@ func (x *blockIndexer) PutBlock(ctx context.Context, blk *block.Block) error {
 	return x.commit()
 }
 
+// DeleteTipBlock removes the tip block from the index
+func (x *blockIndexer) DeleteTipBlock(ctx context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	height := blk.Height()
+	if height == 0 || height != x.tbk.Size()-1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, x.tbk.Size()-1)
+	}
+	v, err := x.tbk.Get(height)
+	if err != nil {
+		return err
+	}
+	bd := &BlockIndex{}
+	if err := bd.Deserialize(v); err != nil {
+		return err
+	}
+	h := blk.HashBlock()
+	if !bytes.Equal(bd.Hash(), h[:]) {
+		return errors.Wrapf(db.ErrInvalid, "block hash %x does not match index %x at height %d", h, bd.Hash(), height)
+	}
+
+	// revert all changes of the block in one batch
+	b := batch.NewBatch()
+	b.Delete(_blockHashToHeightNS, h[_hashOffset:], "failed to delete hash -> height mapping")
+	if err := revertCountingIndex(b, _totalBlocksBucket, x.tbk.Size(), 1); err != nil {
+		return err
+	}
+	if err := revertCountingIndex(b, _totalActionsBucket, x.tac.Size(), uint64(len(blk.Actions))); err != nil {
+		return err
+	}
+	fCtx := protocol.MustGetFeatureCtx(protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: height,
+	})))
+	addrCount := make(map[hash.Hash160]uint64)
+	for _, selp := range blk.Actions {
+		actHash, err := selp.Hash()
+		if err != nil {
+			return err
+		}
+		b.Delete(_actionToBlockHashNS, actHash[_hashOffset:], fmt.Sprintf("failed to delete action hash %x", actHash))
+		addrs, err := actionAddresses(selp, fCtx.TolerateLegacyAddress)
+		if err != nil {
+			return err
+		}
+		for _, addr := range addrs {
+			addrCount[hash.BytesToHash160(addr)]++
+		}
+	}
+	for addr, count := range addrCount {
+		index, err := db.NewCountingIndexNX(x.kvStore, addr[:])
+		if err != nil {
+			return err
+		}
+		if err := revertCountingIndex(b, addr[:], index.Size(), count); err != nil {
+			return err
+		}
+	}
+	if err := x.kvStore.WriteBatch(b); err != nil {
+		return err
+	}
+	// reload the total block and action index
+	if x.tbk, err = db.NewCountingIndexNX(x.kvStore, _totalBlocksBucket); err != nil {
+		return err
+	}
+	x.tac, err = db.NewCountingIndexNX(x.kvStore, _totalActionsBucket)
+	return err
+}
+
 // Height return the blockchain height
 func (x *blockIndexer) Height() (uint64, error) {
 	x.mutex.RLock()
@@ -353,41 +422,64 @@ func (x *blockIndexer) getIndexerForAddr(addr []byte) (db.CountingIndex, error)
 
 // indexAction builds index for an action
 func (x *blockIndexer) indexAction(actHash hash.Hash256, elp *action.SealedEnvelope, tolerateLegacyAddress bool) error {
-	// add to sender's index
-	callerAddrBytes := elp.SrcPubkey().Hash()
-	sender, err := x.getIndexerForAddr(callerAddrBytes)
+	addrs, err := actionAddresses(elp, tolerateLegacyAddress)
 	if err != nil {
 		return err
 	}
-	if err = sender.Add(actHash[:], true); err != nil {
-		return err
+	for _, addr := range addrs {
+		indexer, err := x.getIndexerForAddr(addr)
+		if err != nil {
+			return err
+		}
+		if err = indexer.Add(actHash[:], true); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// actionAddresses returns the sender, and the recipient if it differs from sender
+func actionAddresses(elp *action.SealedEnvelope, tolerateLegacyAddress bool) ([][]byte, error) {
+	callerAddrBytes := elp.SrcPubkey().Hash()
 	dst, ok := elp.Destination()
 	if !ok || dst == "" {
-		return nil
+		return [][]byte{callerAddrBytes}, nil
 	}
 
-	var dstAddr address.Address
+	var (
+		dstAddr address.Address
+		err     error
+	)
 	if tolerateLegacyAddress {
 		dstAddr, err = address.FromStringLegacy(dst)
 	} else {
 		dstAddr, err = address.FromString(dst)
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 	dstAddrBytes := dstAddr.Bytes()
 
 	if bytes.Equal(dstAddrBytes, callerAddrBytes) {
 		// recipient is same as sender
-		return nil
+		return [][]byte{callerAddrBytes}, nil
 	}
+	return [][]byte{callerAddrBytes, dstAddrBytes}, nil
+}
 
-	// add to recipient's index
-	recipient, err := x.getIndexerForAddr(dstAddrBytes)
-	if err != nil {
-		return err
+// revertCountingIndex removes the last count entries of a counting index of
+// the given size into the batch
+func revertCountingIndex(b batch.KVStoreBatch, bucket []byte, size, count uint64) error {
+	if count > size {
+		return errors.Wrapf(db.ErrInvalid, "cannot revert %d entries of index 0x%x, size = %d", count, bucket, size)
 	}
-	return recipient.Add(actHash[:], true)
+	if count == 0 {
+		return nil
+	}
+	start := size - count
+	for i := start; i < size; i++ {
+		b.Delete(string(bucket), byteutil.Uint64ToBytesBigEndian(i), fmt.Sprintf("failed to delete %d-th item", i))
+	}
+	b.Put(string(bucket), db.CountKey, byteutil.Uint64ToBytesBigEndian(start), fmt.Sprintf("failed to update size = %d", start))
+	return nil
 }
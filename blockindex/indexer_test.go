@@ -258,6 +258,51 @@ func TestIndexer(t *testing.T) {
 			require.NoError(err)
 			require.EqualValues(len(indexTests[0].actions[i].hashes), actionCount)
 		}
+
+		x := indexer.(*blockIndexer)
+		// only the tip block can be deleted
+		require.Equal(db.ErrInvalid, errors.Cause(x.DeleteTipBlock(ctx, blks[1])))
+		for i := 1; i < len(indexTests); i++ {
+			blk := blks[3-i]
+			require.NoError(x.DeleteTipBlock(ctx, blk))
+			height, err := indexer.Height()
+			require.NoError(err)
+			require.EqualValues(3-i, height)
+			_, err = indexer.GetBlockHeight(blk.HashBlock())
+			require.Error(err)
+			for j := range blk.Actions {
+				h, _ := blk.Actions[j].Hash()
+				_, err = indexer.GetActionIndex(h[:])
+				require.Equal(db.ErrNotExist, errors.Cause(err))
+			}
+			total, err := indexer.GetTotalActions()
+			require.NoError(err)
+			require.Equal(indexTests[i].total, total)
+			if total > 0 {
+				actions, err := indexer.GetActionHashFromIndex(0, total)
+				require.NoError(err)
+				require.Equal(indexTests[i].hashTotal, actions)
+			}
+			for _, v := range indexTests[i].actions {
+				actionCount, err := indexer.GetActionCountByAddress(v.addr)
+				require.NoError(err)
+				require.EqualValues(len(v.hashes), actionCount)
+				if actionCount > 0 {
+					actions, err := indexer.GetActionsByAddress(v.addr, 0, actionCount)
+					require.NoError(err)
+					require.Equal(v.hashes, actions)
+				}
+			}
+		}
+		// genesis block cannot be deleted
+		require.Equal(db.ErrInvalid, errors.Cause(x.DeleteTipBlock(ctx, blks[0])))
+		// the index can be rebuilt after deletion
+		for i := 0; i < 3; i++ {
+			require.NoError(indexer.PutBlock(ctx, blks[i]))
+		}
+		total, err := indexer.GetTotalActions()
+		require.NoError(err)
+		require.Equal(indexTests[0].total, total)
 	}
 
 	t.Run("In-memory KV indexer", func(t *testing.T) {
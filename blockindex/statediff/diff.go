@@ -0,0 +1,244 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package statediff
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol/account/accountpb"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff/statediffpb"
+	"github.com/iotexproject/iotex-core/v2/db/trie/triepb"
+	"github.com/iotexproject/iotex-core/v2/state"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+)
+
+// _rewardingNamespace is the namespace of the rewarding protocol states since Greenland
+const _rewardingNamespace = "Rewarding"
+
+type (
+	// AccountState is the state of an account in a state diff, Nonce is the nonce of the next action
+	AccountState struct {
+		Balance  *big.Int
+		Nonce    uint64
+		CodeHash []byte
+	}
+
+	// StorageChange is the change of a contract storage slot, a nil value means the slot is empty
+	StorageChange struct {
+		Key      hash.Hash256
+		Previous []byte
+		Current  []byte
+	}
+
+	// AccountDiff is the change of an account, Previous is nil if the account is created by the block
+	AccountDiff struct {
+		Address  address.Address
+		Previous *AccountState
+		Current  *AccountState
+		Storage  []*StorageChange
+	}
+
+	// BlockDiff is the state changes of a block
+	BlockDiff struct {
+		Height   uint64
+		Accounts []*AccountDiff
+		// Changes are all the state changes of the block, including the ones decoded into accounts
+		Changes []*factory.StateChange
+	}
+)
+
+func toProto(height uint64, changes []*factory.StateChange) *statediffpb.BlockStateDiff {
+	pb := &statediffpb.BlockStateDiff{
+		Height:  height,
+		Changes: make([]*statediffpb.StateChange, 0, len(changes)),
+	}
+	for _, c := range changes {
+		pb.Changes = append(pb.Changes, &statediffpb.StateChange{
+			Namespace: c.Namespace,
+			Key:       c.Key,
+			Previous:  c.Previous,
+			Current:   c.Current,
+		})
+	}
+	return pb
+}
+
+func fromProto(pb *statediffpb.BlockStateDiff) []*factory.StateChange {
+	changes := make([]*factory.StateChange, 0, len(pb.Changes))
+	for _, c := range pb.Changes {
+		changes = append(changes, &factory.StateChange{
+			Namespace: c.Namespace,
+			Key:       c.Key,
+			Previous:  nilIfEmpty(c.Previous),
+			Current:   nilIfEmpty(c.Current),
+		})
+	}
+	return changes
+}
+
+func nilIfEmpty(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+// newBlockDiff decodes the changes of accounts and contract storage. Before the protocols moved their states to
+// dedicated namespaces, the legacy protocol states are stored in the account namespace with 20-byte keys too, and
+// the ones which cannot be decoded as accounts are skipped.
+func newBlockDiff(height uint64, changes []*factory.StateChange, legacyStates bool) (*BlockDiff, error) {
+	diff := &BlockDiff{
+		Height:  height,
+		Changes: changes,
+	}
+	var (
+		contracts []*AccountDiff
+		nodes     []*factory.StateChange
+		migrated  = make(map[hash.Hash160]struct{})
+	)
+	for _, c := range changes {
+		if c.Namespace == _rewardingNamespace {
+			// the rewarding protocol deletes the legacy copy of a state when it is written to the
+			// rewarding namespace, the legacy key is the hash of the key in the rewarding namespace
+			migrated[hash.Hash160b(c.Key)] = struct{}{}
+		}
+	}
+	for _, c := range changes {
+		switch c.Namespace {
+		case factory.AccountKVNamespace:
+			if len(c.Key) != len(hash.Hash160{}) {
+				continue
+			}
+			if _, ok := migrated[hash.BytesToHash160(c.Key)]; ok {
+				continue
+			}
+			acct, err := newAccountDiff(c)
+			if err != nil {
+				if legacyStates {
+					continue
+				}
+				return nil, err
+			}
+			diff.Accounts = append(diff.Accounts, acct)
+			if isContract(acct.Previous) || isContract(acct.Current) {
+				contracts = append(contracts, acct)
+			}
+		case evm.ContractKVNameSpace:
+			nodes = append(nodes, c)
+		}
+	}
+	slots := make(map[*AccountDiff]map[hash.Hash256]*StorageChange)
+	for _, c := range nodes {
+		acct, key, value, current, err := decodeStorageChange(contracts, c)
+		if err != nil {
+			return nil, err
+		}
+		if acct == nil {
+			continue
+		}
+		if _, ok := slots[acct]; !ok {
+			slots[acct] = make(map[hash.Hash256]*StorageChange)
+		}
+		slot, ok := slots[acct][key]
+		if !ok {
+			slot = &StorageChange{Key: key}
+			slots[acct][key] = slot
+			acct.Storage = append(acct.Storage, slot)
+		}
+		if current {
+			slot.Current = value
+		} else {
+			slot.Previous = value
+		}
+	}
+	for acct := range slots {
+		sort.Slice(acct.Storage, func(i, j int) bool {
+			return bytes.Compare(acct.Storage[i].Key[:], acct.Storage[j].Key[:]) < 0
+		})
+	}
+	return diff, nil
+}
+
+func newAccountDiff(c *factory.StateChange) (*AccountDiff, error) {
+	addr, err := address.FromBytes(c.Key)
+	if err != nil {
+		return nil, err
+	}
+	diff := &AccountDiff{Address: addr}
+	if diff.Previous, err = decodeAccount(c.Previous); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode previous state of account %s", addr.String())
+	}
+	if diff.Current, err = decodeAccount(c.Current); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode current state of account %s", addr.String())
+	}
+	return diff, nil
+}
+
+func decodeAccount(data []byte) (*AccountState, error) {
+	if data == nil {
+		return nil, nil
+	}
+	// the data is checked before it is converted, since a state of another type can be unmarshaled into an
+	// account with unknown fields, and the conversion panics on an invalid account type or balance
+	pb := &accountpb.Account{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, err
+	}
+	if len(pb.ProtoReflect().GetUnknown()) > 0 {
+		return nil, errors.New("unknown fields in account")
+	}
+	if _, ok := accountpb.AccountType_name[int32(pb.Type)]; !ok {
+		return nil, errors.Errorf("invalid account type %d", pb.Type)
+	}
+	if _, ok := new(big.Int).SetString(pb.Balance, 10); !ok && pb.Balance != "" {
+		return nil, errors.Errorf("invalid balance %s", pb.Balance)
+	}
+	acct := &state.Account{}
+	acct.FromProto(pb)
+	return &AccountState{
+		Balance:  acct.Balance,
+		Nonce:    acct.PendingNonceConsideringFreshAccount(),
+		CodeHash: acct.CodeHash,
+	}, nil
+}
+
+func isContract(acct *AccountState) bool {
+	return acct != nil && len(acct.CodeHash) > 0
+}
+
+// decodeStorageChange decodes the storage slot from a changed leaf node of a contract storage trie. A leaf written
+// by the block holds the current value of the slot, and a leaf deleted by the block holds the previous value. The
+// node key is the hash of the contract address and the node, which identifies the contract the slot belongs to.
+func decodeStorageChange(contracts []*AccountDiff, c *factory.StateChange) (*AccountDiff, hash.Hash256, []byte, bool, error) {
+	data, current := c.Current, true
+	if data == nil {
+		data, current = c.Previous, false
+	}
+	pb := &triepb.NodePb{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, hash.ZeroHash256, nil, false, errors.Wrapf(err, "failed to decode trie node %x", c.Key)
+	}
+	leaf := pb.GetLeaf()
+	if leaf == nil || len(leaf.Path) != len(hash.Hash256{}) {
+		return nil, hash.ZeroHash256, nil, false, nil
+	}
+	for _, acct := range contracts {
+		addr := acct.Address.Bytes()
+		h := hash.Hash256b(append(addr[:len(addr):len(addr)], data...))
+		if bytes.Equal(h[:], c.Key) {
+			return acct, hash.BytesToHash256(leaf.Path), leaf.Value, current, nil
+		}
+	}
+	return nil, hash.ZeroHash256, nil, false, nil
+}
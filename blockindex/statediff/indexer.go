@@ -0,0 +1,217 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package statediff
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff/statediffpb"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+)
+
+const (
+	_diffNS = "sdf"
+	_metaNS = "sdm"
+)
+
+var (
+	_writeHeight  = []byte("wh")
+	_expireHeight = []byte("eh")
+
+	// ErrNotExist indicates the state diff of the height is not recorded
+	ErrNotExist = errors.New("state diff does not exist")
+)
+
+type (
+	// Indexer records the state changes of each block, the previous and current values of the changed
+	// states are kept for the blocks in the retention window. It is a factory.StateDiffHandler, the
+	// changes of a block are written before the state factory commits the block.
+	Indexer struct {
+		mutex     sync.RWMutex
+		kvStore   db.KVStore
+		retention uint64
+		// legacy protocol states are written to the account namespace below v2StorageHeight
+		v2StorageHeight uint64
+		// height is the latest height written
+		height uint64
+		// expire is the lowest height kept in the store
+		expire uint64
+	}
+)
+
+var (
+	_ factory.StateDiffHandler  = (*Indexer)(nil)
+	_ factory.StateDiffRewinder = (*Indexer)(nil)
+)
+
+// NewIndexer creates a new state diff indexer, which keeps the diffs of the last retention blocks. The protocols
+// store their states in dedicated namespaces from v2StorageHeight on, which is the Greenland height.
+func NewIndexer(kvStore db.KVStore, retention uint64, v2StorageHeight uint64) (*Indexer, error) {
+	if kvStore == nil {
+		return nil, errors.New("empty kv store")
+	}
+	if retention == 0 {
+		return nil, errors.New("retention cannot be 0")
+	}
+	return &Indexer{
+		kvStore:         kvStore,
+		retention:       retention,
+		v2StorageHeight: v2StorageHeight,
+	}, nil
+}
+
+// Start starts the indexer
+func (idx *Indexer) Start(ctx context.Context) error {
+	if err := idx.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	var err error
+	if idx.height, err = idx.getMeta(_writeHeight); err != nil {
+		return err
+	}
+	if idx.expire, err = idx.getMeta(_expireHeight); err != nil {
+		return err
+	}
+	// in case the retention window has shrunk, do a one-time purge
+	b := batch.NewBatch()
+	expire := idx.expireDiffs(idx.height, b)
+	if b.Size() > 0 {
+		if err := idx.kvStore.WriteBatch(b); err != nil {
+			return err
+		}
+	}
+	idx.expire = expire
+	return nil
+}
+
+// Stop stops the indexer
+func (idx *Indexer) Stop(ctx context.Context) error {
+	return idx.kvStore.Stop(ctx)
+}
+
+// Height returns the latest height of which the state diff is recorded
+func (idx *Indexer) Height() (uint64, error) {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return idx.height, nil
+}
+
+// Retention returns the number of the latest blocks of which the state diffs are kept
+func (idx *Indexer) Retention() uint64 {
+	return idx.retention
+}
+
+// HandleStateChanges records the state changes of the block at the height
+func (idx *Indexer) HandleStateChanges(_ context.Context, height uint64, changes []*factory.StateChange) error {
+	if height == 0 {
+		// the genesis states are not recorded
+		return nil
+	}
+	data, err := proto.Marshal(toProto(height, changes))
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize state diff at height %d", height)
+	}
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	b := batch.NewBatch()
+	b.Put(_diffNS, byteutil.Uint64ToBytesBigEndian(height), data, "failed to put state diff")
+	b.Put(_metaNS, _writeHeight, byteutil.Uint64ToBytesBigEndian(height), "failed to put write height")
+	expire := idx.expireDiffs(height, b)
+	if err := idx.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrapf(err, "failed to write state diff at height %d", height)
+	}
+	idx.height = height
+	idx.expire = expire
+	return nil
+}
+
+// Rewind deletes the state diffs of the blocks above the height
+func (idx *Indexer) Rewind(_ context.Context, height uint64) error {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	if height >= idx.height {
+		return nil
+	}
+	b := batch.NewBatch()
+	for h := idx.height; h > height && h >= idx.expire; h-- {
+		b.Delete(_diffNS, byteutil.Uint64ToBytesBigEndian(h), "failed to delete state diff")
+	}
+	b.Put(_metaNS, _writeHeight, byteutil.Uint64ToBytesBigEndian(height), "failed to put write height")
+	if err := idx.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrapf(err, "failed to rewind state diff to height %d", height)
+	}
+	idx.height = height
+	return nil
+}
+
+// StateDiff returns the state diff of the block at the height
+func (idx *Indexer) StateDiff(height uint64) (*BlockDiff, error) {
+	changes, err := idx.StateChanges(height)
+	if err != nil {
+		return nil, err
+	}
+	return newBlockDiff(height, changes, height < idx.v2StorageHeight)
+}
+
+// StateChanges returns the state changes of the block at the height
+func (idx *Indexer) StateChanges(height uint64) ([]*factory.StateChange, error) {
+	idx.mutex.RLock()
+	if height > idx.height || height < idx.expire {
+		idx.mutex.RUnlock()
+		return nil, errors.Wrapf(ErrNotExist, "height %d is out of range [%d, %d]", height, idx.expire, idx.height)
+	}
+	data, err := idx.kvStore.Get(_diffNS, byteutil.Uint64ToBytesBigEndian(height))
+	idx.mutex.RUnlock()
+	switch errors.Cause(err) {
+	case nil:
+	case db.ErrNotExist:
+		return nil, errors.Wrapf(ErrNotExist, "state diff at height %d is not recorded", height)
+	default:
+		return nil, err
+	}
+	pb := &statediffpb.BlockStateDiff{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize state diff at height %d", height)
+	}
+	return fromProto(pb), nil
+}
+
+func (idx *Indexer) getMeta(key []byte) (uint64, error) {
+	value, err := idx.kvStore.Get(_metaNS, key)
+	switch errors.Cause(err) {
+	case nil:
+		return byteutil.BytesToUint64BigEndian(value), nil
+	case db.ErrNotExist:
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// expireDiffs deletes the diffs which fall out of the retention window ending at the height, and returns the
+// lowest height kept
+func (idx *Indexer) expireDiffs(height uint64, b batch.KVStoreBatch) uint64 {
+	if height < idx.retention {
+		return idx.expire
+	}
+	expire := height - idx.retention + 1
+	if expire <= idx.expire {
+		return idx.expire
+	}
+	// only the heights written before can exist in the store
+	for h := idx.expire; h < expire && h <= idx.height; h++ {
+		b.Delete(_diffNS, byteutil.Uint64ToBytesBigEndian(h), "failed to delete state diff")
+	}
+	b.Put(_metaNS, _expireHeight, byteutil.Uint64ToBytesBigEndian(expire), "failed to put expire height")
+	return expire
+}
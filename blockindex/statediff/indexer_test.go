@@ -0,0 +1,378 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package statediff
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/unit"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+// _counterContract deploys a contract which increments the value in slot 0 on every call
+const _counterContract = "600a600c600039600a6000f3600054600101600055" + "00"
+
+func TestIndexer(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	g.VanuatuBlockHeight = 1
+	testutil.NormalizeGenesisHeights(&g.Blockchain)
+
+	kv := db.NewMemKVStore()
+	indexer, err := NewIndexer(kv, 2, g.GreenlandBlockHeight)
+	r.NoError(err)
+	cfg := factory.DefaultConfig
+	cfg.Genesis = g
+	cfg.Chain.HistoryStateDepth = 2
+	registry := protocol.NewRegistry()
+	r.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+	r.NoError(rewarding.NewProtocol(g.Rewarding).Register(registry))
+	r.NoError(execution.NewProtocol(nil, rewarding.DepositGas, nil).Register(registry))
+	sf, err := factory.NewStateDB(cfg, db.NewMemKVStore(),
+		factory.RegistryStateDBOption(registry),
+		factory.SkipBlockValidationStateDBOption(),
+		factory.StateDiffStateDBOption(indexer),
+		factory.HistoryStateDBOption(indexer),
+	)
+	r.NoError(err)
+	ctx := genesis.WithGenesisContext(context.Background(), g)
+	r.NoError(indexer.Start(ctx))
+	r.NoError(sf.Start(protocol.WithBlockCtx(protocol.WithRegistry(ctx, registry), protocol.BlockCtx{})))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+		r.NoError(indexer.Stop(ctx))
+	}()
+
+	var (
+		gasPrice = big.NewInt(2 * unit.Qev)
+		prevHash = hash.ZeroHash256
+		contract address.Address
+	)
+	putBlock := func(height uint64, acts ...*action.SealedEnvelope) *block.Block {
+		grant, err := action.Sign((&action.EnvelopeBuilder{}).SetNonce(0).SetGasPrice(big.NewInt(0)).
+			SetAction(action.NewGrantReward(action.BlockReward, height)).Build(), identityset.PrivateKey(27))
+		r.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(g.Timestamp+int64(height)*5, 0)).
+			AddActions(append(acts, grant)...).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(sf.PutBlock(protocol.WithFeatureCtx(protocol.WithBlockchainCtx(
+			protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+				BlockHeight:    height,
+				BlockTimeStamp: blk.Timestamp(),
+				Producer:       identityset.Address(27),
+				GasLimit:       g.BlockGasLimitByHeight(height),
+				BaseFee:        big.NewInt(unit.Qev),
+			}),
+			protocol.BlockchainCtx{
+				ChainID: 1,
+				Tip:     protocol.TipInfo{Height: height - 1, Hash: prevHash},
+				GetBlockHash: func(uint64) (hash.Hash256, error) {
+					return hash.ZeroHash256, nil
+				},
+				GetBlockTime: func(uint64) (time.Time, error) {
+					return time.Time{}, nil
+				},
+			},
+		)), &blk))
+		for _, receipt := range blk.Receipts {
+			r.EqualValues(1, receipt.Status)
+		}
+		prevHash = blk.HashBlock()
+		return &blk
+	}
+	findAccount := func(diff *BlockDiff, addr address.Address) *AccountDiff {
+		for _, acct := range diff.Accounts {
+			if acct.Address.String() == addr.String() {
+				return acct
+			}
+		}
+		return nil
+	}
+
+	// deploy the counter contract, and transfer to a new account
+	code, err := hex.DecodeString(_counterContract)
+	r.NoError(err)
+	deploy, err := action.SignedExecution("", identityset.PrivateKey(0), 0, big.NewInt(0), 200000, gasPrice, code, action.WithChainID(1))
+	r.NoError(err)
+	recipient := identityset.Address(30)
+	transfer, err := action.SignedTransfer(recipient.String(), identityset.PrivateKey(1), 0, big.NewInt(10), nil, 100000, gasPrice, action.WithChainID(1))
+	r.NoError(err)
+	blk := putBlock(1, deploy, transfer)
+	contract, err = address.FromString(blk.Receipts[0].ContractAddress)
+	r.NoError(err)
+	height, err := indexer.Height()
+	r.NoError(err)
+	r.EqualValues(1, height)
+
+	diff, err := indexer.StateDiff(1)
+	r.NoError(err)
+	r.EqualValues(1, diff.Height)
+	r.NotEmpty(diff.Changes)
+	sender := findAccount(diff, identityset.Address(1))
+	r.NotNil(sender)
+	r.NotNil(sender.Previous)
+	r.NotNil(sender.Current)
+	r.EqualValues(1, sender.Current.Nonce-sender.Previous.Nonce)
+	acct, err := accountutil.AccountState(ctx, sf, identityset.Address(1))
+	r.NoError(err)
+	r.Equal(acct.Balance, sender.Current.Balance)
+	created := findAccount(diff, recipient)
+	r.NotNil(created)
+	r.Nil(created.Previous)
+	r.EqualValues(10, created.Current.Balance.Int64())
+	deployed := findAccount(diff, contract)
+	r.NotNil(deployed)
+	r.Nil(deployed.Previous)
+	r.NotEmpty(deployed.Current.CodeHash)
+	r.Empty(deployed.Storage)
+
+	// call the contract twice, the slot changes from empty to 1, and then from 1 to 2
+	for i, height := range []uint64{2, 3} {
+		call, err := action.SignedExecution(contract.String(), identityset.PrivateKey(2), uint64(i), big.NewInt(0), 200000, gasPrice, nil, action.WithChainID(1))
+		r.NoError(err)
+		putBlock(height, call)
+	}
+	diff, err = indexer.StateDiff(2)
+	r.NoError(err)
+	called := findAccount(diff, contract)
+	r.NotNil(called)
+	r.Equal([]*StorageChange{{Key: hash.ZeroHash256, Current: []byte{1}}}, trimSlots(called.Storage))
+	diff, err = indexer.StateDiff(3)
+	r.NoError(err)
+	called = findAccount(diff, contract)
+	r.NotNil(called)
+	r.Equal([]*StorageChange{{Key: hash.ZeroHash256, Previous: []byte{1}, Current: []byte{2}}}, trimSlots(called.Storage))
+
+	// the states below the tip are reconstructed by reverting the diffs
+	for _, e := range []struct {
+		height uint64
+		nonce  uint64
+	}{
+		{1, 0}, {2, 1}, {3, 2},
+	} {
+		ws, err := sf.WorkingSetAtHeight(ctx, e.height)
+		r.NoError(err)
+		caller, err := accountutil.AccountState(ctx, ws, identityset.Address(2))
+		r.NoError(err)
+		r.Equal(e.nonce, caller.PendingNonceConsideringFreshAccount())
+	}
+	ws, err := sf.WorkingSetAtHeight(ctx, 1)
+	r.NoError(err)
+	acct, err = accountutil.AccountState(ctx, ws, identityset.Address(1))
+	r.NoError(err)
+	r.Equal(sender.Current.Balance, acct.Balance)
+
+	// the diff of height 1 is out of the retention window
+	_, err = indexer.StateDiff(1)
+	r.Equal(ErrNotExist, errors.Cause(err))
+	_, err = sf.WorkingSetAtHeight(ctx, 0)
+	r.Error(err)
+	_, err = kv.Get(_diffNS, []byte{0, 0, 0, 0, 0, 0, 0, 1})
+	r.Equal(db.ErrNotExist, errors.Cause(err))
+	_, err = indexer.StateDiff(4)
+	r.Equal(ErrNotExist, errors.Cause(err))
+}
+
+func TestBlockDiffLegacyStates(t *testing.T) {
+	r := require.New(t)
+	// a legacy rewarding state, which cannot be decoded as an account
+	rewardingKey := []byte("rewarding key")
+	legacyKey := hash.Hash160b(rewardingKey)
+	legacy := &factory.StateChange{
+		Namespace: factory.AccountKVNamespace,
+		Key:       legacyKey[:],
+		Previous:  []byte{0x0a, 0x01, 0x31},
+	}
+	// the legacy protocol states are skipped before the protocols moved to their own namespaces
+	diff, err := newBlockDiff(1, []*factory.StateChange{legacy}, true)
+	r.NoError(err)
+	r.Empty(diff.Accounts)
+	_, err = newBlockDiff(1, []*factory.StateChange{legacy}, false)
+	r.Error(err)
+	// the legacy copy of a migrated rewarding state is skipped
+	diff, err = newBlockDiff(1, []*factory.StateChange{legacy, {
+		Namespace: _rewardingNamespace,
+		Key:       rewardingKey,
+		Current:   []byte{0x0a, 0x01, 0x31},
+	}}, false)
+	r.NoError(err)
+	r.Empty(diff.Accounts)
+	r.Len(diff.Changes, 2)
+}
+
+// trimSlots trims the leading zeros of the slot values
+func trimSlots(slots []*StorageChange) []*StorageChange {
+	trim := func(v []byte) []byte {
+		if v == nil {
+			return nil
+		}
+		return new(big.Int).SetBytes(v).Bytes()
+	}
+	for _, s := range slots {
+		s.Previous, s.Current = trim(s.Previous), trim(s.Current)
+	}
+	return slots
+}
+
+func TestRollbackChain(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	g.VanuatuBlockHeight = 1
+	testutil.NormalizeGenesisHeights(&g.Blockchain)
+
+	dbCfg := db.DefaultConfig
+	dbCfg.DbPath = filepath.Join(t.TempDir(), "diff.db")
+	indexer, err := NewIndexer(db.NewBoltDB(dbCfg), 10, g.GreenlandBlockHeight)
+	r.NoError(err)
+	dbCfg.DbPath = filepath.Join(t.TempDir(), "trie.db")
+	stateKV := db.NewBoltDB(dbCfg)
+	registry := protocol.NewRegistry()
+	r.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+	r.NoError(rewarding.NewProtocol(g.Rewarding).Register(registry))
+	r.NoError(execution.NewProtocol(nil, rewarding.DepositGas, nil).Register(registry))
+	newStateDB := func() factory.Factory {
+		cfg := factory.DefaultConfig
+		cfg.Genesis = g
+		sf, err := factory.NewStateDB(cfg, stateKV,
+			factory.RegistryStateDBOption(registry),
+			factory.SkipBlockValidationStateDBOption(),
+			factory.StateDiffStateDBOption(indexer),
+		)
+		r.NoError(err)
+		return sf
+	}
+	store, err := filedao.NewFileDAOInMemForTest()
+	r.NoError(err)
+	ctx := genesis.WithGenesisContext(context.Background(), g)
+	r.NoError(store.Start(ctx))
+	defer store.Stop(ctx)
+	r.NoError(indexer.Start(ctx))
+	sf := newStateDB()
+	r.NoError(sf.Start(protocol.WithBlockCtx(protocol.WithRegistry(ctx, registry), protocol.BlockCtx{})))
+
+	gasPrice := big.NewInt(2 * unit.Qev)
+	putBlock := func(sf factory.Factory, height uint64, prevHash hash.Hash256, acts ...*action.SealedEnvelope) *block.Block {
+		grant, err := action.Sign((&action.EnvelopeBuilder{}).SetNonce(0).SetGasPrice(big.NewInt(0)).
+			SetAction(action.NewGrantReward(action.BlockReward, height)).Build(), identityset.PrivateKey(27))
+		r.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(g.Timestamp+int64(height)*5, 0)).
+			AddActions(append(acts, grant)...).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(sf.PutBlock(protocol.WithFeatureCtx(protocol.WithBlockchainCtx(
+			protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+				BlockHeight:    height,
+				BlockTimeStamp: blk.Timestamp(),
+				Producer:       identityset.Address(27),
+				GasLimit:       g.BlockGasLimitByHeight(height),
+				BaseFee:        big.NewInt(unit.Qev),
+			}),
+			protocol.BlockchainCtx{
+				ChainID: 1,
+				Tip:     protocol.TipInfo{Height: height - 1, Hash: prevHash},
+				GetBlockHash: func(uint64) (hash.Hash256, error) {
+					return hash.ZeroHash256, nil
+				},
+				GetBlockTime: func(uint64) (time.Time, error) {
+					return time.Time{}, nil
+				},
+			},
+		)), &blk))
+		for _, receipt := range blk.Receipts {
+			r.EqualValues(1, receipt.Status)
+		}
+		r.NoError(store.PutBlock(ctx, &blk))
+		return &blk
+	}
+	transfer := func(sender, nonce int, recipient address.Address, amount int64) *action.SealedEnvelope {
+		tsf, err := action.SignedTransfer(recipient.String(), identityset.PrivateKey(sender), uint64(nonce), big.NewInt(amount), nil, 100000, gasPrice, action.WithChainID(1))
+		r.NoError(err)
+		return tsf
+	}
+	balances := func(sf factory.Factory, addrs ...address.Address) []string {
+		var b []string
+		for _, addr := range addrs {
+			acct, err := accountutil.AccountState(ctx, sf, addr)
+			r.NoError(err)
+			b = append(b, fmt.Sprintf("%d:%s", acct.PendingNonceConsideringFreshAccount(), acct.Balance))
+		}
+		return b
+	}
+	created := identityset.Address(30)
+	addrs := []address.Address{identityset.Address(1), identityset.Address(2), identityset.Address(27), created}
+
+	blk := putBlock(sf, 1, hash.ZeroHash256, transfer(1, 0, identityset.Address(2), 100))
+	atOne := balances(sf, addrs...)
+	blk = putBlock(sf, 2, blk.HashBlock(), transfer(2, 0, created, 10))
+	putBlock(sf, 3, blk.HashBlock(), transfer(1, 1, created, 20), transfer(2, 1, identityset.Address(1), 30))
+	r.NoError(sf.Stop(ctx))
+
+	// roll back the chain db and the state db to height 1
+	rewinder := factory.NewStateDBRewinder(stateKV, indexer)
+	r.NoError(rewinder.Start(ctx))
+	rb := blockdao.NewRollbacker(store)
+	rb.AddIndexer("state db", rewinder)
+	// the diffs of all blocks above the target are recorded
+	steps, err := rb.Plan(1)
+	r.NoError(err)
+	r.NoError(steps[0].Err)
+	r.NoError(rb.Rollback(ctx, 1))
+	for _, h := range []func() (uint64, error){store.Height, rewinder.Height, indexer.Height} {
+		height, err := h()
+		r.NoError(err)
+		r.EqualValues(1, height)
+	}
+	r.NoError(rewinder.Stop(ctx))
+	_, err = indexer.StateChanges(2)
+	r.Equal(ErrNotExist, errors.Cause(err))
+
+	// the states are the same as at height 1, and the chain grows again from there
+	sf = newStateDB()
+	r.NoError(sf.Start(protocol.WithBlockCtx(protocol.WithRegistry(ctx, registry), protocol.BlockCtx{})))
+	defer func() {
+		r.NoError(sf.Stop(ctx))
+		r.NoError(indexer.Stop(ctx))
+	}()
+	height, err := sf.Height()
+	r.NoError(err)
+	r.EqualValues(1, height)
+	r.Equal(atOne, balances(sf, addrs...))
+	putBlock(sf, 2, blk.PrevHash(), transfer(1, 1, created, 5))
+	acct, err := accountutil.AccountState(ctx, sf, created)
+	r.NoError(err)
+	r.EqualValues(5, acct.Balance.Int64())
+}
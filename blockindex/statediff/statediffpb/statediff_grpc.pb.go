@@ -0,0 +1,103 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.1
+// source: statediff.proto
+
+package statediffpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StateDiffServiceClient is the client API for StateDiffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StateDiffServiceClient interface {
+	GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*GetStateDiffResponse, error)
+}
+
+type stateDiffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStateDiffServiceClient(cc grpc.ClientConnInterface) StateDiffServiceClient {
+	return &stateDiffServiceClient{cc}
+}
+
+func (c *stateDiffServiceClient) GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*GetStateDiffResponse, error) {
+	out := new(GetStateDiffResponse)
+	err := c.cc.Invoke(ctx, "/statediffpb.StateDiffService/GetStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateDiffServiceServer is the server API for StateDiffService service.
+// All implementations should embed UnimplementedStateDiffServiceServer
+// for forward compatibility
+type StateDiffServiceServer interface {
+	GetStateDiff(context.Context, *GetStateDiffRequest) (*GetStateDiffResponse, error)
+}
+
+// UnimplementedStateDiffServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStateDiffServiceServer struct {
+}
+
+func (UnimplementedStateDiffServiceServer) GetStateDiff(context.Context, *GetStateDiffRequest) (*GetStateDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDiff not implemented")
+}
+
+// UnsafeStateDiffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StateDiffServiceServer will
+// result in compilation errors.
+type UnsafeStateDiffServiceServer interface {
+	mustEmbedUnimplementedStateDiffServiceServer()
+}
+
+func RegisterStateDiffServiceServer(s grpc.ServiceRegistrar, srv StateDiffServiceServer) {
+	s.RegisterService(&StateDiffService_ServiceDesc, srv)
+}
+
+func _StateDiffService_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateDiffServiceServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statediffpb.StateDiffService/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateDiffServiceServer).GetStateDiff(ctx, req.(*GetStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StateDiffService_ServiceDesc is the grpc.ServiceDesc for StateDiffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StateDiffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statediffpb.StateDiffService",
+	HandlerType: (*StateDiffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _StateDiffService_GetStateDiff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "statediff.proto",
+}
@@ -12,7 +12,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -21,7 +20,6 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
-	"github.com/iotexproject/iotex-core/v2/pkg/fastrand"
 	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/routine"
@@ -41,6 +39,11 @@ type (
 	BlockByHeight func(uint64) (*block.Block, error)
 	// CommitBlock commits a block to blockchain
 	CommitBlock func(*block.Block) error
+	// PrefetchBlock prefetches the states of a block before it is committed
+	PrefetchBlock func(*block.Block)
+
+	// Option sets the optional parameters of block syncer
+	Option func(*blockSyncer)
 
 	// BlockSync defines the interface of blocksyncer
 	BlockSync interface {
@@ -50,8 +53,12 @@ type (
 		TargetHeight() uint64
 		// ProcessSyncRequest processes a block sync request
 		ProcessSyncRequest(context.Context, peer.AddrInfo, uint64, uint64) error
-		// ProcessBlock processes an incoming block
-		ProcessBlock(context.Context, string, *block.Block) error
+		// ProcessBlock processes an incoming block, of the size in bytes it is received in
+		ProcessBlock(context.Context, string, *block.Block, int) error
+		// ProcessHeaderSyncRequest processes a block header sync request
+		ProcessHeaderSyncRequest(context.Context, peer.AddrInfo, uint64, uint64) error
+		// ProcessBlockHeader processes an incoming block header
+		ProcessBlockHeader(context.Context, string, *block.Block) error
 		// SyncStatus report block sync status
 		SyncStatus() (startingHeight uint64, currentHeight uint64, targetHeight uint64, syncSpeedDesc string)
 	}
@@ -63,15 +70,24 @@ type (
 		cfg Config
 		buf *blockBuffer
 
-		tipHeightHandler     TipHeight
-		blockByHeightHandler BlockByHeight
-		commitBlockHandler   CommitBlock
-		p2pNeighbor          Neighbors
-		unicastOutbound      UniCastOutbound
-		blockP2pPeer         BlockPeer
+		tipHeightHandler      TipHeight
+		blockByHeightHandler  BlockByHeight
+		commitBlockHandler    CommitBlock
+		prefetchHandler       PrefetchBlock
+		headerByHeightHandler HeaderByHeight
+		validateHeader        ValidateHeader
+		p2pNeighbor           Neighbors
+		unicastOutbound       UniCastOutbound
+		blockP2pPeer          BlockPeer
 
 		syncTask      *routine.RecurringTask
 		syncStageTask *routine.RecurringTask
+		retryTask     *routine.RecurringTask
+
+		peerScores *peerScoreboard
+		chunks     map[uint64]*chunkRequest // the chunks being fetched, keyed by start height
+		chunkMu    sync.Mutex
+		headers    *headerChain
 
 		syncStageHeight   uint64
 		syncBlockIncrease uint64
@@ -117,7 +133,15 @@ func (*dummyBlockSync) ProcessSyncRequest(context.Context, peer.AddrInfo, uint64
 	return nil
 }
 
-func (*dummyBlockSync) ProcessBlock(context.Context, string, *block.Block) error {
+func (*dummyBlockSync) ProcessBlock(context.Context, string, *block.Block, int) error {
+	return nil
+}
+
+func (*dummyBlockSync) ProcessHeaderSyncRequest(context.Context, peer.AddrInfo, uint64, uint64) error {
+	return nil
+}
+
+func (*dummyBlockSync) ProcessBlockHeader(context.Context, string, *block.Block) error {
 	return nil
 }
 
@@ -129,6 +153,13 @@ func (*dummyBlockSync) BuildReport() string {
 	return ""
 }
 
+// WithPrefetchBlock sets the handler to prefetch the states of the next block while committing a block
+func WithPrefetchBlock(prefetchHandler PrefetchBlock) Option {
+	return func(bs *blockSyncer) {
+		bs.prefetchHandler = prefetchHandler
+	}
+}
+
 // NewBlockSyncer returns a new block syncer instance
 func NewBlockSyncer(
 	cfg Config,
@@ -138,6 +169,7 @@ func NewBlockSyncer(
 	p2pNeighbor Neighbors,
 	uniCastHandler UniCastOutbound,
 	blockP2pPeer BlockPeer,
+	opts ...Option,
 ) (BlockSync, error) {
 	bs := &blockSyncer{
 		cfg:                  cfg,
@@ -149,12 +181,21 @@ func NewBlockSyncer(
 		p2pNeighbor:          p2pNeighbor,
 		unicastOutbound:      uniCastHandler,
 		blockP2pPeer:         blockP2pPeer,
+		peerScores:           newPeerScoreboard(),
+		chunks:               map[uint64]*chunkRequest{},
+		headers:              newHeaderChain(),
 		targetHeight:         0,
 	}
+	for _, opt := range opts {
+		opt(bs)
+	}
 	if bs.cfg.Interval != 0 {
 		bs.syncTask = routine.NewRecurringTask(bs.sync, bs.cfg.Interval)
 		bs.syncStageTask = routine.NewRecurringTask(bs.syncStageChecker, bs.cfg.Interval)
 	}
+	if bs.cfg.ChunkTimeout != 0 {
+		bs.retryTask = routine.NewRecurringTask(bs.retry, bs.cfg.ChunkTimeout)
+	}
 	atomic.StoreUint64(&bs.syncBlockIncrease, 0)
 	return bs, nil
 }
@@ -173,6 +214,7 @@ func (bs *blockSyncer) commitBlocks(blks []*peerBlock) bool {
 		case blockchain.ErrPaused:
 			log.L().Info("blockchain is paused, skip committing block", zap.Uint64("height", blk.block.Height()))
 		default:
+			bs.peerScores.InvalidBlock(blk.pid)
 			bs.blockP2pPeer(blk.pid)
 			log.L().Error("failed to commit block", zap.Error(err), zap.Uint64("height", blk.block.Height()), zap.String("peer", blk.pid))
 		}
@@ -192,7 +234,15 @@ func (bs *blockSyncer) sync() {
 	if updateTime.Add(bs.cfg.Interval).After(time.Now()) {
 		return
 	}
-	intervals := bs.buf.GetBlocksIntervalsToSync(bs.tipHeightHandler(), targetHeight)
+	tip := bs.tipHeightHandler()
+	if bs.headerFirst() {
+		bs.syncHeaders(context.Background())
+		// the blocks are requested only for the verified headers, unless no header is being fetched
+		if verified, fetching := bs.verifiedHeight(time.Now()); (verified > tip || fetching) && verified < targetHeight {
+			targetHeight = verified
+		}
+	}
+	intervals := bs.buf.GetBlocksIntervalsToSync(tip, targetHeight)
 	// no sync
 	if len(intervals) == 0 {
 		return
@@ -202,36 +252,13 @@ func (bs *blockSyncer) sync() {
 	log.L().Info("block sync intervals.",
 		zap.Any("intervals", intervals),
 		zap.Uint64("targetHeight", targetHeight))
-	for i, interval := range intervals {
-		bs.requestBlock(context.Background(), interval.Start, interval.End, bs.cfg.MaxRepeat-i/bs.cfg.RepeatDecayStep)
-	}
+	bs.requestChunks(context.Background(), intervals)
 }
 
-func (bs *blockSyncer) requestBlock(ctx context.Context, start uint64, end uint64, repeat int) {
-	peers, err := bs.p2pNeighbor()
-	if err != nil {
-		log.L().Error("failed to get neighbours", zap.Error(err))
-		return
-	}
-	if len(peers) == 0 {
-		log.L().Error("no peers")
-		return
-	}
-	if repeat < 2 {
-		repeat = 2
-	}
-	if repeat > len(peers) {
-		repeat = len(peers)
-	}
-	for i := 0; i < repeat; i++ {
-		peer := peers[fastrand.Uint32n(uint32(len(peers)))]
-		if err := bs.unicastOutbound(
-			ctx,
-			peer,
-			&iotexrpc.BlockSync{Start: start, End: end},
-		); err != nil {
-			log.L().Error("failed to request blocks", zap.Error(err), zap.String("peer", peer.ID.String()), zap.Uint64("start", start), zap.Uint64("end", end))
-		}
+func (bs *blockSyncer) retry() {
+	bs.retryChunks()
+	if bs.headerFirst() {
+		bs.syncHeaders(context.Background())
 	}
 }
 
@@ -250,7 +277,12 @@ func (bs *blockSyncer) Start(ctx context.Context) error {
 		}
 	}
 	if bs.syncStageTask != nil {
-		return bs.syncStageTask.Start(ctx)
+		if err := bs.syncStageTask.Start(ctx); err != nil {
+			return err
+		}
+	}
+	if bs.retryTask != nil {
+		return bs.retryTask.Start(ctx)
 	}
 	return nil
 }
@@ -258,6 +290,11 @@ func (bs *blockSyncer) Start(ctx context.Context) error {
 // Stop stops a block syncer
 func (bs *blockSyncer) Stop(ctx context.Context) error {
 	log.L().Debug("Stopping block syncer.")
+	if bs.retryTask != nil {
+		if err := bs.retryTask.Stop(ctx); err != nil {
+			return err
+		}
+	}
 	if bs.syncStageTask != nil {
 		if err := bs.syncStageTask.Stop(ctx); err != nil {
 			return err
@@ -271,11 +308,21 @@ func (bs *blockSyncer) Stop(ctx context.Context) error {
 	return nil
 }
 
-func (bs *blockSyncer) ProcessBlock(ctx context.Context, peer string, blk *block.Block) error {
+func (bs *blockSyncer) ProcessBlock(ctx context.Context, peer string, blk *block.Block, size int) error {
 	if blk == nil {
 		return errors.New("block is nil")
 	}
 
+	bs.observeBlock(peer, blk.Height(), size)
+	if bs.headerFirst() {
+		if err := bs.checkBody(peer, blk); err != nil {
+			bs.peerScores.InvalidBlock(peer)
+			bs.blockP2pPeer(peer)
+			return err
+		}
+		// the next batch of headers is requested after the blocks are committed
+		defer bs.syncHeaders(ctx)
+	}
 	tip := bs.tipHeightHandler()
 	added, targetHeight := bs.buf.AddBlock(tip, newPeerBlock(peer, blk))
 	bs.mu.Lock()
@@ -288,7 +335,14 @@ func (bs *blockSyncer) ProcessBlock(ctx context.Context, peer string, blk *block
 	}
 	syncedHeight := tip
 	for {
-		if !bs.commitBlocks(bs.buf.Pop(syncedHeight + 1)) {
+		blks := bs.buf.Pop(syncedHeight + 1)
+		if len(blks) > 0 && bs.prefetchHandler != nil {
+			// the states of the next block are read while the block is being committed
+			if next := bs.buf.Peek(syncedHeight + 2); len(next) > 0 {
+				bs.prefetchHandler(next[0].block)
+			}
+		}
+		if !bs.commitBlocks(blks) {
 			break
 		}
 		syncedHeight++
@@ -352,10 +406,11 @@ func (bs *blockSyncer) SyncStatus() (uint64, uint64, uint64, string) {
 func (bs *blockSyncer) BuildReport() string {
 	startingHeight, tipHeight, targetHeight, syncSpeedDesc := bs.SyncStatus()
 	return fmt.Sprintf(
-		"BlockSync startingHeight: %d, tipHeight: %d, targetHeight: %d, %s",
+		"BlockSync startingHeight: %d, tipHeight: %d, targetHeight: %d, %s%s",
 		startingHeight,
 		tipHeight,
 		targetHeight,
 		syncSpeedDesc,
+		bs.peerScores.BuildReport(),
 	)
 }
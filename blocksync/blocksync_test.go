@@ -233,17 +233,17 @@ func TestBlockSyncerProcessBlockTipHeight(t *testing.T) {
 
 	peer := "peer1"
 
-	require.NoError(bs.ProcessBlock(ctx, peer, blk))
+	require.NoError(bs.ProcessBlock(ctx, peer, blk, 0))
 	h2 := chain.TipHeight()
 	assert.Equal(t, h+1, h2)
 
 	// commit top
-	require.NoError(bs.ProcessBlock(ctx, peer, blk))
+	require.NoError(bs.ProcessBlock(ctx, peer, blk, 0))
 	h3 := chain.TipHeight()
 	assert.Equal(t, h+1, h3)
 
 	// commit same block again
-	require.NoError(bs.ProcessBlock(ctx, peer, blk))
+	require.NoError(bs.ProcessBlock(ctx, peer, blk, 0))
 	h4 := chain.TipHeight()
 	assert.Equal(t, h3, h4)
 }
@@ -326,22 +326,22 @@ func TestBlockSyncerProcessBlockOutOfOrder(t *testing.T) {
 	blk1, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk1)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk1))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk1, 0))
 	blk2, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk2)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk2))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk2, 0))
 	blk3, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk3)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk3))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk3, 0))
 	h1 := chain1.TipHeight()
 	assert.Equal(t, uint64(3), h1)
 
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk3))
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk2))
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk2))
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk1))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk3, 0))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk2, 0))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk2, 0))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk1, 0))
 	h2 := chain2.TipHeight()
 	assert.Equal(t, h1, h2)
 }
@@ -427,21 +427,21 @@ func TestBlockSyncerProcessBlock(t *testing.T) {
 	blk1, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk1)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk1))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk1, 0))
 	blk2, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk2)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk2))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk2, 0))
 	blk3, err := chain1.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk3)
 	require.NoError(err)
-	require.NoError(bs1.ProcessBlock(ctx, peer, blk3))
+	require.NoError(bs1.ProcessBlock(ctx, peer, blk3, 0))
 	h1 := chain1.TipHeight()
 	assert.Equal(t, uint64(3), h1)
 
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk2))
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk3))
-	require.NoError(bs2.ProcessBlock(ctx, peer, blk1))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk2, 0))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk3, 0))
+	require.NoError(bs2.ProcessBlock(ctx, peer, blk1, 0))
 	h2 := chain2.TipHeight()
 	assert.Equal(t, h1, h2)
 }
@@ -498,12 +498,12 @@ func TestBlockSyncerSync(t *testing.T) {
 	blk, err := chain.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk)
 	require.NoError(err)
-	require.NoError(bs.ProcessBlock(ctx, peer, blk))
+	require.NoError(bs.ProcessBlock(ctx, peer, blk, 0))
 
 	blk, err = chain.MintNewBlock(testutil.TimestampNow())
 	require.NotNil(blk)
 	require.NoError(err)
-	require.NoError(bs.ProcessBlock(ctx, peer, blk))
+	require.NoError(bs.ProcessBlock(ctx, peer, blk, 0))
 	time.Sleep(time.Millisecond << 7)
 }
 
@@ -539,7 +539,7 @@ func TestDummyBlockSync(t *testing.T) {
 	bs := NewDummyBlockSyncer()
 	require.NoError(bs.Start(nil))
 	require.NoError(bs.Stop(nil))
-	require.NoError(bs.ProcessBlock(nil, "", nil))
+	require.NoError(bs.ProcessBlock(nil, "", nil, 0))
 	require.NoError(bs.ProcessSyncRequest(nil, peer.AddrInfo{}, 0, 0))
 	require.Equal(bs.TargetHeight(), uint64(0))
 	startingHeight, currentHeight, targetHeight, desc := bs.SyncStatus()
@@ -548,3 +548,49 @@ func TestDummyBlockSync(t *testing.T) {
 	require.Zero(targetHeight)
 	require.Empty(desc)
 }
+
+func TestBlockSyncerPrefetch(t *testing.T) {
+	require := require.New(t)
+	cfg, err := newTestConfig()
+	require.NoError(err)
+
+	var (
+		tip        uint64
+		committed  []uint64
+		prefetched []uint64
+	)
+	bs, err := NewBlockSyncer(cfg.BlockSync,
+		func() uint64 {
+			return tip
+		},
+		nil,
+		func(blk *block.Block) error {
+			committed = append(committed, blk.Height())
+			tip = blk.Height()
+			return nil
+		},
+		func() ([]peer.AddrInfo, error) {
+			return []peer.AddrInfo{}, nil
+		},
+		func(context.Context, peer.AddrInfo, proto.Message) error {
+			return nil
+		},
+		func(string) {},
+		WithPrefetchBlock(func(blk *block.Block) {
+			prefetched = append(prefetched, blk.Height())
+		}),
+	)
+	require.NoError(err)
+
+	ctx := context.Background()
+	for _, height := range []uint64{3, 2, 1} {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		require.NoError(bs.ProcessBlock(ctx, "peer", &blk, 0))
+	}
+	require.Equal([]uint64{1, 2, 3}, committed)
+	// the next block is prefetched before committing a block
+	require.Equal([]uint64{2, 3}, prefetched)
+}
@@ -43,6 +43,17 @@ func (b *blockBuffer) Pop(height uint64) []*peerBlock {
 	return blks
 }
 
+// Peek returns the blocks of the height without removing them
+func (b *blockBuffer) Peek(height uint64) []*peerBlock {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	queue, ok := b.blockQueues[height]
+	if !ok {
+		return nil
+	}
+	return append([]*peerBlock{}, queue.blocks...)
+}
+
 // AddBlock tries to put given block into buffer and flush buffer into blockchain.
 func (b *blockBuffer) AddBlock(tipHeight uint64, blk *peerBlock) (bool, uint64) {
 	b.mu.Lock()
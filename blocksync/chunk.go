@@ -0,0 +1,233 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"time"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+)
+
+type (
+	// chunkRequest is a range of blocks requested from the peers
+	chunkRequest struct {
+		start    uint64
+		end      uint64
+		deadline time.Time
+		fetches  map[string]*chunkFetch
+	}
+
+	// chunkFetch is the progress of a peer sending the blocks of a chunk
+	chunkFetch struct {
+		sentAt time.Time
+		lastAt time.Time
+	}
+)
+
+func (c *chunkRequest) contains(height uint64) bool {
+	return height >= c.start && height <= c.end
+}
+
+// requestChunks requests the intervals from the best peers, the intervals are spread over the peers so
+// that they are fetched in parallel. An interval being fetched is not requested again until it times out
+func (bs *blockSyncer) requestChunks(ctx context.Context, intervals []syncBlocksInterval) {
+	peers, err := bs.p2pNeighbor()
+	if err != nil {
+		log.L().Error("failed to get neighbours", zap.Error(err))
+		return
+	}
+	if len(peers) == 0 {
+		log.L().Error("no peers")
+		return
+	}
+	bs.peerScores.Prune(peers)
+	tip := bs.tipHeightHandler()
+	bs.chunkMu.Lock()
+	for start, c := range bs.chunks {
+		if c.end <= tip {
+			delete(bs.chunks, start)
+		}
+	}
+	bs.chunkMu.Unlock()
+	now := time.Now()
+	for i, interval := range intervals {
+		bs.chunkMu.Lock()
+		c, ok := bs.chunks[interval.Start]
+		inflight := ok && c.end == interval.End && now.Before(c.deadline)
+		bs.chunkMu.Unlock()
+		if inflight {
+			continue
+		}
+		ranked := bs.bestPeers(peers, interval.Start)
+		repeat := bs.cfg.MaxRepeat - i/bs.cfg.RepeatDecayStep
+		if repeat < 2 {
+			repeat = 2
+		}
+		if repeat > len(ranked) {
+			repeat = len(ranked)
+		}
+		// rotate over the best peers, so the intervals are not all requested from the same peers
+		targets := make([]peer.AddrInfo, 0, repeat)
+		for j := 0; j < repeat; j++ {
+			targets = append(targets, ranked[(i+j)%len(ranked)])
+		}
+		bs.chunkMu.Lock()
+		bs.chunks[interval.Start] = &chunkRequest{
+			start:   interval.Start,
+			end:     interval.End,
+			fetches: map[string]*chunkFetch{},
+		}
+		bs.chunkMu.Unlock()
+		bs.requestChunk(ctx, interval.Start, interval.End, targets)
+	}
+}
+
+// retryChunks requests the chunks timed out from the peers not requested yet, a chunk is dropped once all
+// the peers have been tried, and will be requested again in the next sync
+func (bs *blockSyncer) retryChunks() {
+	var (
+		tip       = bs.tipHeightHandler()
+		now       = time.Now()
+		expired   []*chunkRequest
+		intervals []syncBlocksInterval
+		tried     []map[string]struct{}
+	)
+	bs.chunkMu.Lock()
+	for start, c := range bs.chunks {
+		if c.end <= tip {
+			delete(bs.chunks, start)
+			continue
+		}
+		if now.Before(c.deadline) {
+			continue
+		}
+		expired = append(expired, c)
+	}
+	for _, c := range expired {
+		requested := make(map[string]struct{}, len(c.fetches))
+		for pid := range c.fetches {
+			bs.peerScores.Timeout(pid)
+			requested[pid] = struct{}{}
+		}
+		// the committed blocks are not requested again
+		if c.start <= tip {
+			delete(bs.chunks, c.start)
+			c.start = tip + 1
+			bs.chunks[c.start] = c
+		}
+		intervals = append(intervals, syncBlocksInterval{Start: c.start, End: c.end})
+		tried = append(tried, requested)
+	}
+	bs.chunkMu.Unlock()
+	if len(expired) == 0 {
+		return
+	}
+	peers, err := bs.p2pNeighbor()
+	if err != nil {
+		log.L().Error("failed to get neighbours", zap.Error(err))
+		return
+	}
+	for i, interval := range intervals {
+		var target *peer.AddrInfo
+		for _, p := range bs.peerScores.Rank(peers, interval.Start) {
+			if _, ok := tried[i][p.ID.String()]; !ok {
+				target = &p
+				break
+			}
+		}
+		if target == nil {
+			bs.chunkMu.Lock()
+			if bs.chunks[interval.Start] == expired[i] {
+				delete(bs.chunks, interval.Start)
+			}
+			bs.chunkMu.Unlock()
+			continue
+		}
+		log.L().Debug("re-request timed out blocks",
+			zap.Uint64("start", interval.Start),
+			zap.Uint64("end", interval.End),
+			zap.String("peer", target.ID.String()))
+		bs.requestChunk(context.Background(), interval.Start, interval.End, []peer.AddrInfo{*target})
+	}
+}
+
+// requestChunk sends the request of the blocks in [start, end] to the peers
+func (bs *blockSyncer) requestChunk(ctx context.Context, start, end uint64, peers []peer.AddrInfo) {
+	now := time.Now()
+	bs.chunkMu.Lock()
+	if c, ok := bs.chunks[start]; ok {
+		c.deadline = now.Add(bs.cfg.ChunkTimeout)
+		for _, p := range peers {
+			c.fetches[p.ID.String()] = &chunkFetch{sentAt: now}
+		}
+	}
+	bs.chunkMu.Unlock()
+	for _, p := range peers {
+		bs.peerScores.Requested(p.ID.String())
+		if err := bs.unicastOutbound(
+			ctx,
+			p,
+			&iotexrpc.BlockSync{Start: start, End: end},
+		); err != nil {
+			log.L().Error("failed to request blocks", zap.Error(err), zap.String("peer", p.ID.String()), zap.Uint64("start", start), zap.Uint64("end", end))
+		}
+	}
+}
+
+// bestPeers returns the best peers to request the blocks from the height, up to cfg.ParallelPeers of them
+func (bs *blockSyncer) bestPeers(peers []peer.AddrInfo, height uint64) []peer.AddrInfo {
+	ranked := bs.peerScores.Rank(peers, height)
+	if n := bs.cfg.ParallelPeers; n > 0 && len(ranked) > n {
+		ranked = ranked[:n]
+	}
+	return ranked
+}
+
+// observeBlock updates the stats of the peer sending the block of the size in bytes, and completes the chunk
+// the block ends
+func (bs *blockSyncer) observeBlock(pid string, height uint64, size int) {
+	bs.peerScores.ObserveTip(pid, height)
+	now := time.Now()
+	bs.chunkMu.Lock()
+	var (
+		c     *chunkRequest
+		fetch *chunkFetch
+	)
+	for _, cc := range bs.chunks {
+		if f, ok := cc.fetches[pid]; ok && cc.contains(height) {
+			c, fetch = cc, f
+			break
+		}
+	}
+	if c == nil {
+		bs.chunkMu.Unlock()
+		return
+	}
+	first := fetch.lastAt.IsZero()
+	from := fetch.lastAt
+	if first {
+		from = fetch.sentAt
+	}
+	fetch.lastAt = now
+	done := height == c.end
+	if done {
+		delete(bs.chunks, c.start)
+	}
+	bs.chunkMu.Unlock()
+
+	if first {
+		bs.peerScores.ObserveLatency(pid, now.Sub(fetch.sentAt))
+	}
+	bs.peerScores.ObserveBlock(pid, size, now.Sub(from))
+	if done {
+		bs.peerScores.Delivered(pid)
+	}
+}
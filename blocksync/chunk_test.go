@@ -0,0 +1,181 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type chunkSent struct {
+	peer       string
+	start, end uint64
+}
+
+func newChunkTestSyncer(tip *uint64, peers []peer.AddrInfo) (*blockSyncer, func() []chunkSent) {
+	var (
+		mu   sync.Mutex
+		sent []chunkSent
+	)
+	cfg := DefaultConfig
+	cfg.ChunkTimeout = time.Minute
+	bs := &blockSyncer{
+		cfg:              cfg,
+		tipHeightHandler: func() uint64 { return *tip },
+		p2pNeighbor:      func() ([]peer.AddrInfo, error) { return peers, nil },
+		unicastOutbound: func(_ context.Context, p peer.AddrInfo, msg proto.Message) error {
+			req := msg.(*iotexrpc.BlockSync)
+			mu.Lock()
+			defer mu.Unlock()
+			sent = append(sent, chunkSent{p.ID.String(), req.Start, req.End})
+			return nil
+		},
+		peerScores: newPeerScoreboard(),
+		chunks:     map[uint64]*chunkRequest{},
+	}
+	return bs, func() []chunkSent {
+		mu.Lock()
+		defer mu.Unlock()
+		ret := sent
+		sent = nil
+		return ret
+	}
+}
+
+// expireChunks moves the deadlines of the chunks being fetched into the past
+func expireChunks(bs *blockSyncer) {
+	bs.chunkMu.Lock()
+	defer bs.chunkMu.Unlock()
+	for _, c := range bs.chunks {
+		c.deadline = time.Now().Add(-time.Second)
+	}
+}
+
+func TestRequestChunk(t *testing.T) {
+	require := require.New(t)
+	var (
+		tip      uint64
+		a, b     = peer.ID("a").String(), peer.ID("b").String()
+		peers    = []peer.AddrInfo{{ID: "a"}, {ID: "b"}}
+		bs, sent = newChunkTestSyncer(&tip, peers)
+	)
+	bs.chunks[1] = &chunkRequest{start: 1, end: 10, fetches: map[string]*chunkFetch{}}
+	bs.requestChunk(context.Background(), 1, 10, peers)
+	require.ElementsMatch([]chunkSent{{a, 1, 10}, {b, 1, 10}}, sent())
+	c := bs.chunks[1]
+	require.Len(c.fetches, 2)
+	require.True(c.deadline.After(time.Now()))
+	require.Equal(uint64(1), bs.peerScores.peers[a].requests)
+	require.Equal(uint64(1), bs.peerScores.peers[b].requests)
+
+	// a chunk in flight is not requested again
+	bs.requestChunks(context.Background(), []syncBlocksInterval{{Start: 1, End: 10}})
+	require.Empty(sent())
+	// nor retried before it times out
+	bs.retryChunks()
+	require.Empty(sent())
+}
+
+func TestRetryChunks(t *testing.T) {
+	t.Run("peer times out", func(t *testing.T) {
+		require := require.New(t)
+		var (
+			tip      uint64
+			a, b     = peer.ID("a").String(), peer.ID("b").String()
+			bs, sent = newChunkTestSyncer(&tip, []peer.AddrInfo{{ID: "a"}, {ID: "b"}})
+		)
+		bs.chunks[1] = &chunkRequest{start: 1, end: 10, fetches: map[string]*chunkFetch{}}
+		bs.requestChunk(context.Background(), 1, 10, []peer.AddrInfo{{ID: "a"}})
+		require.Equal([]chunkSent{{a, 1, 10}}, sent())
+
+		// the chunk is requested from the peer not tried yet, and the peer timed out is penalized
+		expireChunks(bs)
+		bs.retryChunks()
+		require.Equal([]chunkSent{{b, 1, 10}}, sent())
+		require.Equal(uint64(1), bs.peerScores.peers[a].timeouts)
+		require.Less(bs.peerScores.Score(a), bs.peerScores.Score(b))
+		require.Len(bs.chunks[1].fetches, 2)
+
+		// the chunk is dropped once all the peers have timed out
+		expireChunks(bs)
+		bs.retryChunks()
+		require.Empty(sent())
+		require.Equal(uint64(2), bs.peerScores.peers[a].timeouts)
+		require.Equal(uint64(1), bs.peerScores.peers[b].timeouts)
+		require.Empty(bs.chunks)
+	})
+
+	t.Run("peer returns a short chunk", func(t *testing.T) {
+		require := require.New(t)
+		var (
+			tip      uint64
+			a, b     = peer.ID("a").String(), peer.ID("b").String()
+			bs, sent = newChunkTestSyncer(&tip, []peer.AddrInfo{{ID: "a"}, {ID: "b"}})
+		)
+		bs.chunks[1] = &chunkRequest{start: 1, end: 10, fetches: map[string]*chunkFetch{}}
+		bs.requestChunk(context.Background(), 1, 10, []peer.AddrInfo{{ID: "a"}})
+		require.Equal([]chunkSent{{a, 1, 10}}, sent())
+		for h := uint64(1); h <= 5; h++ {
+			bs.observeBlock(a, h, 1000)
+		}
+		tip = 5
+		// the chunk is not completed by the blocks sent
+		require.Contains(bs.chunks, uint64(1))
+		stats := bs.peerScores.peers[a]
+		require.Equal(uint64(5), stats.tip)
+		require.Equal(uint64(5), stats.blocks)
+		require.Equal(uint64(5000), stats.bytes)
+
+		// the missing blocks are requested from the other peer
+		expireChunks(bs)
+		bs.retryChunks()
+		require.Equal([]chunkSent{{b, 6, 10}}, sent())
+		require.NotContains(bs.chunks, uint64(1))
+		require.Contains(bs.chunks, uint64(6))
+		require.Equal(uint64(1), bs.peerScores.peers[a].timeouts)
+
+		// the chunk is completed by the last block
+		for h := uint64(6); h <= 10; h++ {
+			bs.observeBlock(b, h, 2000)
+		}
+		require.Empty(bs.chunks)
+		require.Equal(uint64(10000), bs.peerScores.peers[b].bytes)
+		require.Zero(bs.peerScores.peers[b].failures)
+	})
+}
+
+func TestObserveBlock(t *testing.T) {
+	require := require.New(t)
+	var (
+		tip   uint64
+		a, b  = peer.ID("a").String(), peer.ID("b").String()
+		bs, _ = newChunkTestSyncer(&tip, []peer.AddrInfo{{ID: "a"}, {ID: "b"}})
+	)
+	bs.chunks[1] = &chunkRequest{start: 1, end: 2, fetches: map[string]*chunkFetch{}}
+	bs.requestChunk(context.Background(), 1, 2, []peer.AddrInfo{{ID: "a"}})
+
+	// a block from a peer not requested only updates its tip
+	bs.observeBlock(b, 2, 100)
+	require.Equal(uint64(2), bs.peerScores.peers[b].tip)
+	require.Zero(bs.peerScores.peers[b].blocks)
+	require.Contains(bs.chunks, uint64(1))
+
+	// the first block measures the latency, and the size received is accounted
+	bs.observeBlock(a, 1, 100)
+	stats := bs.peerScores.peers[a]
+	require.NotZero(stats.latency)
+	require.Equal(uint64(100), stats.bytes)
+	bs.observeBlock(a, 2, 300)
+	require.Equal(uint64(400), stats.bytes)
+	require.Empty(bs.chunks)
+}
@@ -17,6 +17,14 @@ type Config struct {
 	MaxRepeat int `yaml:"maxRepeat"`
 	// RepeatDecayStep is the step for repeat number decreasing by 1
 	RepeatDecayStep int `yaml:"repeatDecayStep"`
+	// ChunkTimeout is the time to wait for the blocks of a request before requesting them from another peer
+	ChunkTimeout time.Duration `yaml:"chunkTimeout"`
+	// ParallelPeers is the number of the best peers the requests are spread over
+	ParallelPeers int `yaml:"parallelPeers"`
+	// HeaderFirst enables fetching and verifying the block headers before downloading the blocks
+	HeaderFirst bool `yaml:"headerFirst"`
+	// HeaderBatchSize is the number of headers requested in one batch
+	HeaderBatchSize uint64 `yaml:"headerBatchSize"`
 }
 
 // DefaultConfig is the default config
@@ -27,4 +35,8 @@ var DefaultConfig = Config{
 	IntervalSize:          20,
 	MaxRepeat:             3,
 	RepeatDecayStep:       1,
+	ChunkTimeout:          10 * time.Second,
+	ParallelPeers:         8,
+	HeaderFirst:           false,
+	HeaderBatchSize:       200,
 }
@@ -0,0 +1,374 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+)
+
+// _headerRequestRepeat is the number of peers a batch of headers is requested from
+const _headerRequestRepeat = 2
+
+// ErrHeaderUnverifiable indicates that the delegates of the header's epoch are not known yet, the header is
+// kept and verified again after more blocks are committed
+var ErrHeaderUnverifiable = errors.New("header cannot be verified yet")
+
+type (
+	// ValidateHeader validates the producer and the endorsements of a block header against the delegates
+	ValidateHeader func(*block.Block) error
+	// HeaderByHeight returns the header and footer of a given height
+	HeaderByHeight func(uint64) (*block.Header, *block.Footer, error)
+
+	// headerChain is the chain of headers verified ahead of the committed blocks, and the headers received
+	// but not verified yet
+	headerChain struct {
+		mu        sync.Mutex
+		verified  uint64                  // the height of the highest verified header
+		hashes    map[uint64]hash.Hash256 // the hashes of the verified headers
+		pending   map[uint64][]*peerBlock // the headers received but not verified yet
+		requested uint64                  // the end of the last batch of headers requested
+		deadline  time.Time               // the time the last batch of headers times out
+		peers     map[string]struct{}     // the peers the last batch of headers is requested from
+		bodies    uint64                  // the height up to which the blocks have been requested
+	}
+)
+
+func newHeaderChain() *headerChain {
+	return &headerChain{
+		hashes:  map[uint64]hash.Hash256{},
+		pending: map[uint64][]*peerBlock{},
+		peers:   map[string]struct{}{},
+	}
+}
+
+// IsHeaderOnly checks if a block sync request or a block message is for the header only
+func IsHeaderOnly(msg proto.Message) bool {
+	switch m := msg.(type) {
+	case *iotexrpc.BlockSync:
+		return m.GetHeaderOnly()
+	case *iotextypes.Block:
+		return m.GetHeaderOnly()
+	default:
+		return false
+	}
+}
+
+// WithHeaderValidator enables header-first sync, the headers are fetched in batches and verified by the
+// validator before the blocks are downloaded
+func WithHeaderValidator(validateHeader ValidateHeader) Option {
+	return func(bs *blockSyncer) {
+		bs.validateHeader = validateHeader
+	}
+}
+
+// WithHeaderByHeight sets the handler to read the headers for the header sync requests, instead of the full blocks
+func WithHeaderByHeight(headerByHeightHandler HeaderByHeight) Option {
+	return func(bs *blockSyncer) {
+		bs.headerByHeightHandler = headerByHeightHandler
+	}
+}
+
+func (bs *blockSyncer) headerFirst() bool {
+	return bs.cfg.HeaderFirst && bs.validateHeader != nil
+}
+
+// ProcessHeaderSyncRequest sends the headers and footers of the blocks in [start, end] to the peer
+func (bs *blockSyncer) ProcessHeaderSyncRequest(ctx context.Context, peer peer.AddrInfo, start uint64, end uint64) error {
+	if tip := bs.tipHeightHandler(); end > tip {
+		end = tip
+	}
+	if size := bs.cfg.HeaderBatchSize; size > 0 && end >= start+size {
+		end = start + size - 1
+	}
+	for i := start; i <= end; i++ {
+		header, footer, err := bs.headerByHeight(i)
+		if err != nil {
+			return err
+		}
+		pb := &iotextypes.Block{
+			Header:     header.Proto(),
+			Body:       &iotextypes.BlockBody{},
+			Footer:     footer.Proto(),
+			HeaderOnly: true,
+		}
+		syncCtx, cancel := context.WithTimeout(ctx, bs.cfg.ProcessSyncRequestTTL)
+		err = bs.unicastOutbound(syncCtx, peer, pb)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bs *blockSyncer) headerByHeight(height uint64) (*block.Header, *block.Footer, error) {
+	if bs.headerByHeightHandler != nil {
+		return bs.headerByHeightHandler(height)
+	}
+	blk, err := bs.blockByHeightHandler(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &blk.Header, &blk.Footer, nil
+}
+
+// ProcessBlockHeader processes an incoming block header, the blocks of the headers verified are then
+// requested from the best peers. The target height is raised only by a header with a valid producer signature
+// and endorsements, so that a peer cannot make the node fetch headers up to a forged height
+func (bs *blockSyncer) ProcessBlockHeader(ctx context.Context, peer string, blk *block.Block) error {
+	if blk == nil {
+		return errors.New("block is nil")
+	}
+	if !bs.headerFirst() {
+		return nil
+	}
+	if !blk.VerifySignature() {
+		bs.peerScores.InvalidBlock(peer)
+		bs.blockP2pPeer(peer)
+		return errors.Errorf("header %d has an invalid producer signature", blk.Height())
+	}
+	switch err := bs.validateHeader(blk); errors.Cause(err) {
+	case nil:
+		bs.peerScores.ObserveTip(peer, blk.Height())
+		bs.mu.Lock()
+		if blk.Height() > bs.targetHeight {
+			bs.targetHeight = blk.Height()
+		}
+		bs.mu.Unlock()
+	case ErrHeaderUnverifiable:
+	default:
+		bs.peerScores.InvalidBlock(peer)
+		bs.blockP2pPeer(peer)
+		return errors.Wrapf(err, "failed to validate header %d", blk.Height())
+	}
+	bs.addHeader(peer, blk)
+	bs.syncHeaders(ctx)
+	bs.syncBodies(ctx)
+	return nil
+}
+
+// checkBody checks the block against its verified header, the header of a block not verified yet is added to
+// the header chain, so that the blocks sent by the nodes not supporting header-first sync are verified too
+func (bs *blockSyncer) checkBody(peer string, blk *block.Block) error {
+	bs.headers.mu.Lock()
+	h, ok := bs.headers.hashes[blk.Height()]
+	bs.headers.mu.Unlock()
+	if !ok {
+		bs.addHeader(peer, &block.Block{Header: blk.Header, Footer: blk.Footer})
+		return nil
+	}
+	if blk.HashBlock() != h {
+		return errors.Errorf("block %d does not match the verified header", blk.Height())
+	}
+	return blk.VerifyTxRoot()
+}
+
+// addHeader adds a header to the header chain, and verifies the headers following the highest verified one
+func (bs *blockSyncer) addHeader(pid string, blk *block.Block) {
+	tip := bs.tipHeightHandler()
+	hc := bs.headers
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	bs.resetHeaders(tip)
+	height := blk.Height()
+	if height <= hc.verified || height > hc.verified+2*bs.cfg.HeaderBatchSize {
+		return
+	}
+	if !hc.hasPending(blk) {
+		hc.pending[height] = append(hc.pending[height], newPeerBlock(pid, blk))
+	}
+	for {
+		next := hc.verified + 1
+		candidates, ok := hc.pending[next]
+		if !ok {
+			return
+		}
+		prev, linked := hc.hashes[hc.verified]
+		var (
+			verified   bool
+			unverified []*peerBlock
+		)
+		for _, pb := range candidates {
+			var err error
+			if linked && pb.block.PrevHash() != prev {
+				err = errors.Errorf("header %d is not linked to the previous header", next)
+			} else if !pb.block.VerifySignature() {
+				err = errors.Errorf("header %d has an invalid producer signature", next)
+			} else {
+				err = bs.validateHeader(pb.block)
+			}
+			switch errors.Cause(err) {
+			case nil:
+				hc.hashes[next] = pb.block.HashBlock()
+				hc.verified = next
+				verified = true
+			case ErrHeaderUnverifiable:
+				unverified = append(unverified, pb)
+				continue
+			default:
+				bs.peerScores.InvalidBlock(pb.pid)
+				bs.blockP2pPeer(pb.pid)
+				log.L().Error("failed to verify block header", zap.Error(err), zap.Uint64("height", next), zap.String("peer", pb.pid))
+				continue
+			}
+			break
+		}
+		if !verified {
+			if len(unverified) > 0 {
+				hc.pending[next] = unverified
+			} else {
+				delete(hc.pending, next)
+			}
+			return
+		}
+		delete(hc.pending, next)
+	}
+}
+
+func (hc *headerChain) hasPending(blk *block.Block) bool {
+	h := blk.HashBlock()
+	for _, pb := range hc.pending[blk.Height()] {
+		if pb.block.HashBlock() == h {
+			return true
+		}
+	}
+	return false
+}
+
+// resetHeaders drops the headers of the committed blocks, and restarts the header chain from the tip once the
+// tip has passed the verified headers. It is called with the lock of the header chain held
+func (bs *blockSyncer) resetHeaders(tip uint64) {
+	hc := bs.headers
+	if hc.verified >= tip {
+		for height := range hc.hashes {
+			if height < tip {
+				delete(hc.hashes, height)
+			}
+		}
+		return
+	}
+	hc.hashes = map[uint64]hash.Hash256{}
+	for height := range hc.pending {
+		if height <= tip {
+			delete(hc.pending, height)
+		}
+	}
+	hc.verified = tip
+	if hc.bodies < tip {
+		hc.bodies = tip
+	}
+	if blk, err := bs.blockByHeightHandler(tip); err == nil {
+		hc.hashes[tip] = blk.HashBlock()
+	}
+}
+
+// verifiedHeight returns the height of the highest verified header, and whether a batch of headers is
+// being fetched
+func (bs *blockSyncer) verifiedHeight(now time.Time) (uint64, bool) {
+	bs.headers.mu.Lock()
+	defer bs.headers.mu.Unlock()
+	return bs.headers.verified, bs.headers.requested > bs.headers.verified && now.Before(bs.headers.deadline)
+}
+
+// syncHeaders requests the next batch of headers once the last one is verified or timed out, the headers are
+// fetched up to 2 batches ahead of the tip
+func (bs *blockSyncer) syncHeaders(ctx context.Context) {
+	var (
+		tip    = bs.tipHeightHandler()
+		target = bs.TargetHeight()
+		now    = time.Now()
+		hc     = bs.headers
+	)
+	hc.mu.Lock()
+	bs.resetHeaders(tip)
+	start := hc.verified + 1
+	if start > target || start > tip+bs.cfg.HeaderBatchSize {
+		hc.mu.Unlock()
+		return
+	}
+	if hc.requested >= start && now.Before(hc.deadline) {
+		hc.mu.Unlock()
+		return
+	}
+	if hc.requested >= start {
+		for pid := range hc.peers {
+			bs.peerScores.Timeout(pid)
+		}
+	}
+	tried := hc.peers
+	end := start + bs.cfg.HeaderBatchSize - 1
+	if end > target {
+		end = target
+	}
+	hc.requested = end
+	hc.deadline = now.Add(bs.cfg.ChunkTimeout)
+	hc.peers = map[string]struct{}{}
+	hc.mu.Unlock()
+
+	peers, err := bs.p2pNeighbor()
+	if err != nil {
+		log.L().Error("failed to get neighbours", zap.Error(err))
+		return
+	}
+	// the peers timed out on the last batch are tried last
+	targets := bs.peerScores.Rank(peers, end)
+	sort.SliceStable(targets, func(i, j int) bool {
+		_, triedI := tried[targets[i].ID.String()]
+		_, triedJ := tried[targets[j].ID.String()]
+		return !triedI && triedJ
+	})
+	if len(targets) > _headerRequestRepeat {
+		targets = targets[:_headerRequestRepeat]
+	}
+	hc.mu.Lock()
+	for _, p := range targets {
+		hc.peers[p.ID.String()] = struct{}{}
+	}
+	hc.mu.Unlock()
+	for _, p := range targets {
+		bs.peerScores.Requested(p.ID.String())
+		req := &iotexrpc.BlockSync{Start: start, End: end, HeaderOnly: true}
+		if err := bs.unicastOutbound(ctx, p, req); err != nil {
+			log.L().Error("failed to request headers", zap.Error(err), zap.String("peer", p.ID.String()), zap.Uint64("start", start), zap.Uint64("end", end))
+		}
+	}
+}
+
+// syncBodies requests the blocks of the verified headers, once an interval of them has been verified
+func (bs *blockSyncer) syncBodies(ctx context.Context) {
+	var (
+		tip    = bs.tipHeightHandler()
+		target = bs.TargetHeight()
+		hc     = bs.headers
+	)
+	hc.mu.Lock()
+	verified := hc.verified
+	if verified <= hc.bodies || (verified < hc.bodies+bs.cfg.IntervalSize && verified < target) {
+		hc.mu.Unlock()
+		return
+	}
+	hc.bodies = verified
+	hc.mu.Unlock()
+	intervals := bs.buf.GetBlocksIntervalsToSync(tip, verified)
+	if len(intervals) == 0 {
+		return
+	}
+	bs.requestChunks(ctx, intervals)
+}
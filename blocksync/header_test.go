@@ -0,0 +1,203 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+)
+
+func TestHeaderOnly(t *testing.T) {
+	require := require.New(t)
+
+	require.False(IsHeaderOnly(&iotexrpc.BlockSync{Start: 1, End: 10}))
+	require.True(IsHeaderOnly(&iotexrpc.BlockSync{Start: 1, End: 10, HeaderOnly: true}))
+	require.False(IsHeaderOnly(&iotextypes.Block{}))
+	require.True(IsHeaderOnly(&iotextypes.Block{HeaderOnly: true}))
+	require.False(IsHeaderOnly(&iotexrpc.ActionSync{}))
+}
+
+func TestHeaderFirstSync(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		blks     []*block.Block
+		prevHash hash.Hash256
+		ts       = time.Now()
+	)
+	for height := uint64(1); height <= 3; height++ {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(ts.Add(time.Duration(height) * time.Second)).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		blks = append(blks, &blk)
+		prevHash = blk.HashBlock()
+	}
+	headerOf := func(blk *block.Block) *block.Block {
+		return &block.Block{Header: blk.Header, Footer: blk.Footer}
+	}
+
+	var (
+		mu           sync.Mutex
+		tip          uint64
+		committed    []uint64
+		blocked      []string
+		requests     []*iotexrpc.BlockSync
+		unverifiable = true
+	)
+	cfg := DefaultConfig
+	cfg.Interval = 0
+	cfg.HeaderFirst = true
+	bs, err := NewBlockSyncer(cfg,
+		func() uint64 {
+			mu.Lock()
+			defer mu.Unlock()
+			return tip
+		},
+		func(height uint64) (*block.Block, error) {
+			if height == 0 || height > uint64(len(blks)) {
+				return nil, errors.New("not exist")
+			}
+			return blks[height-1], nil
+		},
+		func(blk *block.Block) error {
+			mu.Lock()
+			defer mu.Unlock()
+			committed = append(committed, blk.Height())
+			tip = blk.Height()
+			return nil
+		},
+		func() ([]peer.AddrInfo, error) {
+			return []peer.AddrInfo{{ID: "peer"}}, nil
+		},
+		func(_ context.Context, _ peer.AddrInfo, msg proto.Message) error {
+			if req, ok := msg.(*iotexrpc.BlockSync); ok {
+				mu.Lock()
+				requests = append(requests, req)
+				mu.Unlock()
+			}
+			return nil
+		},
+		func(pid string) {
+			blocked = append(blocked, pid)
+		},
+		WithHeaderValidator(func(blk *block.Block) error {
+			if blk.Height() == 3 && unverifiable {
+				return ErrHeaderUnverifiable
+			}
+			return nil
+		}),
+	)
+	require.NoError(err)
+	syncer := bs.(*blockSyncer)
+	ctx := context.Background()
+
+	// the headers are verified in order
+	require.NoError(bs.ProcessBlockHeader(ctx, "peer", headerOf(blks[1])))
+	verified, _ := syncer.verifiedHeight(time.Now())
+	require.Zero(verified)
+	require.NoError(bs.ProcessBlockHeader(ctx, "peer", headerOf(blks[0])))
+	verified, _ = syncer.verifiedHeight(time.Now())
+	require.Equal(uint64(2), verified)
+	require.Equal(uint64(2), bs.TargetHeight())
+	require.NotEmpty(requests)
+	require.True(IsHeaderOnly(requests[0]))
+
+	// a header not linked to the verified ones is rejected
+	forged, err := block.NewTestingBuilder().
+		SetHeight(3).
+		SetPrevBlockHash(hash.Hash256b([]byte("forged"))).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(bs.ProcessBlockHeader(ctx, "bad", &forged))
+	require.Equal([]string{"bad"}, blocked)
+
+	// a header with an invalid producer signature does not raise the target height
+	pb := blks[2].Header.Proto()
+	pb.Core.Height = 100
+	var badSig block.Header
+	require.NoError(badSig.LoadFromBlockHeaderProto(pb))
+	require.Error(bs.ProcessBlockHeader(ctx, "badsig", &block.Block{Header: badSig}))
+	require.Equal(uint64(2), bs.TargetHeight())
+	require.Equal([]string{"bad", "badsig"}, blocked)
+
+	// the header of an epoch without known delegates is kept until it can be verified, and it raises the
+	// target height only once it is verified
+	require.NoError(bs.ProcessBlockHeader(ctx, "peer", headerOf(blks[2])))
+	verified, _ = syncer.verifiedHeight(time.Now())
+	require.Equal(uint64(2), verified)
+	require.Equal(uint64(2), bs.TargetHeight())
+	unverifiable = false
+	require.NoError(bs.ProcessBlockHeader(ctx, "peer", headerOf(blks[2])))
+	verified, _ = syncer.verifiedHeight(time.Now())
+	require.Equal(uint64(3), verified)
+	require.Equal(uint64(3), bs.TargetHeight())
+
+	// a block not matching its verified header is rejected before it is committed
+	tampered, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.Error(bs.ProcessBlock(ctx, "tampered", &tampered, 0))
+	require.Equal([]string{"bad", "badsig", "tampered"}, blocked)
+	require.Empty(committed)
+
+	for _, blk := range blks {
+		require.NoError(bs.ProcessBlock(ctx, "peer", blk, 0))
+	}
+	require.Equal([]uint64{1, 2, 3}, committed)
+}
+
+func TestProcessHeaderSyncRequest(t *testing.T) {
+	require := require.New(t)
+
+	var sent []*iotextypes.Block
+	cfg := DefaultConfig
+	cfg.HeaderBatchSize = 2
+	bs, err := NewBlockSyncer(cfg,
+		func() uint64 {
+			return 3
+		},
+		func(height uint64) (*block.Block, error) {
+			blk, err := block.NewTestingBuilder().
+				SetHeight(height).
+				SignAndBuild(identityset.PrivateKey(27))
+			return &blk, err
+		},
+		nil,
+		nil,
+		func(_ context.Context, _ peer.AddrInfo, msg proto.Message) error {
+			sent = append(sent, msg.(*iotextypes.Block))
+			return nil
+		},
+		nil,
+	)
+	require.NoError(err)
+
+	require.NoError(bs.ProcessHeaderSyncRequest(context.Background(), peer.AddrInfo{}, 2, 10))
+	// the request is capped by the batch size
+	require.Len(sent, 2)
+	for i, pb := range sent {
+		require.True(IsHeaderOnly(pb))
+		require.Equal(uint64(i+2), pb.GetHeader().GetCore().GetHeight())
+		require.Empty(pb.GetBody().GetActions())
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/fastrand"
+)
+
+const (
+	// _peerStatsWeight is the weight of a new sample in the moving averages of latency and throughput
+	_peerStatsWeight = 0.2
+	// _defaultPeerThroughput is the throughput in bytes/sec assumed for a peer not measured yet
+	_defaultPeerThroughput = 64 * 1024
+	// _invalidBlockPenalty is the number of timeouts an invalid block counts as
+	_invalidBlockPenalty = 4
+)
+
+type (
+	// peerStats is the statistics of a peer serving block sync requests
+	peerStats struct {
+		tip        uint64        // the highest block height the peer has sent
+		latency    time.Duration // moving average of the time to the first block of a request
+		throughput float64       // moving average of bytes/sec
+		requests   uint64
+		blocks     uint64
+		bytes      uint64
+		timeouts   uint64
+		invalid    uint64
+		// failures counts the recent timeouts and invalid blocks, it is halved on every delivered request
+		failures float64
+	}
+
+	// peerScoreboard tracks the tips of the peers and how well they serve block sync requests
+	peerScoreboard struct {
+		mu    sync.RWMutex
+		peers map[string]*peerStats
+	}
+)
+
+func newPeerScoreboard() *peerScoreboard {
+	return &peerScoreboard{
+		peers: map[string]*peerStats{},
+	}
+}
+
+func (ps *peerScoreboard) stats(pid string) *peerStats {
+	s, ok := ps.peers[pid]
+	if !ok {
+		s = &peerStats{}
+		ps.peers[pid] = s
+	}
+	return s
+}
+
+// ObserveTip records that the peer has the block of the height
+func (ps *peerScoreboard) ObserveTip(pid string, height uint64) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if s := ps.stats(pid); height > s.tip {
+		s.tip = height
+	}
+}
+
+// Requested records a block sync request sent to the peer
+func (ps *peerScoreboard) Requested(pid string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.stats(pid).requests++
+}
+
+// ObserveLatency records the time the peer took to send the first block of a request
+func (ps *peerScoreboard) ObserveLatency(pid string, latency time.Duration) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.stats(pid)
+	if s.latency == 0 {
+		s.latency = latency
+		return
+	}
+	s.latency = time.Duration((1-_peerStatsWeight)*float64(s.latency) + _peerStatsWeight*float64(latency))
+}
+
+// ObserveBlock records a block of size bytes the peer sent in elapsed time for a request
+func (ps *peerScoreboard) ObserveBlock(pid string, size int, elapsed time.Duration) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.stats(pid)
+	s.blocks++
+	s.bytes += uint64(size)
+	if elapsed <= 0 {
+		return
+	}
+	sample := float64(size) / elapsed.Seconds()
+	if s.throughput == 0 {
+		s.throughput = sample
+		return
+	}
+	s.throughput = (1-_peerStatsWeight)*s.throughput + _peerStatsWeight*sample
+}
+
+// Delivered records that the peer has delivered all the blocks of a request
+func (ps *peerScoreboard) Delivered(pid string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.stats(pid).failures /= 2
+}
+
+// Timeout records that the peer has not delivered the blocks of a request in time
+func (ps *peerScoreboard) Timeout(pid string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.stats(pid)
+	s.timeouts++
+	s.failures++
+}
+
+// InvalidBlock records that the peer has sent a block failing to be committed
+func (ps *peerScoreboard) InvalidBlock(pid string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.stats(pid)
+	s.invalid++
+	s.failures += _invalidBlockPenalty
+}
+
+// Score returns the score of the peer, the higher the better
+func (ps *peerScoreboard) Score(pid string) float64 {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	s, ok := ps.peers[pid]
+	if !ok {
+		s = &peerStats{}
+	}
+	return s.score()
+}
+
+func (s *peerStats) score() float64 {
+	throughput := s.throughput
+	if throughput == 0 {
+		throughput = _defaultPeerThroughput
+	}
+	return throughput / (1 + s.latency.Seconds()) / (1 + s.failures)
+}
+
+// Rank sorts the peers by score in descending order, the peers known to be lower than the height are put
+// at the end. Peers of the same score are shuffled, so the requests spread over the peers not measured yet
+func (ps *peerScoreboard) Rank(peers []peer.AddrInfo, height uint64) []peer.AddrInfo {
+	ranked := make([]peer.AddrInfo, len(peers))
+	copy(ranked, peers)
+	for i := len(ranked) - 1; i > 0; i-- {
+		j := fastrand.Uint32n(uint32(i + 1))
+		ranked[i], ranked[j] = ranked[j], ranked[i]
+	}
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	var (
+		lagging = make(map[peer.ID]bool, len(ranked))
+		scores  = make(map[peer.ID]float64, len(ranked))
+	)
+	for _, p := range ranked {
+		s, ok := ps.peers[p.ID.String()]
+		if !ok {
+			s = &peerStats{}
+		}
+		lagging[p.ID] = s.tip != 0 && s.tip < height
+		scores[p.ID] = s.score()
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		pi, pj := ranked[i].ID, ranked[j].ID
+		if lagging[pi] != lagging[pj] {
+			return !lagging[pi]
+		}
+		return scores[pi] > scores[pj]
+	})
+	return ranked
+}
+
+// Prune removes the stats of the peers no longer connected
+func (ps *peerScoreboard) Prune(peers []peer.AddrInfo) {
+	connected := make(map[string]struct{}, len(peers))
+	for _, p := range peers {
+		connected[p.ID.String()] = struct{}{}
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for pid := range ps.peers {
+		if _, ok := connected[pid]; !ok {
+			delete(ps.peers, pid)
+		}
+	}
+}
+
+// BuildReport builds a report of the peers' stats, sorted by score
+func (ps *peerScoreboard) BuildReport() string {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	pids := make([]string, 0, len(ps.peers))
+	for pid := range ps.peers {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		si, sj := ps.peers[pids[i]].score(), ps.peers[pids[j]].score()
+		if si != sj {
+			return si > sj
+		}
+		return pids[i] < pids[j]
+	})
+	stringBuilder := strings.Builder{}
+	for _, pid := range pids {
+		s := ps.peers[pid]
+		stringBuilder.WriteString(fmt.Sprintf(
+			"\n  peer %s: tip %d, latency %s, %.1f KB/s, requests %d, blocks %d, timeouts %d, invalid blocks %d, score %.1f",
+			pid,
+			s.tip,
+			s.latency.Round(time.Millisecond),
+			s.throughput/1024,
+			s.requests,
+			s.blocks,
+			s.timeouts,
+			s.invalid,
+			s.score(),
+		))
+	}
+	return stringBuilder.String()
+}
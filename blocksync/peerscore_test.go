@@ -0,0 +1,67 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScoreboard(t *testing.T) {
+	var (
+		fast    = peer.ID("fast").String()
+		slow    = peer.ID("slow").String()
+		lagging = peer.ID("lagging").String()
+		invalid = peer.ID("invalid").String()
+		peers   = []peer.AddrInfo{{ID: "fast"}, {ID: "slow"}, {ID: "lagging"}, {ID: "invalid"}}
+		ps      = newPeerScoreboard()
+	)
+	ps.ObserveTip(fast, 100)
+	ps.ObserveLatency(fast, 100*time.Millisecond)
+	ps.ObserveBlock(fast, 1024*1024, time.Second)
+	ps.ObserveTip(slow, 100)
+	ps.ObserveLatency(slow, 2*time.Second)
+	ps.ObserveBlock(slow, 1024, time.Second)
+	ps.ObserveTip(lagging, 10)
+	ps.ObserveLatency(lagging, 100*time.Millisecond)
+	ps.ObserveBlock(lagging, 512*1024, time.Second)
+	ps.ObserveTip(invalid, 100)
+	ps.InvalidBlock(invalid)
+
+	t.Run("rank", func(t *testing.T) {
+		require := require.New(t)
+		ranked := ps.Rank(peers, 50)
+		require.Len(ranked, 4)
+		require.Equal(fast, ranked[0].ID.String())
+		require.Equal(lagging, ranked[3].ID.String())
+		// a lagging peer is still ranked by score below its tip
+		require.Equal(lagging, ps.Rank(peers, 10)[1].ID.String())
+	})
+	t.Run("timeout", func(t *testing.T) {
+		require := require.New(t)
+		score := ps.Score(fast)
+		ps.Timeout(fast)
+		require.Less(ps.Score(fast), score)
+		ps.Delivered(fast)
+		require.Greater(ps.Score(fast), ps.Score(invalid))
+	})
+	t.Run("report", func(t *testing.T) {
+		require := require.New(t)
+		report := ps.BuildReport()
+		require.Contains(report, "peer "+fast+": tip 100")
+		require.Contains(report, "timeouts 1")
+		require.Contains(report, "invalid blocks 1")
+	})
+	t.Run("prune", func(t *testing.T) {
+		require := require.New(t)
+		ps.Prune(peers[:1])
+		require.Len(ps.peers, 1)
+		require.Contains(ps.peers, fast)
+	})
+}
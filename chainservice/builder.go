@@ -37,13 +37,16 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/blockindex"
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory"
 	"github.com/iotexproject/iotex-core/v2/blockindex/contractstaking"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 	"github.com/iotexproject/iotex-core/v2/blocksync"
 	"github.com/iotexproject/iotex-core/v2/config"
 	"github.com/iotexproject/iotex-core/v2/consensus"
 	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
 	rp "github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos"
 	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/trie/mptrie"
 	"github.com/iotexproject/iotex-core/v2/nodeinfo"
 	"github.com/iotexproject/iotex-core/v2/p2p"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
@@ -152,6 +155,10 @@ func (builder *Builder) buildFactory(forTest bool) error {
 		return errors.Wrapf(err, "failed to create state factory")
 	}
 	builder.cs.factory = factory
+	if builder.cs.stateDiffIndexer != nil {
+		// the indexer has to be started before the factory commits any block
+		builder.cs.lifecycle.Add(builder.cs.stateDiffIndexer)
+	}
 	return nil
 }
 
@@ -171,7 +178,29 @@ func (builder *Builder) createFactory(forTest bool) (factory.Factory, error) {
 		factory.RegistryStateDBOption(builder.cs.registry),
 		factory.DefaultPatchOption(),
 	}
-	if builder.cfg.Chain.EnableStateDBCaching {
+	if path := builder.cfg.Chain.StateDiffDBPath; len(path) > 0 {
+		dbConfig := builder.cfg.DB
+		dbConfig.DbPath = path
+		blocksPerHour := time.Hour / builder.cfg.WakeUpgrade.BlockInterval
+		indexer, err := statediff.NewIndexer(
+			db.NewBoltDB(dbConfig),
+			uint64(blocksPerHour)*uint64(builder.cfg.Chain.StateDiffRetentionDays)*24,
+			builder.cfg.Genesis.GreenlandBlockHeight,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create state diff indexer")
+		}
+		builder.cs.stateDiffIndexer = indexer
+		opts = append(opts, factory.StateDiffStateDBOption(indexer), factory.HistoryStateDBOption(indexer))
+	}
+	if size := builder.cfg.DB.TrieNodeCacheSize(); size > 0 {
+		mptrie.SetSharedNodeCache(mptrie.NewNodeCache(int(size)))
+	}
+	if builder.cfg.Chain.EnableArchiveMode {
+		// the state of every height is kept in a versioned DB on pebble
+		factoryDBCfg.DbPath = builder.cfg.Chain.TrieDBPath
+		dao = db.NewKVStoreWithVersion(factoryDBCfg, db.VersionAllOption())
+	} else if builder.cfg.Chain.EnableStateDBCaching {
 		dao, err = db.CreateKVStoreWithCache(factoryDBCfg, builder.cfg.Chain.TrieDBPath, builder.cfg.Chain.StateDBCacheSize)
 	} else {
 		dao, err = db.CreateKVStore(factoryDBCfg, builder.cfg.Chain.TrieDBPath)
@@ -297,6 +326,9 @@ func (builder *Builder) buildBlockDAO(forTest bool) error {
 	if builder.cs.bfIndexer != nil {
 		indexers = append(indexers, builder.cs.bfIndexer)
 	}
+	if builder.cs.balanceHistoryIndexer != nil {
+		indexers = append(indexers, builder.cs.balanceHistoryIndexer)
+	}
 	var (
 		cfg       = builder.cfg
 		err       error
@@ -421,6 +453,27 @@ func (builder *Builder) buildGatewayComponents(forTest bool) error {
 	builder.cs.bfIndexer = bfIndexer
 	builder.cs.indexer = indexer
 
+	return builder.buildBalanceHistoryIndexer(forTest)
+}
+
+func (builder *Builder) buildBalanceHistoryIndexer(forTest bool) error {
+	path := builder.cfg.Chain.BalanceHistoryIndexDBPath
+	if _, gateway := builder.cfg.Plugins[config.GatewayPlugin]; !gateway || len(path) == 0 {
+		return nil
+	}
+	var kvStore db.KVStore
+	if forTest {
+		kvStore = db.NewMemKVStore()
+	} else {
+		dbConfig := builder.cfg.DB
+		dbConfig.DbPath = path
+		kvStore = db.NewBoltDB(dbConfig)
+	}
+	indexer, err := balancehistory.NewIndexer(kvStore, builder.cfg.Genesis.Timestamp)
+	if err != nil {
+		return errors.Wrap(err, "failed to create balance history indexer")
+	}
+	builder.cs.balanceHistoryIndexer = indexer
 	return nil
 }
 
@@ -484,7 +537,11 @@ func (builder *Builder) createGateWayComponents(forTest bool) (
 }
 
 func (builder *Builder) buildBlockchain(forSubChain, forTest bool) error {
-	builder.cs.chain = builder.createBlockchain(forSubChain, forTest)
+	chain, err := builder.createBlockchain(forSubChain, forTest)
+	if err != nil {
+		return errors.Wrap(err, "failed to create blockchain")
+	}
+	builder.cs.chain = chain
 	builder.cs.lifecycle.Add(builder.cs.chain)
 	builder.cs.lifecycle.Add(builder.cs.actpool)
 	if err := builder.cs.chain.AddSubscriber(builder.cs.actpool); err != nil {
@@ -512,9 +569,9 @@ func (builder *Builder) buildBlockchain(forSubChain, forTest bool) error {
 	return nil
 }
 
-func (builder *Builder) createBlockchain(forSubChain, forTest bool) blockchain.Blockchain {
+func (builder *Builder) createBlockchain(forSubChain, forTest bool) (blockchain.Blockchain, error) {
 	if builder.cs.chain != nil {
-		return builder.cs.chain
+		return builder.cs.chain, nil
 	}
 	var chainOpts []blockchain.Option
 	if !forSubChain {
@@ -526,8 +583,13 @@ func (builder *Builder) createBlockchain(forSubChain, forTest bool) blockchain.B
 	if builder.cfg.Consensus.Scheme == config.RollDPoSScheme {
 		mintOpts = append(mintOpts, factory.WithTimeoutOption(builder.cfg.Chain.MintTimeout))
 	}
+	blockBuilder, err := factory.NewBlockBuilder(builder.cfg.Chain.BlockBuilder, builder.cfg.Chain.ReservedGas, builder.cs.actpool)
+	if err != nil {
+		return nil, err
+	}
+	mintOpts = append(mintOpts, factory.WithBlockBuilderOption(blockBuilder))
 	builder.cs.minter = factory.NewMinter(builder.cs.factory, builder.cs.actpool, mintOpts...)
-	return blockchain.NewBlockchain(builder.cfg.Chain, builder.cfg.Genesis, builder.cs.blockdao, builder.cs.minter, chainOpts...)
+	return blockchain.NewBlockchain(builder.cfg.Chain, builder.cfg.Genesis, builder.cs.blockdao, builder.cs.minter, chainOpts...), nil
 }
 
 func (builder *Builder) buildNodeInfoManager() error {
@@ -580,6 +642,37 @@ func (builder *Builder) buildBlockSyncer() error {
 	dao := builder.cs.blockdao
 	cfg := builder.cfg
 
+	var opts []blocksync.Option
+	if prefetcher, ok := builder.cs.factory.(factory.Prefetcher); ok && cfg.Chain.EnableStatePrefetch {
+		ctx := genesis.WithGenesisContext(context.Background(), cfg.Genesis)
+		opts = append(opts, blocksync.WithPrefetchBlock(func(blk *block.Block) {
+			prefetcher.Prefetch(ctx, blk)
+		}))
+	}
+	opts = append(opts, blocksync.WithHeaderByHeight(func(height uint64) (*block.Header, *block.Footer, error) {
+		header, err := dao.HeaderByHeight(height)
+		if err != nil {
+			return nil, nil, err
+		}
+		footer, err := dao.FooterByHeight(height)
+		if err != nil {
+			return nil, nil, err
+		}
+		return header, footer, nil
+	}))
+	if rDPoSProtocol := rolldpos.FindProtocol(builder.cs.registry); rDPoSProtocol != nil && cfg.BlockSync.HeaderFirst {
+		opts = append(opts, blocksync.WithHeaderValidator(func(blk *block.Block) error {
+			err := consens.ValidateBlockFooter(blk)
+			if err == nil {
+				return nil
+			}
+			if errors.Is(err, rp.ErrBLSKeysUnavailable) || rDPoSProtocol.GetEpochNum(blk.Height()) > rDPoSProtocol.GetEpochNum(chain.TipHeight()) {
+				// the delegates and their BLS keys of a later epoch are not known until its snapshot is committed
+				return errors.Wrap(blocksync.ErrHeaderUnverifiable, err.Error())
+			}
+			return err
+		}))
+	}
 	blocksync, err := blocksync.NewBlockSyncer(
 		builder.cfg.BlockSync,
 		chain.TipHeight,
@@ -652,6 +745,7 @@ func (builder *Builder) buildBlockSyncer() error {
 		p2pAgent.ConnectedPeers,
 		p2pAgent.UnicastOutbound,
 		p2pAgent.BlockPeer,
+		opts...,
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to create block syncer")
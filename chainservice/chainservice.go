@@ -32,7 +32,9 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
 	"github.com/iotexproject/iotex-core/v2/blockindex"
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory"
 	"github.com/iotexproject/iotex-core/v2/blockindex/contractstaking"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 	"github.com/iotexproject/iotex-core/v2/blocksync"
 	"github.com/iotexproject/iotex-core/v2/consensus"
 	"github.com/iotexproject/iotex-core/v2/nodeinfo"
@@ -77,6 +79,8 @@ type ChainService struct {
 	contractStakingIndexer   *contractstaking.Indexer
 	contractStakingIndexerV2 stakingindex.StakingIndexer
 	contractStakingIndexerV3 stakingindex.StakingIndexer
+	stateDiffIndexer         *statediff.Indexer
+	balanceHistoryIndexer    *balancehistory.Indexer
 	registry                 *protocol.Registry
 	nodeInfoManager          *nodeinfo.InfoManager
 	apiStats                 *nodestats.APILocalStats
@@ -213,15 +217,21 @@ func (cs *ChainService) HandleBlock(ctx context.Context, peer string, pbBlock *i
 	if err != nil {
 		return err
 	}
+	if blocksync.IsHeaderOnly(pbBlock) {
+		return cs.blocksync.ProcessBlockHeader(ctx, peer, blk)
+	}
 	ctx, err = cs.chain.Context(ctx)
 	if err != nil {
 		return err
 	}
-	return cs.blocksync.ProcessBlock(ctx, peer, blk)
+	return cs.blocksync.ProcessBlock(ctx, peer, blk, proto.Size(pbBlock))
 }
 
 // HandleSyncRequest handles incoming sync request.
 func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peer.AddrInfo, sync *iotexrpc.BlockSync) error {
+	if blocksync.IsHeaderOnly(sync) {
+		return cs.blocksync.ProcessHeaderSyncRequest(ctx, peer, sync.Start, sync.End)
+	}
 	return cs.blocksync.ProcessSyncRequest(ctx, peer, sync.Start, sync.End)
 }
 
@@ -298,6 +308,17 @@ func (cs *ChainService) NewAPIServer(cfg api.Config, archive bool) (*api.ServerV
 	if archive {
 		apiServerOptions = append(apiServerOptions, api.WithArchiveSupport())
 	}
+	if cs.stateDiffIndexer != nil {
+		apiServerOptions = append(apiServerOptions, api.WithStateDiffIndexer(cs.stateDiffIndexer))
+	}
+	if cs.balanceHistoryIndexer != nil {
+		apiServerOptions = append(apiServerOptions, api.WithBalanceHistoryIndexer(cs.balanceHistoryIndexer))
+	}
+	if cs.consensus != nil {
+		if reader, ok := cs.consensus.(api.EvidenceReader); ok {
+			apiServerOptions = append(apiServerOptions, api.WithEvidenceReader(reader))
+		}
+	}
 
 	svr, err := api.NewServerV2(
 		cfg,
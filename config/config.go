@@ -26,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/dispatcher"
 	"github.com/iotexproject/iotex-core/v2/nodeinfo"
 	"github.com/iotexproject/iotex-core/v2/p2p"
+	"github.com/iotexproject/iotex-core/v2/pkg/ha"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 )
 
@@ -79,6 +80,7 @@ var (
 			HTTPAdminPort:         0,
 			StartSubChainInterval: 10 * time.Second,
 			SystemLogDBPath:       "/var/log",
+			HA:                    ha.DefaultConfig,
 		},
 		DB:         db.DefaultConfig,
 		Indexer:    blockindex.DefaultConfig,
@@ -97,6 +99,7 @@ var (
 		ValidateAPI,
 		ValidateActPool,
 		ValidateForkHeights,
+		ValidateHA,
 	}
 )
 
@@ -115,6 +118,8 @@ type (
 		StartSubChainInterval time.Duration `yaml:"startSubChainInterval"`
 		SystemLogDBPath       string        `yaml:"systemLogDBPath"`
 		MptrieLogPath         string        `yaml:"mptrieLogPath"`
+		// HA is the config of the lease election, which overrides Active if enabled
+		HA ha.Config `yaml:"ha"`
 	}
 
 	// Config is the root config struct, each package's config should be put as its sub struct
@@ -165,6 +170,10 @@ func New(configPaths []string, _plugins []string, validates ...Validate) (Config
 		return Config{}, errors.Wrap(err, "failed to unmarshal YAML config to struct")
 	}
 
+	// the keys held by the remote signer cannot be exported as the network master key
+	if cfg.Chain.ProducerPrivKeySchema == "remoteSigner" && cfg.Network.MasterKey == "" {
+		return Config{}, errors.Wrap(ErrInvalidCfg, "network master key is required with the remote signer")
+	}
 	if err := cfg.Chain.SetProducerPrivKey(); err != nil {
 		return Config{}, errors.Wrap(err, "failed to set producer private key")
 	}
@@ -260,6 +269,17 @@ func ValidateRollDPoS(cfg Config) error {
 	if fsm.EventChanSize <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "roll-DPoS event chan size should be greater than 0")
 	}
+	if rollDPoS.RoundLog.Size < 0 {
+		return errors.Wrap(ErrInvalidCfg, "roll-DPoS round log size should not be negative")
+	}
+	return nil
+}
+
+// ValidateHA validates the lease election configs
+func ValidateHA(cfg Config) error {
+	if err := cfg.System.HA.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidCfg, err.Error())
+	}
 	return nil
 }
 
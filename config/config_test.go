@@ -171,6 +171,21 @@ func TestNewConfigWithOverride(t *testing.T) {
 	require.Equal(t, sk.HexString(), cfg.Chain.ProducerPrivKey)
 }
 
+func TestNewConfigWithRemoteSigner(t *testing.T) {
+	cfgStr := `
+chain:
+    producerPrivKeySchema: remoteSigner
+    producerPrivKey: /nonexistent/signer.yaml
+`
+	require.NoError(t, makePathAndWriteFile(cfgStr, "_overwritePath"))
+
+	defer resetPathValues(t, []string{"_overwritePath"})
+
+	_, err := New([]string{_overwritePath, ""}, []string{})
+	require.ErrorIs(t, err, ErrInvalidCfg)
+	require.ErrorContains(t, err, "network master key is required")
+}
+
 func TestNewConfigWithSecret(t *testing.T) {
 	sk, cfgStr, err := generateProducerPrivKey()
 	require.NoError(t, err)
@@ -251,6 +266,17 @@ func TestValidateRollDPoS(t *testing.T) {
 		t,
 		strings.Contains(err.Error(), "roll-DPoS event chan size should be greater than 0"),
 	)
+
+	cfg = Default
+	cfg.Consensus.Scheme = RollDPoSScheme
+	cfg.Consensus.RollDPoS.RoundLog.Size = -1
+	err = ValidateRollDPoS(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(err.Error(), "roll-DPoS round log size should not be negative"),
+	)
 }
 
 func TestValidateActPool(t *testing.T) {
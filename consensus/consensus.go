@@ -7,21 +7,29 @@ package consensus
 
 import (
 	"context"
+	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/facebookgo/clock"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
 	"github.com/iotexproject/iotex-core/v2/action/protocol/poll"
 	rp "github.com/iotexproject/iotex-core/v2/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/v2/blockchain"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/consensus/scheme"
 	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/state"
@@ -152,8 +160,53 @@ func NewConsensus(
 			return addrs, nil
 		}
 		proposersByEpochFunc := delegatesByEpochFunc
+		blsPubKeysFunc := func(epochNum uint64, prevHash []byte, delegates []string) (map[string]*bls.PublicKey, error) {
+			fork, err := chainMgr.Fork(hash.Hash256(prevHash))
+			if err != nil {
+				return nil, err
+			}
+			// the keys are snapshotted per epoch, those of the epoch of the tip and the next one are known
+			tipEpochNum := ops.rp.GetEpochNum(fork.TipHeight())
+			if epochNum != tipEpochNum && epochNum != tipEpochNum+1 {
+				return nil, errors.Wrapf(rolldpos.ErrBLSKeysUnavailable, "epoch number %d, tip epoch number %d", epochNum, tipEpochNum)
+			}
+			forkSF, err := fork.StateReader()
+			if err != nil {
+				return nil, err
+			}
+			cands, err := staking.BLSKeysByEpoch(forkSF, epochNum)
+			if err != nil {
+				return nil, err
+			}
+			isDelegate := make(map[string]bool, len(delegates))
+			for _, d := range delegates {
+				isDelegate[d] = true
+			}
+			pubKeys := map[string]*bls.PublicKey{}
+			owners := map[string]int{}
+			for _, c := range cands {
+				if len(c.BLSPubKey) == 0 || !isDelegate[c.Operator.String()] {
+					continue
+				}
+				pk, err := bls.BytesToPublicKey(c.BLSPubKey)
+				if err != nil {
+					log.Logger("consensus").Warn("invalid BLS public key", zap.String("delegate", c.Operator.String()), zap.Error(err))
+					continue
+				}
+				pubKeys[c.Operator.String()] = pk
+				owners[pk.HexString()]++
+			}
+			// a key shared by delegates is not counted for any of them
+			for d, pk := range pubKeys {
+				if owners[pk.HexString()] > 1 {
+					delete(pubKeys, d)
+				}
+			}
+			return pubKeys, nil
+		}
 		bd := rolldpos.NewRollDPoSBuilder().
 			SetPriKey(cfg.Chain.ProducerPrivateKeys()...).
+			SetBLSPriKey(cfg.Chain.ProducerBLSPrivateKeys()...).
 			SetConfig(cfg).
 			SetChainManager(chainMgr).
 			SetBlockDeserializer(block.NewDeserializer(bc.EvmNetworkID())).
@@ -162,6 +215,9 @@ func NewConsensus(
 			SetDelegatesByEpochFunc(delegatesByEpochFunc).
 			SetProposersByEpochFunc(proposersByEpochFunc).
 			RegisterProtocol(ops.rp)
+		if cfg.Chain.EnableStakingProtocol {
+			bd.SetBLSPubKeysFunc(blsPubKeysFunc)
+		}
 		// TODO: explorer dependency deleted here at #1085, need to revive by migrating to api
 		cs.scheme, err = bd.Build()
 		if err != nil {
@@ -255,6 +311,60 @@ func (c *IotxConsensus) Scheme() scheme.Scheme {
 	return c.scheme
 }
 
+// Evidences returns up to count equivocation evidences at or above the height, of the offender if it is not empty
+func (c *IotxConsensus) Evidences(height, count uint64, offender string) []*evidencepb.Evidence {
+	r, ok := c.scheme.(*rolldpos.RollDPoS)
+	if !ok {
+		return nil
+	}
+	return r.Evidences(height, count, offender)
+}
+
+// RoundState returns the consensus events of the node at the height, of the latest height if it is 0
+func (c *IotxConsensus) RoundState(height uint64) ([]*roundstatepb.RoundEvent, error) {
+	r, ok := c.scheme.(*rolldpos.RollDPoS)
+	if !ok {
+		return nil, nil
+	}
+	return r.RoundState(height)
+}
+
+// HandleRoundState handles the admin request of the consensus events at the height in the query, of the latest
+// height if the height is not given
+func (c *IotxConsensus) HandleRoundState(w http.ResponseWriter, r *http.Request) {
+	var height uint64
+	if val := r.URL.Query().Get("height"); val != "" {
+		h, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid height", http.StatusBadRequest)
+			return
+		}
+		height = h
+	}
+	events, err := c.RoundState(height)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := protojson.Marshal(&roundstatepb.GetRoundStateResponse{Events: events})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		log.L().Warn("Failed to send http response.", zap.Error(err))
+	}
+}
+
+// SetSigningFence sets the function returning the fencing token the consensus messages of the round starting at
+// the time are signed under
+func (c *IotxConsensus) SetSigningFence(fence func(time.Time) (uint64, error)) {
+	if r, ok := c.scheme.(*rolldpos.RollDPoS); ok {
+		r.SetSigningFence(fence)
+	}
+}
+
 // Activate activates or pauses the consensus component
 func (c *IotxConsensus) Activate(active bool) {
 	c.scheme.Activate(active)
@@ -42,3 +42,9 @@ type Context interface {
 	Commit(interface{}) (bool, error)
 	ConsensusConfig
 }
+
+// TransitionObserver is notified of the state transitions of the fsm, and whether they are caused by timeouts. The
+// fsm notifies the context if it implements the interface
+type TransitionObserver interface {
+	OnTransition(evt *ConsensusEvent, src, dst fsm.State, timeout bool)
+}
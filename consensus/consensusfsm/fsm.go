@@ -8,6 +8,7 @@ package consensusfsm
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/facebookgo/clock"
@@ -95,12 +96,17 @@ var (
 
 // ConsensusFSM wraps over the general purpose FSM and implements the consensus logic
 type ConsensusFSM struct {
-	fsm   fsm.FSM
-	evtq  chan *ConsensusEvent
-	close chan interface{}
-	clock clock.Clock
-	ctx   Context
-	wg    sync.WaitGroup
+	fsm      fsm.FSM
+	evtq     chan *ConsensusEvent
+	close    chan interface{}
+	clock    clock.Clock
+	ctx      Context
+	observer TransitionObserver
+	wg       sync.WaitGroup
+	// queued is the number of events queued or being handled, and delayed is the number of events waiting for
+	// their delays to elapse
+	queued  atomic.Int64
+	delayed atomic.Int64
 }
 
 // NewConsensusFSM returns a new fsm
@@ -111,6 +117,9 @@ func NewConsensusFSM(ctx Context, clock clock.Clock) (*ConsensusFSM, error) {
 		ctx:   ctx,
 		clock: clock,
 	}
+	if observer, ok := ctx.(TransitionObserver); ok {
+		cm.observer = observer
+	}
 	b := fsm.NewBuilder().
 		AddInitialState(sPrepare).
 		AddStates(
@@ -246,6 +255,7 @@ func (m *ConsensusFSM) Start(c context.Context) error {
 						zap.Error(err),
 					)
 				}
+				m.queued.Add(-1)
 			}
 		}
 		m.wg.Done()
@@ -270,6 +280,16 @@ func (m *ConsensusFSM) NumPendingEvents() int {
 	return len(m.evtq)
 }
 
+// NumEventRoutines returns the number of the goroutines handling or producing the events, which are the event
+// loop if an event is queued or being handled, and a goroutine for each delayed event
+func (m *ConsensusFSM) NumEventRoutines() int {
+	n := int(m.delayed.Load())
+	if m.queued.Load() > 0 {
+		n++
+	}
+	return n
+}
+
 // Calibrate calibrates the state if necessary
 func (m *ConsensusFSM) Calibrate(height uint64) {
 	m.instantProduce(m.ctx.NewConsensusEvent(eCalibrate, height)...)
@@ -328,15 +348,19 @@ func (m *ConsensusFSM) produceOne(evt *ConsensusEvent, delay time.Duration) {
 	_consensusEvtsMtc.WithLabelValues(string(evt.Type()), "produced").Inc()
 	if delay > 0 {
 		m.wg.Add(1)
+		m.delayed.Add(1)
 		go func() {
 			select {
 			case <-m.close:
 			case <-m.clock.After(delay):
+				m.queued.Add(1)
 				m.evtq <- evt
 			}
+			m.delayed.Add(-1)
 			m.wg.Done()
 		}()
 	} else {
+		m.queued.Add(1)
 		m.evtq <- evt
 	}
 }
@@ -365,6 +389,9 @@ func (m *ConsensusFSM) handle(evt *ConsensusEvent) error {
 			zap.String("evt", string(evt.Type())),
 		)
 		_consensusEvtsMtc.WithLabelValues(string(evt.Type()), "consumed").Inc()
+		if m.observer != nil {
+			m.observer.OnTransition(evt, src, m.fsm.CurrentState(), isTimeout(evt.Type()))
+		}
 	case fsm.ErrTransitionNotFound:
 		if m.ctx.IsStaleUnmatchedEvent(evt) {
 			_consensusEvtsMtc.WithLabelValues(string(evt.Type()), "stale").Inc()
@@ -583,6 +610,15 @@ func (m *ConsensusFSM) onStopReceivingPreCommitEndorsement(evt fsm.Event) (fsm.S
 	return m.BackToPrepare(0)
 }
 
+func isTimeout(et fsm.EventType) bool {
+	switch et {
+	case eFailedToReceiveBlock, eStopReceivingProposalEndorsement, eStopReceivingLockEndorsement, eStopReceivingPreCommitEndorsement:
+		return true
+	default:
+		return false
+	}
+}
+
 // handleBackdoorEvt takes the dst state from the event and move the FSM into it
 func (m *ConsensusFSM) handleBackdoorEvt(evt fsm.Event) (fsm.State, error) {
 	cEvt, ok := evt.(*ConsensusEvent)
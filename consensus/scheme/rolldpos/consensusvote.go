@@ -11,6 +11,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/slashprotect"
 )
 
 // ConsensusVoteTopic defines the topic of an consensus vote
@@ -55,6 +57,18 @@ func (v *ConsensusVote) Topic() ConsensusVoteTopic {
 	return v.topic
 }
 
+// voteKind returns the kind of the vote of the topic in the slashing protection
+func voteKind(topic ConsensusVoteTopic) slashprotect.Kind {
+	switch topic {
+	case LOCK:
+		return slashprotect.LockVote
+	case COMMIT:
+		return slashprotect.CommitVote
+	default:
+		return slashprotect.ProposalVote
+	}
+}
+
 // Proto converts to a protobuf message
 func (v *ConsensusVote) Proto() (*iotextypes.ConsensusVote, error) {
 	var topic iotextypes.ConsensusVote_Topic
@@ -15,6 +15,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/endorsementpb"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
@@ -374,6 +375,79 @@ func (m *endorsementManager) AddVoteEndorsement(
 	return nil
 }
 
+// AggregateEndorsements aggregates the BLS signatures of the endorsements of the block on the topic at the time, of
+// the delegates with the public keys. The endorsers are marked by their indexes in the delegates, and the endorsements
+// not aggregated are returned as they are
+func (m *endorsementManager) AggregateEndorsements(
+	blkHash []byte,
+	topic ConsensusVoteTopic,
+	ts time.Time,
+	delegates []string,
+	pubKeys map[string]*bls.PublicKey,
+) (*endorsement.AggregateEndorsement, []*endorsement.Endorsement) {
+	c := m.CollectionByBlockHash(blkHash)
+	if c == nil {
+		return nil, []*endorsement.Endorsement{}
+	}
+	indexes := make(map[string]int, len(delegates))
+	for i, d := range delegates {
+		indexes[d] = i
+	}
+	var (
+		vote   = NewConsensusVote(blkHash, topic)
+		ens    = c.Endorsements([]ConsensusVoteTopic{topic})
+		signed []*endorsement.Endorsement
+		pks    []*bls.PublicKey
+		idxs   []int
+		rest   = []*endorsement.Endorsement{}
+	)
+	for _, en := range ens {
+		var (
+			idx int
+			pk  *bls.PublicKey
+			ok  bool
+		)
+		if addr := en.Endorser().Address(); addr != nil && len(en.BLSSignature()) != 0 && en.Timestamp().Equal(ts) {
+			if idx, ok = indexes[addr.String()]; ok {
+				pk, ok = pubKeys[addr.String()]
+			}
+		}
+		if !ok {
+			rest = append(rest, en)
+			continue
+		}
+		signed = append(signed, en)
+		pks = append(pks, pk)
+		idxs = append(idxs, idx)
+	}
+	if len(signed) == 0 {
+		return nil, rest
+	}
+	agg, err := endorsement.Aggregate(signed, idxs, len(delegates))
+	if err == nil && endorsement.VerifyAggregateEndorsement(vote, ts, agg, pks) {
+		return agg, rest
+	}
+	// some signatures are invalid, aggregate the valid ones only
+	var valid []*endorsement.Endorsement
+	var validIdxs []int
+	for i, en := range signed {
+		if endorsement.VerifyBLSEndorsement(vote, en, pks[i]) {
+			valid = append(valid, en)
+			validIdxs = append(validIdxs, idxs[i])
+		} else {
+			rest = append(rest, en)
+		}
+	}
+	if len(valid) == 0 {
+		return nil, rest
+	}
+	if agg, err = endorsement.Aggregate(valid, validIdxs, len(delegates)); err != nil {
+		log.L().Warn("failed to aggregate the endorsements", zap.Error(err))
+		return nil, ens
+	}
+	return agg, rest
+}
+
 func (m *endorsementManager) SetMintedBlock(blk *block.Block) error {
 	m.cachedMintedBlk = blk
 	if m.eManagerDB != nil {
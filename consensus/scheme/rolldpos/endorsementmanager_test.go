@@ -13,6 +13,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/test/identityset"
@@ -177,6 +178,58 @@ func TestEndorsementManager(t *testing.T) {
 	require.Nil(em.CachedMintedBlock())   // clean up
 }
 
+func TestEndorsementManagerAggregate(t *testing.T) {
+	require := require.New(t)
+	em, err := newEndorsementManager(nil, block.NewDeserializer(0))
+	require.NoError(err)
+	b := getBlock(t)
+	require.NoError(em.RegisterBlock(&b))
+	blkHash := b.HashBlock()
+	vote := NewConsensusVote(blkHash[:], COMMIT)
+	ts := time.Now()
+
+	delegates := make([]string, 4)
+	pubKeys := map[string]*bls.PublicKey{}
+	for i := range delegates {
+		delegates[i] = identityset.Address(i).String()
+		sk, err := bls.GenerateKey()
+		require.NoError(err)
+		ens, err := endorsement.Endorse(vote, ts, identityset.PrivateKey(i))
+		require.NoError(err)
+		switch i {
+		case 0:
+			// no BLS key registered
+		case 1:
+			// BLS signature by another key
+			other, err := bls.GenerateKey()
+			require.NoError(err)
+			require.NoError(endorsement.SignBLS(vote, ens[0], other))
+			pubKeys[delegates[i]] = sk.PublicKey()
+		default:
+			require.NoError(endorsement.SignBLS(vote, ens[0], sk))
+			pubKeys[delegates[i]] = sk.PublicKey()
+		}
+		require.NoError(em.AddVoteEndorsement(vote, ens[0]))
+	}
+
+	// the valid BLS signatures are aggregated, and the others are kept as they are
+	agg, rest := em.AggregateEndorsements(blkHash[:], COMMIT, ts, delegates, pubKeys)
+	require.NotNil(agg)
+	require.Len(rest, 2)
+	indexes, err := agg.Endorsers(len(delegates))
+	require.NoError(err)
+	require.Equal([]int{2, 3}, indexes)
+	require.True(endorsement.VerifyAggregateEndorsement(vote, ts, agg, []*bls.PublicKey{pubKeys[delegates[2]], pubKeys[delegates[3]]}))
+
+	// nothing is aggregated at another time or without the keys
+	agg, rest = em.AggregateEndorsements(blkHash[:], COMMIT, ts.Add(time.Second), delegates, pubKeys)
+	require.Nil(agg)
+	require.Len(rest, 4)
+	agg, rest = em.AggregateEndorsements(blkHash[:], COMMIT, ts, delegates, nil)
+	require.Nil(agg)
+	require.Len(rest, 4)
+}
+
 func TestEndorsementManagerProto(t *testing.T) {
 	require := require.New(t)
 	em, err := newEndorsementManager(nil, block.NewDeserializer(0))
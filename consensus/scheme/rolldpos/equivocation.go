@@ -0,0 +1,359 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/endorsement"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+const (
+	_evidenceNS = "evd"
+	// _equivocationWindow is the number of heights below the highest one the signed messages are kept for
+	_equivocationWindow = 2
+	// _maxEvidences is the max number of evidences kept, the evidences of the lowest heights are pruned from the
+	// memory and the store beyond it
+	_maxEvidences = 1000
+)
+
+var (
+	_equivocationMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_consensus_equivocation",
+			Help: "Conflicting consensus messages signed by the same delegate",
+		},
+		[]string{"type", "offender"},
+	)
+
+	// ErrInvalidEvidence indicates that the evidence does not prove an equivocation
+	ErrInvalidEvidence = errors.New("invalid evidence")
+)
+
+func init() {
+	prometheus.MustRegister(_equivocationMtc)
+}
+
+type (
+	// signedKey identifies the messages a delegate may sign only once
+	signedKey struct {
+		height uint64
+		round  uint32
+		topic  ConsensusVoteTopic
+		signer string
+	}
+
+	// storedEvidence is an evidence with its key in the store
+	storedEvidence struct {
+		*evidencepb.Evidence
+		key []byte
+	}
+
+	// equivocationDetector detects the delegates signing two different block proposals or endorsements for the
+	// same height and round, and keeps the conflicting messages as evidences
+	equivocationDetector struct {
+		mutex        sync.Mutex
+		kvStore      db.KVStore
+		blocks       map[signedKey]*block.Header
+		votes        map[signedKey]*EndorsedConsensusMessage
+		reported     map[signedKey]struct{}
+		height       uint64
+		evidences    []*storedEvidence
+		maxEvidences int
+	}
+)
+
+func newEquivocationDetector(kvStore db.KVStore) *equivocationDetector {
+	return &equivocationDetector{
+		kvStore:      kvStore,
+		blocks:       map[signedKey]*block.Header{},
+		votes:        map[signedKey]*EndorsedConsensusMessage{},
+		reported:     map[signedKey]struct{}{},
+		maxEvidences: _maxEvidences,
+	}
+}
+
+// Load loads the evidences persisted in the store
+func (d *equivocationDetector) Load() error {
+	if d.kvStore == nil {
+		return nil
+	}
+	keys, values, err := d.kvStore.Filter(_evidenceNS, func(k, v []byte) bool { return true }, nil, nil)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return nil
+		}
+		return err
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for i, v := range values {
+		ev := &evidencepb.Evidence{}
+		if err := proto.Unmarshal(v, ev); err != nil {
+			return errors.Wrap(err, "failed to unmarshal evidence")
+		}
+		d.evidences = append(d.evidences, &storedEvidence{Evidence: ev, key: keys[i]})
+	}
+	d.trim()
+	return nil
+}
+
+// ObserveBlock records the block produced in the round, and returns an evidence if the producer has produced
+// another block in the same round
+func (d *equivocationDetector) ObserveBlock(round uint32, header *block.Header) *evidencepb.Evidence {
+	key := signedKey{
+		height: header.Height(),
+		round:  round,
+		signer: header.ProducerAddress(),
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !d.advance(key.height) {
+		return nil
+	}
+	first, ok := d.blocks[key]
+	if !ok {
+		d.blocks[key] = header
+		return nil
+	}
+	if _, ok := d.reported[key]; ok || first.HashBlock() == header.HashBlock() {
+		return nil
+	}
+	d.reported[key] = struct{}{}
+	ev := &evidencepb.Evidence{
+		Height:   key.height,
+		Round:    round,
+		Offender: key.signer,
+		Conflict: &evidencepb.Evidence_Proposal{
+			Proposal: &evidencepb.ProposalConflict{
+				First:  first.Proto(),
+				Second: header.Proto(),
+			},
+		},
+	}
+	d.addEvidence(ev, "proposal")
+	return ev
+}
+
+// ObserveVote records the vote endorsed in the round, and returns an evidence if the endorser has endorsed
+// another block on the same topic in the same round
+func (d *equivocationDetector) ObserveVote(round uint32, msg *EndorsedConsensusMessage) *evidencepb.Evidence {
+	vote, ok := msg.Document().(*ConsensusVote)
+	// a vote without block is not counted, as it does not endorse anything
+	if !ok || vote == nil || len(vote.BlockHash()) == 0 {
+		return nil
+	}
+	endorser := msg.Endorsement().Endorser().Address()
+	if endorser == nil {
+		return nil
+	}
+	key := signedKey{
+		height: msg.Height(),
+		round:  round,
+		topic:  vote.Topic(),
+		signer: endorser.String(),
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !d.advance(key.height) {
+		return nil
+	}
+	first, ok := d.votes[key]
+	if !ok {
+		d.votes[key] = msg
+		return nil
+	}
+	if _, ok := d.reported[key]; ok || bytes.Equal(first.Document().(*ConsensusVote).BlockHash(), vote.BlockHash()) {
+		return nil
+	}
+	d.reported[key] = struct{}{}
+	firstPb, err := first.Proto()
+	if err != nil {
+		log.L().Error("failed to convert consensus message", zap.Error(err))
+		return nil
+	}
+	secondPb, err := msg.Proto()
+	if err != nil {
+		log.L().Error("failed to convert consensus message", zap.Error(err))
+		return nil
+	}
+	ev := &evidencepb.Evidence{
+		Height:   key.height,
+		Round:    round,
+		Offender: key.signer,
+		Conflict: &evidencepb.Evidence_Vote{
+			Vote: &evidencepb.VoteConflict{
+				First:  firstPb,
+				Second: secondPb,
+			},
+		},
+	}
+	d.addEvidence(ev, "vote")
+	return ev
+}
+
+// Evidences returns up to count evidences at or above the height, of the offender if it is not empty
+func (d *equivocationDetector) Evidences(height uint64, count uint64, offender string) []*evidencepb.Evidence {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	evidences := []*evidencepb.Evidence{}
+	for _, ev := range d.evidences {
+		if uint64(len(evidences)) >= count {
+			break
+		}
+		if ev.Height < height || (offender != "" && ev.Offender != offender) {
+			continue
+		}
+		evidences = append(evidences, ev.Evidence)
+	}
+	return evidences
+}
+
+// advance moves the window of the heights forward, the messages below the window are dropped. It returns
+// false if the height is below the window
+func (d *equivocationDetector) advance(height uint64) bool {
+	if height+_equivocationWindow <= d.height {
+		return false
+	}
+	if height <= d.height {
+		return true
+	}
+	d.height = height
+	for key := range d.blocks {
+		if key.height+_equivocationWindow <= height {
+			delete(d.blocks, key)
+		}
+	}
+	for key := range d.votes {
+		if key.height+_equivocationWindow <= height {
+			delete(d.votes, key)
+		}
+	}
+	for key := range d.reported {
+		if key.height+_equivocationWindow <= height {
+			delete(d.reported, key)
+		}
+	}
+	return true
+}
+
+func (d *equivocationDetector) addEvidence(ev *evidencepb.Evidence, typ string) {
+	ev.DetectedAt = time.Now().Unix()
+	log.L().Warn("detected equivocation",
+		zap.String("type", typ),
+		zap.String("offender", ev.Offender),
+		zap.Uint64("height", ev.Height),
+		zap.Uint32("round", ev.Round))
+	_equivocationMtc.WithLabelValues(typ, ev.Offender).Inc()
+	stored := &storedEvidence{Evidence: ev}
+	if d.kvStore != nil {
+		value, err := proto.Marshal(ev)
+		if err != nil {
+			log.L().Error("failed to marshal evidence", zap.Error(err))
+		} else {
+			h := hash.Hash256b(value)
+			key := append(byteutil.Uint64ToBytesBigEndian(ev.Height), h[:]...)
+			if err := d.kvStore.Put(_evidenceNS, key, value); err != nil {
+				log.L().Error("failed to persist evidence", zap.Error(err))
+			} else {
+				stored.key = key
+			}
+		}
+	}
+	d.evidences = append(d.evidences, stored)
+	d.trim()
+}
+
+// trim sorts the evidences by height, and prunes the evidences of the lowest heights beyond the max number
+func (d *equivocationDetector) trim() {
+	sort.SliceStable(d.evidences, func(i, j int) bool {
+		return d.evidences[i].Height < d.evidences[j].Height
+	})
+	if len(d.evidences) <= d.maxEvidences {
+		return
+	}
+	pruned := d.evidences[:len(d.evidences)-d.maxEvidences]
+	d.evidences = d.evidences[len(d.evidences)-d.maxEvidences:]
+	if d.kvStore == nil {
+		return
+	}
+	for _, ev := range pruned {
+		if ev.key == nil {
+			continue
+		}
+		if err := d.kvStore.Delete(_evidenceNS, ev.key); err != nil {
+			log.L().Error("failed to prune evidence", zap.Error(err), zap.Uint64("height", ev.Height))
+		}
+	}
+}
+
+// VerifyEvidence verifies that the messages in the evidence are signed by the offender, for the height in the
+// evidence, and conflict with each other. The round of the messages is checked by the caller, as it depends
+// on the time of the previous block
+func VerifyEvidence(ev *evidencepb.Evidence) error {
+	switch conflict := ev.Conflict.(type) {
+	case *evidencepb.Evidence_Proposal:
+		var first, second block.Header
+		if err := first.LoadFromBlockHeaderProto(conflict.Proposal.GetFirst()); err != nil {
+			return errors.Wrap(ErrInvalidEvidence, err.Error())
+		}
+		if err := second.LoadFromBlockHeaderProto(conflict.Proposal.GetSecond()); err != nil {
+			return errors.Wrap(ErrInvalidEvidence, err.Error())
+		}
+		for _, h := range []*block.Header{&first, &second} {
+			if h.Height() != ev.Height || h.ProducerAddress() != ev.Offender || !h.VerifySignature() {
+				return errors.Wrap(ErrInvalidEvidence, "block is not produced by the offender at the height")
+			}
+		}
+		if first.HashBlock() == second.HashBlock() {
+			return errors.Wrap(ErrInvalidEvidence, "blocks are the same")
+		}
+		return nil
+	case *evidencepb.Evidence_Vote:
+		votes := make([]*ConsensusVote, 0, 2)
+		for _, pb := range []*iotextypes.ConsensusMessage{conflict.Vote.GetFirst(), conflict.Vote.GetSecond()} {
+			if pb.GetVote() == nil {
+				return errors.Wrap(ErrInvalidEvidence, "message is not a vote")
+			}
+			msg := &EndorsedConsensusMessage{}
+			if err := msg.LoadProto(pb, nil); err != nil {
+				return errors.Wrap(ErrInvalidEvidence, err.Error())
+			}
+			endorser := msg.Endorsement().Endorser().Address()
+			if msg.Height() != ev.Height || endorser == nil || endorser.String() != ev.Offender {
+				return errors.Wrap(ErrInvalidEvidence, "vote is not endorsed by the offender at the height")
+			}
+			if !endorsement.VerifyEndorsedDocument(msg) {
+				return errors.Wrap(ErrInvalidEvidence, "invalid endorsement")
+			}
+			votes = append(votes, msg.Document().(*ConsensusVote))
+		}
+		if votes[0].Topic() != votes[1].Topic() {
+			return errors.Wrap(ErrInvalidEvidence, "votes are on different topics")
+		}
+		if bytes.Equal(votes[0].BlockHash(), votes[1].BlockHash()) {
+			return errors.Wrap(ErrInvalidEvidence, "votes are on the same block")
+		}
+		return nil
+	default:
+		return errors.Wrap(ErrInvalidEvidence, "unknown conflict")
+	}
+}
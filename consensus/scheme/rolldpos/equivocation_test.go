@@ -0,0 +1,165 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/endorsement"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+func TestEquivocationDetector(t *testing.T) {
+	ts := time.Unix(1562382592, 0)
+	newHeader := func(t *testing.T, height uint64, ts time.Time, sk crypto.PrivateKey) *block.Header {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(ts).
+			SignAndBuild(sk)
+		require.NoError(t, err)
+		return &blk.Header
+	}
+	newVote := func(t *testing.T, height uint64, blkHash []byte, topic ConsensusVoteTopic, sk crypto.PrivateKey) *EndorsedConsensusMessage {
+		vote := NewConsensusVote(blkHash, topic)
+		en, err := endorsement.Endorse(vote, ts, sk)
+		require.NoError(t, err)
+		return NewEndorsedConsensusMessage(height, vote, en[0])
+	}
+
+	t.Run("proposal", func(t *testing.T) {
+		require := require.New(t)
+		d := newEquivocationDetector(nil)
+		sk := identityset.PrivateKey(1)
+		first := newHeader(t, 10, ts, sk)
+		require.Nil(d.ObserveBlock(0, first))
+		// the same block is not an equivocation
+		require.Nil(d.ObserveBlock(0, first))
+		// nor is a block in another round
+		require.Nil(d.ObserveBlock(1, newHeader(t, 10, ts.Add(time.Second), sk)))
+		ev := d.ObserveBlock(0, newHeader(t, 10, ts.Add(2*time.Second), sk))
+		require.NotNil(ev)
+		require.Equal(uint64(10), ev.Height)
+		require.Equal(sk.PublicKey().Address().String(), ev.Offender)
+		require.NoError(VerifyEvidence(ev))
+		// the same offence is reported once
+		require.Nil(d.ObserveBlock(0, newHeader(t, 10, ts.Add(3*time.Second), sk)))
+		require.Len(d.Evidences(0, 10, ""), 1)
+	})
+
+	t.Run("vote", func(t *testing.T) {
+		require := require.New(t)
+		d := newEquivocationDetector(nil)
+		sk := identityset.PrivateKey(2)
+		require.Nil(d.ObserveVote(0, newVote(t, 10, []byte("block1"), COMMIT, sk)))
+		// a vote on another topic is not an equivocation
+		require.Nil(d.ObserveVote(0, newVote(t, 10, []byte("block2"), LOCK, sk)))
+		// nor is a vote without block
+		require.Nil(d.ObserveVote(0, newVote(t, 10, []byte{}, COMMIT, sk)))
+		ev := d.ObserveVote(0, newVote(t, 10, []byte("block2"), COMMIT, sk))
+		require.NotNil(ev)
+		require.NoError(VerifyEvidence(ev))
+		require.Len(d.Evidences(10, 10, sk.PublicKey().Address().String()), 1)
+		require.Empty(d.Evidences(11, 10, ""))
+		require.Empty(d.Evidences(0, 10, identityset.Address(3).String()))
+	})
+
+	t.Run("window", func(t *testing.T) {
+		require := require.New(t)
+		d := newEquivocationDetector(nil)
+		sk := identityset.PrivateKey(3)
+		require.Nil(d.ObserveBlock(0, newHeader(t, 10, ts, sk)))
+		require.Nil(d.ObserveBlock(0, newHeader(t, 12, ts, sk)))
+		// the messages below the window are dropped
+		require.Nil(d.ObserveBlock(0, newHeader(t, 10, ts.Add(time.Second), sk)))
+		require.Empty(d.Evidences(0, 10, ""))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require := require.New(t)
+		sk := identityset.PrivateKey(4)
+		first, second := newHeader(t, 10, ts, sk), newHeader(t, 10, ts.Add(time.Second), sk)
+		ev := &evidencepb.Evidence{
+			Height:   10,
+			Offender: identityset.Address(5).String(),
+			Conflict: &evidencepb.Evidence_Proposal{
+				Proposal: &evidencepb.ProposalConflict{
+					First:  first.Proto(),
+					Second: second.Proto(),
+				},
+			},
+		}
+		require.ErrorIs(VerifyEvidence(ev), ErrInvalidEvidence)
+		ev.Offender = sk.PublicKey().Address().String()
+		require.NoError(VerifyEvidence(ev))
+		ev.Height = 11
+		require.ErrorIs(VerifyEvidence(ev), ErrInvalidEvidence)
+
+		vote1, err := newVote(t, 10, []byte("block1"), COMMIT, sk).Proto()
+		require.NoError(err)
+		vote2, err := newVote(t, 10, []byte("block1"), COMMIT, sk).Proto()
+		require.NoError(err)
+		ev = &evidencepb.Evidence{
+			Height:   10,
+			Offender: sk.PublicKey().Address().String(),
+			Conflict: &evidencepb.Evidence_Vote{
+				Vote: &evidencepb.VoteConflict{
+					First:  vote1,
+					Second: vote2,
+				},
+			},
+		}
+		require.ErrorIs(VerifyEvidence(ev), ErrInvalidEvidence)
+	})
+
+	t.Run("persistence", func(t *testing.T) {
+		require := require.New(t)
+		path, err := testutil.PathOfTempFile("consensus.db")
+		require.NoError(err)
+		defer testutil.CleanupPath(path)
+		cfg := db.DefaultConfig
+		cfg.DbPath = path
+		kvStore := db.NewBoltDB(cfg)
+		ctx := context.Background()
+		require.NoError(kvStore.Start(ctx))
+		defer kvStore.Stop(ctx)
+
+		d := newEquivocationDetector(kvStore)
+		require.NoError(d.Load())
+		sk := identityset.PrivateKey(6)
+		require.Nil(d.ObserveBlock(0, newHeader(t, 10, ts, sk)))
+		ev := d.ObserveBlock(0, newHeader(t, 10, ts.Add(time.Second), sk))
+		require.NotNil(ev)
+
+		d = newEquivocationDetector(kvStore)
+		require.NoError(d.Load())
+		evidences := d.Evidences(0, 10, "")
+		require.Len(evidences, 1)
+		require.True(proto.Equal(ev, evidences[0]))
+
+		// the evidences of the lowest heights are pruned from the store beyond the max number
+		d.maxEvidences = 2
+		for height := uint64(11); height <= 12; height++ {
+			require.Nil(d.ObserveBlock(0, newHeader(t, height, ts, sk)))
+			require.NotNil(d.ObserveBlock(0, newHeader(t, height, ts.Add(time.Second), sk)))
+		}
+		d = newEquivocationDetector(kvStore)
+		require.NoError(d.Load())
+		evidences = d.Evidences(0, 10, "")
+		require.Len(evidences, 2)
+		require.Equal(uint64(11), evidences[0].Height)
+		require.Equal(uint64(12), evidences[1].Height)
+	})
+}
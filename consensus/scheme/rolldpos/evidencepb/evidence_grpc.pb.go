@@ -0,0 +1,103 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.1
+// source: evidence.proto
+
+package evidencepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EvidenceServiceClient is the client API for EvidenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EvidenceServiceClient interface {
+	GetEvidences(ctx context.Context, in *GetEvidencesRequest, opts ...grpc.CallOption) (*GetEvidencesResponse, error)
+}
+
+type evidenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvidenceServiceClient(cc grpc.ClientConnInterface) EvidenceServiceClient {
+	return &evidenceServiceClient{cc}
+}
+
+func (c *evidenceServiceClient) GetEvidences(ctx context.Context, in *GetEvidencesRequest, opts ...grpc.CallOption) (*GetEvidencesResponse, error) {
+	out := new(GetEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/evidencepb.EvidenceService/GetEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EvidenceServiceServer is the server API for EvidenceService service.
+// All implementations should embed UnimplementedEvidenceServiceServer
+// for forward compatibility
+type EvidenceServiceServer interface {
+	GetEvidences(context.Context, *GetEvidencesRequest) (*GetEvidencesResponse, error)
+}
+
+// UnimplementedEvidenceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEvidenceServiceServer struct {
+}
+
+func (UnimplementedEvidenceServiceServer) GetEvidences(context.Context, *GetEvidencesRequest) (*GetEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidences not implemented")
+}
+
+// UnsafeEvidenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EvidenceServiceServer will
+// result in compilation errors.
+type UnsafeEvidenceServiceServer interface {
+	mustEmbedUnimplementedEvidenceServiceServer()
+}
+
+func RegisterEvidenceServiceServer(s grpc.ServiceRegistrar, srv EvidenceServiceServer) {
+	s.RegisterService(&EvidenceService_ServiceDesc, srv)
+}
+
+func _EvidenceService_GetEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvidenceServiceServer).GetEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evidencepb.EvidenceService/GetEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvidenceServiceServer).GetEvidences(ctx, req.(*GetEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EvidenceService_ServiceDesc is the grpc.ServiceDesc for EvidenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EvidenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "evidencepb.EvidenceService",
+	HandlerType: (*EvidenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEvidences",
+			Handler:    _EvidenceService_GetEvidences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evidence.proto",
+}
@@ -22,6 +22,9 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
 	"github.com/iotexproject/iotex-core/v2/consensus/scheme"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
@@ -35,6 +38,8 @@ var (
 	ErrZeroDelegate = errors.New("zero delegates in the network")
 	// ErrNotEnoughCandidates indicates there are not enough candidates from the candidate pool
 	ErrNotEnoughCandidates = errors.New("Candidate pool does not have enough candidates")
+	// ErrBLSKeysUnavailable indicates that the BLS public keys of the epoch are not known at the tip
+	ErrBLSKeysUnavailable = errors.New("BLS public keys of the epoch are unavailable")
 )
 
 type (
@@ -44,6 +49,7 @@ type (
 		ToleratedOvertime time.Duration                `yaml:"toleratedOvertime"`
 		Delay             time.Duration                `yaml:"delay"`
 		ConsensusDBPath   string                       `yaml:"consensusDBPath"`
+		RoundLog          RoundLogConfig               `yaml:"roundLog"`
 	}
 )
 
@@ -61,6 +67,11 @@ var DefaultConfig = Config{
 	ToleratedOvertime: 2 * time.Second,
 	Delay:             5 * time.Second,
 	ConsensusDBPath:   "/var/data/consensus.db",
+	RoundLog: RoundLogConfig{
+		Size:           10000,
+		Persist:        false,
+		PersistHeights: 17280,
+	},
 }
 
 // RollDPoS is Roll-DPoS consensus main entrance
@@ -193,7 +204,25 @@ func (r *RollDPoS) ValidateBlockFooter(blk *block.Block) error {
 			return err
 		}
 	}
-	if !round.EndorsedByMajority(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
+	var aggregated []string
+	if agg := blk.Aggregate(); agg != nil {
+		if !roundCalc.IsBLSEndorsement(height) {
+			return errors.Errorf("aggregate endorsement is not enabled at height %d", height)
+		}
+		pubKeys, err := roundCalc.BLSPubKeys(height, round.Delegates())
+		if err != nil {
+			return errors.Wrap(err, "failed to read the BLS public keys of the delegates")
+		}
+		if aggregated, err = round.VerifyAggregateEndorsement(
+			NewConsensusVote(blkHash[:], COMMIT),
+			blk.CommitTime(),
+			agg,
+			pubKeys,
+		); err != nil {
+			return err
+		}
+	}
+	if !round.EndorsedByMajorityWithAggregate(blkHash[:], []ConsensusVoteTopic{COMMIT}, aggregated) {
 		return ErrInsufficientEndorsements
 	}
 
@@ -222,6 +251,11 @@ func (r *RollDPoS) NumPendingEvts() int {
 	return r.cfsm.NumPendingEvents()
 }
 
+// NumEventRoutines returns the number of the goroutines handling or producing the consensus events
+func (r *RollDPoS) NumEventRoutines() int {
+	return r.cfsm.NumEventRoutines()
+}
+
 // CurrentState returns the current state
 func (r *RollDPoS) CurrentState() fsm.State {
 	return r.cfsm.CurrentState()
@@ -237,6 +271,22 @@ func (r *RollDPoS) Activate(active bool) {
 	}
 }
 
+// Evidences returns up to count equivocation evidences at or above the height, of the offender if it is not empty
+func (r *RollDPoS) Evidences(height, count uint64, offender string) []*evidencepb.Evidence {
+	return r.ctx.Evidences(height, count, offender)
+}
+
+// RoundState returns the consensus events of the node at the height, of the latest height if it is 0
+func (r *RollDPoS) RoundState(height uint64) ([]*roundstatepb.RoundEvent, error) {
+	return r.ctx.RoundState(height)
+}
+
+// SetSigningFence sets the function returning the fencing token of the lease to sign in the round starting at the
+// time, the consensus messages are signed only if the node holds the lease
+func (r *RollDPoS) SetSigningFence(fence func(time.Time) (uint64, error)) {
+	r.ctx.SetSigningFence(fence)
+}
+
 // Active is true if the roll-DPoS consensus is active, or false if it is stand-by
 func (r *RollDPoS) Active() bool {
 	return r.ctx.Active() || r.cfsm.CurrentState() != consensusfsm.InitState
@@ -269,6 +319,8 @@ type (
 		rp                   *rolldpos.Protocol
 		delegatesByEpochFunc NodesSelectionByEpochFunc
 		proposersByEpochFunc NodesSelectionByEpochFunc
+		blsPriKey            []*bls.PrivateKey
+		blsPubKeysFunc       BLSPubKeysFunc
 	}
 )
 
@@ -329,6 +381,18 @@ func (b *Builder) SetProposersByEpochFunc(
 	return b
 }
 
+// SetBLSPriKey sets the BLS private keys, each of which belongs to the private key at the same position
+func (b *Builder) SetBLSPriKey(priKeys ...*bls.PrivateKey) *Builder {
+	b.blsPriKey = priKeys
+	return b
+}
+
+// SetBLSPubKeysFunc sets blsPubKeysFunc, the commit endorsements are aggregated only if it is set
+func (b *Builder) SetBLSPubKeysFunc(blsPubKeysFunc BLSPubKeysFunc) *Builder {
+	b.blsPubKeysFunc = blsPubKeysFunc
+	return b
+}
+
 // RegisterProtocol sets the rolldpos protocol
 func (b *Builder) RegisterProtocol(rp *rolldpos.Protocol) *Builder {
 	b.rp = rp
@@ -366,6 +430,12 @@ func (b *Builder) Build() (*RollDPoS, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing consensus context")
 	}
+	ctx.SetRoundLog(b.cfg.Consensus.RoundLog)
+	if b.blsPubKeysFunc != nil {
+		if err := ctx.SetBLSEndorsement(b.cfg.Genesis.BLSEndorsementBlockHeight, b.blsPubKeysFunc, b.blsPriKey); err != nil {
+			return nil, errors.Wrap(err, "error when enabling the BLS endorsement")
+		}
+	}
 	cfsm, err := consensusfsm.NewConsensusFSM(ctx, b.clock)
 	if err != nil {
 		return nil, errors.Wrap(err, "error when constructing the consensus FSM")
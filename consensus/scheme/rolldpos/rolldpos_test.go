@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
 	cp "github.com/iotexproject/iotex-core/v2/crypto"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/p2p/node"
@@ -245,25 +247,41 @@ func TestValidateBlockFooter(t *testing.T) {
 			candidates[3],
 		}, nil
 	}
-	r, err := NewRollDPoSBuilder().
-		SetConfig(builderCfg).
-		SetPriKey(sk1).
-		SetChainManager(NewChainManager(bc, sf, &dummyBlockBuildFactory{})).
-		SetBroadcast(func(_ proto.Message) error {
-			return nil
-		}).
-		SetDelegatesByEpochFunc(delegatesByEpoch).
-		SetProposersByEpochFunc(delegatesByEpoch).
-		SetClock(clock).
-		RegisterProtocol(rp).
-		Build()
-	require.NoError(t, err)
-	require.NotNil(t, r)
-	require.NoError(t, r.Start(context.Background()))
+	blsKeys := make([]*bls.PrivateKey, 4)
+	pubKeys := map[string]*bls.PublicKey{}
+	for i := range blsKeys {
+		sk, err := bls.GenerateKey()
+		require.NoError(t, err)
+		blsKeys[i] = sk
+		pubKeys[candidates[i]] = sk.PublicKey()
+	}
+	build := func(blsHeight uint64) *RollDPoS {
+		builderCfg.Genesis.BLSEndorsementBlockHeight = blsHeight
+		r, err := NewRollDPoSBuilder().
+			SetConfig(builderCfg).
+			SetPriKey(sk1).
+			SetChainManager(NewChainManager(bc, sf, &dummyBlockBuildFactory{})).
+			SetBroadcast(func(_ proto.Message) error {
+				return nil
+			}).
+			SetDelegatesByEpochFunc(delegatesByEpoch).
+			SetProposersByEpochFunc(delegatesByEpoch).
+			SetBLSPubKeysFunc(func(uint64, []byte, []string) (map[string]*bls.PublicKey, error) {
+				return pubKeys, nil
+			}).
+			SetClock(clock).
+			RegisterProtocol(rp).
+			Build()
+		require.NoError(t, err)
+		require.NotNil(t, r)
+		require.NoError(t, r.Start(context.Background()))
+		return r
+	}
+	r := build(10)
 
 	// all right
 	blk := makeBlock(t, 1, 4, false, 9)
-	err = r.ValidateBlockFooter(blk)
+	err := r.ValidateBlockFooter(blk)
 	require.NoError(t, err)
 
 	// Proposer is wrong
@@ -285,6 +303,49 @@ func TestValidateBlockFooter(t *testing.T) {
 	blk = makeBlock(t, 1, 4, true, 9)
 	err = r.ValidateBlockFooter(blk)
 	require.Error(t, err)
+
+	// makeAggregate makes a block endorsed by the delegates of the indexes in an aggregate endorsement, and the
+	// delegates of the legacy indexes in the endorsements
+	makeAggregate := func(indexes, legacy []int, topic ConsensusVoteTopic) *block.Block {
+		blk := makeBlock(t, 1, 0, false, 9)
+		blkHash := blk.HashBlock()
+		ts := time.Unix(1500000000, 0)
+		var ens []*endorsement.Endorsement
+		for _, i := range indexes {
+			en, err := endorsement.Endorse(NewConsensusVote(blkHash[:], topic), ts, identityset.PrivateKey(i))
+			require.NoError(t, err)
+			require.NoError(t, endorsement.SignBLS(NewConsensusVote(blkHash[:], topic), en[0], blsKeys[i]))
+			ens = append(ens, en[0])
+		}
+		agg, err := endorsement.Aggregate(ens, indexes, 4)
+		require.NoError(t, err)
+		var legacyEns []*endorsement.Endorsement
+		for _, i := range legacy {
+			en, err := endorsement.Endorse(NewConsensusVote(blkHash[:], COMMIT), ts, identityset.PrivateKey(i))
+			require.NoError(t, err)
+			legacyEns = append(legacyEns, en[0])
+		}
+		require.NoError(t, blk.FinalizeWithAggregate(agg, legacyEns, ts))
+		return blk
+	}
+
+	// aggregate endorsement is rejected before the activation height
+	require.ErrorContains(t, r.ValidateBlockFooter(makeAggregate([]int{0, 1, 2}, nil, COMMIT)), "not enabled")
+
+	r = build(9)
+	// the legacy footer is still valid after the activation height
+	require.NoError(t, r.ValidateBlockFooter(makeBlock(t, 1, 4, false, 9)))
+	require.NoError(t, r.ValidateBlockFooter(makeAggregate([]int{0, 1, 2}, nil, COMMIT)))
+	require.NoError(t, r.ValidateBlockFooter(makeAggregate([]int{1, 3}, []int{0}, COMMIT)))
+	// the delegates in both the aggregate and the endorsements are counted once
+	require.ErrorIs(t, r.ValidateBlockFooter(makeAggregate([]int{1, 3}, []int{1}, COMMIT)), ErrInsufficientEndorsements)
+	require.ErrorIs(t, r.ValidateBlockFooter(makeAggregate([]int{1, 3}, nil, COMMIT)), ErrInsufficientEndorsements)
+	// the aggregate signature is of another topic
+	require.Error(t, r.ValidateBlockFooter(makeAggregate([]int{0, 1, 2}, nil, LOCK)))
+	// the delegate has not registered the BLS key
+	blk = makeAggregate([]int{0, 1, 2}, nil, COMMIT)
+	delete(pubKeys, candidates[2])
+	require.ErrorContains(t, r.ValidateBlockFooter(blk), "no BLS public key")
 }
 
 func TestRollDPoS_Metrics(t *testing.T) {
@@ -311,7 +372,7 @@ func TestRollDPoS_Metrics(t *testing.T) {
 
 	sk1 := identityset.PrivateKey(1)
 	cfg := DefaultConfig
-	cfg.ConsensusDBPath = "consensus.db"
+	cfg.ConsensusDBPath = filepath.Join(t.TempDir(), "consensus.db")
 	g := genesis.TestDefault()
 	g.NumDelegates = 4
 	g.NumSubEpochs = 1
@@ -25,6 +25,10 @@ import (
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
 	"github.com/iotexproject/iotex-core/v2/consensus/scheme"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/evidencepb"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/slashprotect"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/db"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
@@ -75,6 +79,9 @@ type (
 	// NodesSelectionByEpochFunc defines a function to select nodes
 	NodesSelectionByEpochFunc func(uint64, []byte) ([]string, error)
 
+	// BLSPubKeysFunc defines a function to read the BLS public keys of the delegates in the epoch at the block
+	BLSPubKeysFunc func(uint64, []byte, []string) (map[string]*bls.PublicKey, error)
+
 	// RDPoSCtx is the context of RollDPoS
 	RDPoSCtx interface {
 		consensusfsm.Context
@@ -84,6 +91,11 @@ type (
 		Clock() clock.Clock
 		CheckBlockProposer(uint64, *blockProposal, *endorsement.Endorsement) error
 		CheckVoteEndorser(uint64, *ConsensusVote, *endorsement.Endorsement) error
+		Evidences(uint64, uint64, string) []*evidencepb.Evidence
+		SetSigningFence(func(time.Time) (uint64, error))
+		RoundState(uint64) ([]*roundstatepb.RoundEvent, error)
+		SetRoundLog(RoundLogConfig)
+		SetBLSEndorsement(uint64, BLSPubKeysFunc, []*bls.PrivateKey) error
 	}
 
 	rollDPoSCtx struct {
@@ -96,9 +108,13 @@ type (
 		roundCalc         *roundCalculator
 		eManagerDB        db.KVStore
 		toleratedOvertime time.Duration
+		detector          *equivocationDetector
+		protection        *slashprotect.DB
+		roundLog          *roundLog
 
 		encodedAddrs []string
 		priKeys      []crypto.PrivateKey
+		blsPriKeys   []*bls.PrivateKey
 		round        *roundCtx
 		clock        clock.Clock
 		active       bool
@@ -176,6 +192,9 @@ func NewRollDPoSCtx(
 		roundCalc:         roundCalc,
 		eManagerDB:        eManagerDB,
 		toleratedOvertime: toleratedOvertime,
+		detector:          newEquivocationDetector(eManagerDB),
+		protection:        slashprotect.New(eManagerDB),
+		roundLog:          newRoundLog(DefaultConfig.RoundLog, eManagerDB),
 	}, nil
 }
 
@@ -192,6 +211,12 @@ func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "Error when creating the endorsement manager")
 		}
+		if err := ctx.detector.Load(); err != nil {
+			return errors.Wrap(err, "Error when loading the equivocation evidences")
+		}
+		if err := ctx.protection.Load(); err != nil {
+			return errors.Wrap(err, "Error when loading the slashing protection records")
+		}
 	}
 	ctx.round, err = ctx.roundCalc.NewRoundWithToleration(0, ctx.BlockInterval(0), ctx.clock.Now(), eManager, ctx.toleratedOvertime)
 
@@ -200,6 +225,9 @@ func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
 
 func (ctx *rollDPoSCtx) Stop(c context.Context) error {
 	if ctx.eManagerDB != nil {
+		if err := ctx.roundLog.Flush(); err != nil {
+			ctx.logger().Error("Error when persisting the round events", zap.Error(err))
+		}
 		return ctx.eManagerDB.Stop(c)
 	}
 	return nil
@@ -241,6 +269,10 @@ func (ctx *rollDPoSCtx) CheckVoteEndorser(
 	if !roundCalc.IsDelegate(endorserAddr.String(), height) {
 		return errors.Errorf("%s is not delegate of the corresponding round", endorserAddr)
 	}
+	if round, start, err := roundCalc.RoundInfo(height, ctx.BlockInterval(height), en.Timestamp()); err == nil {
+		ctx.detector.ObserveVote(round, NewEndorsedConsensusMessage(height, vote, en))
+		ctx.roundLog.ObserveEndorsement(height, round, start, ctx.clock.Now(), endorserAddr.String(), vote)
+	}
 
 	return nil
 }
@@ -284,6 +316,10 @@ func (ctx *rollDPoSCtx) CheckBlockProposer(
 	if !proposal.block.VerifySignature() {
 		return errors.Errorf("invalid block signature")
 	}
+	roundNum, roundStart, roundErr := roundCalc.RoundInfo(height, ctx.BlockInterval(height), proposal.block.Timestamp())
+	if roundErr == nil {
+		ctx.detector.ObserveBlock(roundNum, &proposal.block.Header)
+	}
 	if proposerAddr != endorserAddr.String() {
 		round, err := roundCalc.NewRound(height, ctx.BlockInterval(height), en.Timestamp(), nil)
 		if err != nil {
@@ -311,9 +347,55 @@ func (ctx *rollDPoSCtx) CheckBlockProposer(
 			return errors.Wrap(ErrInsufficientEndorsements, "failed to verify proof of lock")
 		}
 	}
+	if roundErr == nil {
+		ctx.roundLog.ObserveProposal(height, roundNum, roundStart, ctx.clock.Now(), endorserAddr.String(), proposal.block.HashBlock())
+	}
 	return nil
 }
 
+// Evidences returns up to count equivocation evidences at or above the height, of the offender if it is not empty
+func (ctx *rollDPoSCtx) Evidences(height uint64, count uint64, offender string) []*evidencepb.Evidence {
+	return ctx.detector.Evidences(height, count, offender)
+}
+
+// RoundState returns the consensus events of the node at the height, of the latest height if it is 0
+func (ctx *rollDPoSCtx) RoundState(height uint64) ([]*roundstatepb.RoundEvent, error) {
+	return ctx.roundLog.Events(height)
+}
+
+// SetRoundLog sets the config of the round log, it should be called before the context starts
+func (ctx *rollDPoSCtx) SetRoundLog(cfg RoundLogConfig) {
+	ctx.roundLog = newRoundLog(cfg, ctx.eManagerDB)
+}
+
+// SetBLSEndorsement enables the BLS aggregate of the commit endorsements from the height, the BLS private keys
+// belong to the producer keys at the same positions. It should be called before the context starts
+func (ctx *rollDPoSCtx) SetBLSEndorsement(height uint64, pubKeysFunc BLSPubKeysFunc, priKeys []*bls.PrivateKey) error {
+	if len(priKeys) != 0 && len(priKeys) != len(ctx.priKeys) {
+		return errors.Errorf("%d BLS private keys mismatch %d producer keys", len(priKeys), len(ctx.priKeys))
+	}
+	ctx.roundCalc.blsHeight = height
+	ctx.roundCalc.blsPubKeysFunc = pubKeysFunc
+	ctx.blsPriKeys = priKeys
+	return nil
+}
+
+// OnTransition records the state transition of the consensus fsm in the round log
+func (ctx *rollDPoSCtx) OnTransition(evt *consensusfsm.ConsensusEvent, src, dst fsm.State, timeout bool) {
+	ctx.mutex.RLock()
+	defer ctx.mutex.RUnlock()
+	ctx.roundLog.ObserveTransition(
+		ctx.round.Height(),
+		ctx.round.Number(),
+		ctx.round.StartTime(),
+		ctx.clock.Now(),
+		string(src),
+		string(dst),
+		string(evt.Type()),
+		timeout,
+	)
+}
+
 func (ctx *rollDPoSCtx) RoundCalc() *roundCalculator {
 	return ctx.roundCalc
 }
@@ -443,12 +525,12 @@ func (ctx *rollDPoSCtx) WaitUntilRoundStart() time.Duration {
 	now := ctx.clock.Now()
 	startTime := ctx.round.StartTime()
 	if now.Before(startTime) {
-		time.Sleep(startTime.Sub(now))
+		ctx.clock.Sleep(startTime.Sub(now))
 		return 0
 	}
 	overTime := now.Sub(startTime)
 	if !ctx.hasDelegate() && ctx.toleratedOvertime > overTime {
-		time.Sleep(ctx.toleratedOvertime - overTime)
+		ctx.clock.Sleep(ctx.toleratedOvertime - overTime)
 		return 0
 	}
 	return overTime
@@ -580,12 +662,7 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	if ctx.round.Height()%100 == 0 {
 		ctx.logger().Info("consensus reached", zap.Uint64("blockHeight", ctx.round.Height()))
 	}
-	if err := pendingBlock.Finalize(
-		ctx.round.Endorsements(blkHash, []ConsensusVoteTopic{COMMIT}),
-		ctx.round.StartTime().Add(
-			ctx.AcceptBlockTTL(ctx.round.height)+ctx.AcceptProposalEndorsementTTL(ctx.round.height)+ctx.AcceptLockEndorsementTTL(ctx.round.height),
-		),
-	); err != nil {
+	if err := ctx.finalize(pendingBlock, blkHash); err != nil {
 		return false, errors.Wrap(err, "failed to add endorsements to block")
 	}
 
@@ -597,7 +674,7 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 		ctx.logger().Info("chain is paused, block will not be committed")
 		return false, nil
 	case nil:
-		break
+		ctx.roundLog.ObserveCommit(ctx.round.Height(), ctx.round.Number(), ctx.round.StartTime(), ctx.clock.Now(), pendingBlock.HashBlock())
 	default:
 		log.L().Error("error when committing the block", zap.Error(err))
 		return false, errors.Wrap(err, "error when committing a block")
@@ -634,6 +711,25 @@ func (ctx *rollDPoSCtx) Commit(msg interface{}) (bool, error) {
 	return true, nil
 }
 
+// finalize adds the commit endorsements to the block, the BLS signatures of which are aggregated into one if the
+// aggregate is enabled at the height
+func (ctx *rollDPoSCtx) finalize(blk *block.Block, blkHash []byte) error {
+	commitTime := ctx.round.StartTime().Add(
+		ctx.AcceptBlockTTL(ctx.round.height) + ctx.AcceptProposalEndorsementTTL(ctx.round.height) + ctx.AcceptLockEndorsementTTL(ctx.round.height),
+	)
+	pubKeys, err := ctx.roundCalc.BLSPubKeys(ctx.round.Height(), ctx.round.Delegates())
+	if err != nil {
+		// the endorsements are still valid without being aggregated
+		ctx.logger().Warn("failed to read the BLS public keys of the delegates", zap.Error(err))
+	}
+	if len(pubKeys) != 0 {
+		if agg, ens := ctx.round.AggregateEndorsements(blkHash, COMMIT, commitTime, pubKeys); agg != nil {
+			return blk.FinalizeWithAggregate(agg, ens, commitTime)
+		}
+	}
+	return blk.Finalize(ctx.round.Endorsements(blkHash, []ConsensusVoteTopic{COMMIT}), commitTime)
+}
+
 func (ctx *rollDPoSCtx) encodeAndBroadcast(ecm *EndorsedConsensusMessage) error {
 	msg, err := ecm.Proto()
 	if err != nil {
@@ -689,6 +785,11 @@ func (ctx *rollDPoSCtx) Height() uint64 {
 	return ctx.round.Height()
 }
 
+// SetSigningFence sets the fence of the slashing protection
+func (ctx *rollDPoSCtx) SetSigningFence(fence func(time.Time) (uint64, error)) {
+	ctx.protection.SetFence(fence)
+}
+
 func (ctx *rollDPoSCtx) Activate(active bool) {
 	ctx.mutex.Lock()
 	defer ctx.mutex.Unlock()
@@ -708,6 +809,15 @@ func (ctx *rollDPoSCtx) Active() bool {
 ///////////////////////////////////////////
 
 func (ctx *rollDPoSCtx) mintNewBlock(privateKey crypto.PrivateKey) (*EndorsedConsensusMessage, error) {
+	if err := ctx.protection.Check(
+		privateKey.PublicKey().Address().String(),
+		ctx.round.Height(),
+		ctx.round.Number(),
+		slashprotect.BlockProposal,
+		ctx.round.StartTime(),
+	); err != nil {
+		return nil, err
+	}
 	var err error
 	blk := ctx.round.CachedMintedBlock()
 	if blk == nil {
@@ -737,6 +847,15 @@ func (ctx *rollDPoSCtx) hasDelegate() bool {
 }
 
 func (ctx *rollDPoSCtx) endorseBlockProposal(proposal *blockProposal, privateKey crypto.PrivateKey) (*EndorsedConsensusMessage, error) {
+	blkHash := proposal.block.HashBlock()
+	if err := ctx.protection.Approve(privateKey.PublicKey().Address().String(), slashprotect.Record{
+		Height: ctx.round.Height(),
+		Round:  ctx.round.Number(),
+		Kind:   slashprotect.BlockProposal,
+		Hash:   blkHash[:],
+	}, ctx.round.StartTime()); err != nil {
+		return nil, err
+	}
 	ens, err := endorsement.Endorse(proposal, ctx.round.StartTime(), privateKey)
 	if err != nil {
 		return nil, err
@@ -843,18 +962,37 @@ func (ctx *rollDPoSCtx) newEndorsement(
 		topic,
 	)
 	privKeys := make([]crypto.PrivateKey, 0, len(ctx.priKeys))
+	blsPriKeys := make([]*bls.PrivateKey, 0, len(ctx.priKeys))
 	for i, addr := range ctx.encodedAddrs {
 		if !ctx.round.IsDelegate(addr) {
 			continue
 		}
+		if err := ctx.protection.Approve(addr, slashprotect.Record{
+			Height: ctx.round.Height(),
+			Round:  ctx.round.Number(),
+			Kind:   voteKind(topic),
+			Hash:   blkHash,
+		}, ctx.round.StartTime()); err != nil {
+			ctx.loggerWithStats().Error("refuse to endorse", zap.String("delegate", addr), zap.Error(err))
+			continue
+		}
 		privKeys = append(privKeys, ctx.priKeys[i])
+		if len(ctx.blsPriKeys) != 0 {
+			blsPriKeys = append(blsPriKeys, ctx.blsPriKeys[i])
+		}
 	}
 	ens, err := endorsement.Endorse(vote, timestamp, privKeys...)
 	if err != nil {
 		return nil, err
 	}
 	msgs := make([]*EndorsedConsensusMessage, 0, len(ens))
-	for _, en := range ens {
+	for i, en := range ens {
+		// only the commit endorsements are aggregated in the block footer
+		if topic == COMMIT && len(blsPriKeys) != 0 && ctx.roundCalc.IsBLSEndorsement(ctx.round.Height()) {
+			if err := endorsement.SignBLS(vote, en, blsPriKeys[i]); err != nil {
+				return nil, err
+			}
+		}
 		msgs = append(msgs, NewEndorsedConsensusMessage(ctx.round.Height(), vote, en))
 	}
 
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/v2/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 )
@@ -25,6 +26,8 @@ type roundCalculator struct {
 	delegatesByEpochFunc NodesSelectionByEpochFunc
 	proposersByEpochFunc NodesSelectionByEpochFunc
 	beringHeight         uint64
+	blsHeight            uint64
+	blsPubKeysFunc       BLSPubKeysFunc
 }
 
 // UpdateRound updates previous roundCtx
@@ -191,6 +194,22 @@ func (c *roundCalculator) Delegates(height uint64) ([]string, error) {
 	return c.delegatesByEpochFunc(epochNum, prevHash[:])
 }
 
+// IsBLSEndorsement returns true if the commit endorsements of the block at given height can be aggregated
+func (c *roundCalculator) IsBLSEndorsement(height uint64) bool {
+	return c.blsPubKeysFunc != nil && height >= c.blsHeight
+}
+
+// BLSPubKeys returns the BLS public keys of the delegates in the epoch of given height, nil if the endorsements
+// at the height cannot be aggregated
+func (c *roundCalculator) BLSPubKeys(height uint64, delegates []string) (map[string]*bls.PublicKey, error) {
+	if !c.IsBLSEndorsement(height) {
+		return nil, nil
+	}
+	epochNum := c.rp.GetEpochNum(height)
+	prevHash := c.chain.TipHash()
+	return c.blsPubKeysFunc(epochNum, prevHash[:], delegates)
+}
+
 // Proposers returns list of candidate proposers at given height
 func (c *roundCalculator) Proposers(height uint64) ([]string, error) {
 	epochNum := c.rp.GetEpochNum(height)
@@ -304,5 +323,7 @@ func (c *roundCalculator) Fork(fork ForkChain) *roundCalculator {
 		delegatesByEpochFunc: c.delegatesByEpochFunc,
 		proposersByEpochFunc: c.proposersByEpochFunc,
 		beringHeight:         c.beringHeight,
+		blsHeight:            c.blsHeight,
+		blsPubKeysFunc:       c.blsPubKeysFunc,
 	}
 }
@@ -271,5 +271,7 @@ func makeRoundCalculator(t *testing.T) *roundCalculator {
 		delegatesByEpoch,
 		delegatesByEpoch,
 		0,
+		0,
+		nil,
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/iotexproject/go-pkgs/hash"
 
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/endorsement"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 )
@@ -205,6 +206,66 @@ func (ctx *roundCtx) EndorsedByMajority(
 	return ctx.endorsedByMajority(blockHash, topics)
 }
 
+// EndorsedByMajorityWithAggregate checks whether the block is endorsed by the majority on the topics, counting the
+// delegates endorsing in the aggregate endorsement as well
+func (ctx *roundCtx) EndorsedByMajorityWithAggregate(
+	blockHash []byte,
+	topics []ConsensusVoteTopic,
+	aggregated []string,
+) bool {
+	endorsers := make(map[string]struct{}, len(aggregated))
+	for _, d := range aggregated {
+		endorsers[d] = struct{}{}
+	}
+	for _, en := range ctx.endorsements(blockHash, topics) {
+		if addr := en.Endorser().Address(); addr != nil {
+			endorsers[addr.String()] = struct{}{}
+		}
+	}
+	return 3*len(endorsers) > 2*int(ctx.numOfDelegates)
+}
+
+// AggregateEndorsements aggregates the BLS signatures of the endorsements of the block on the topic at the time
+func (ctx *roundCtx) AggregateEndorsements(
+	blkHash []byte,
+	topic ConsensusVoteTopic,
+	ts time.Time,
+	pubKeys map[string]*bls.PublicKey,
+) (*endorsement.AggregateEndorsement, []*endorsement.Endorsement) {
+	return ctx.eManager.AggregateEndorsements(blkHash, topic, ts, ctx.delegates, pubKeys)
+}
+
+// VerifyAggregateEndorsement checks the aggregate endorsement of the vote at the time, and returns the delegates
+// endorsing in it
+func (ctx *roundCtx) VerifyAggregateEndorsement(
+	vote *ConsensusVote,
+	ts time.Time,
+	agg *endorsement.AggregateEndorsement,
+	pubKeys map[string]*bls.PublicKey,
+) ([]string, error) {
+	indexes, err := agg.Endorsers(len(ctx.delegates))
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return nil, errors.Wrap(endorsement.ErrInvalidAggregate, "no endorser")
+	}
+	endorsers := make([]string, 0, len(indexes))
+	pks := make([]*bls.PublicKey, 0, len(indexes))
+	for _, i := range indexes {
+		pk, ok := pubKeys[ctx.delegates[i]]
+		if !ok {
+			return nil, errors.Errorf("delegate %s has no BLS public key", ctx.delegates[i])
+		}
+		endorsers = append(endorsers, ctx.delegates[i])
+		pks = append(pks, pk)
+	}
+	if !endorsement.VerifyAggregateEndorsement(vote, ts, agg, pks) {
+		return nil, errors.New("invalid aggregate endorsement for the vote")
+	}
+	return endorsers, nil
+}
+
 func (ctx *roundCtx) AddBlock(blk *block.Block) error {
 	return ctx.eManager.RegisterBlock(blk)
 }
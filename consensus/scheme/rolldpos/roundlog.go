@@ -0,0 +1,307 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+const _roundLogNS = "rlg"
+
+var (
+	_proposalLatencyMtc = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "iotex_consensus_proposal_latency_seconds",
+			Help:    "Time from the round start to the arrival of the block proposal, by the delegate sending it",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+		},
+		[]string{"delegate"},
+	)
+	_endorsementLatencyMtc = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "iotex_consensus_endorsement_latency_seconds",
+			Help:    "Time from the round start to the arrival of the endorsement, by the topic and the endorser",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+		},
+		[]string{"topic", "delegate"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(_proposalLatencyMtc)
+	prometheus.MustRegister(_endorsementLatencyMtc)
+}
+
+type (
+	// RoundLogConfig is the config of the log of the consensus rounds
+	RoundLogConfig struct {
+		// Size is the max number of events kept in memory, the log is disabled if it is 0
+		Size int `yaml:"size"`
+		// Persist is true if the events are persisted in the consensus db as well
+		Persist bool `yaml:"persist"`
+		// PersistHeights is the number of the latest heights the persisted events are kept for, all are kept if it is 0
+		PersistHeights uint64 `yaml:"persistHeights"`
+	}
+
+	// roundLog records the consensus timeline of the node, the state transitions, the timeouts, the proposals and
+	// endorsements received, and the commits, in a ring buffer. The events persisted are written in a batch once
+	// the height changes or the block is committed, so that the consensus does not wait on the db for each event
+	roundLog struct {
+		cfg     RoundLogConfig
+		kvStore db.KVStore
+
+		mutex         sync.Mutex
+		events        []*roundstatepb.RoundEvent
+		next          int
+		seq           uint64
+		pending       batch.KVStoreBatch
+		pendingHeight uint64
+		pruned        uint64
+	}
+)
+
+func newRoundLog(cfg RoundLogConfig, kvStore db.KVStore) *roundLog {
+	if !cfg.Persist {
+		kvStore = nil
+	}
+	return &roundLog{
+		cfg:     cfg,
+		kvStore: kvStore,
+		events:  make([]*roundstatepb.RoundEvent, 0, cfg.Size),
+		pending: batch.NewBatch(),
+	}
+}
+
+// ObserveTransition records the state transition of the fsm
+func (l *roundLog) ObserveTransition(height uint64, round uint32, start, now time.Time, src, dst, evt string, timeout bool) {
+	typ := roundstatepb.RoundEventType_TRANSITION
+	if timeout {
+		typ = roundstatepb.RoundEventType_TIMEOUT
+	}
+	l.add(&roundstatepb.RoundEvent{
+		Height:    height,
+		Round:     round,
+		Type:      typ,
+		Timestamp: now.UnixNano(),
+		Src:       src,
+		Dst:       dst,
+		Event:     evt,
+		Latency:   int64(now.Sub(start)),
+	})
+}
+
+// ObserveProposal records the block proposal received from the sender
+func (l *roundLog) ObserveProposal(height uint64, round uint32, start, arrival time.Time, sender string, blkHash hash.Hash256) {
+	latency := arrival.Sub(start)
+	_proposalLatencyMtc.WithLabelValues(sender).Observe(math.Max(latency.Seconds(), 0))
+	l.add(&roundstatepb.RoundEvent{
+		Height:    height,
+		Round:     round,
+		Type:      roundstatepb.RoundEventType_PROPOSAL,
+		Timestamp: arrival.UnixNano(),
+		Sender:    sender,
+		BlockHash: blkHash[:],
+		Latency:   int64(latency),
+	})
+}
+
+// ObserveEndorsement records the endorsement received from the sender
+func (l *roundLog) ObserveEndorsement(height uint64, round uint32, start, arrival time.Time, sender string, vote *ConsensusVote) {
+	if vote == nil {
+		return
+	}
+	latency := arrival.Sub(start)
+	topic := topicName(vote.Topic())
+	_endorsementLatencyMtc.WithLabelValues(topic, sender).Observe(math.Max(latency.Seconds(), 0))
+	l.add(&roundstatepb.RoundEvent{
+		Height:    height,
+		Round:     round,
+		Type:      roundstatepb.RoundEventType_ENDORSEMENT,
+		Timestamp: arrival.UnixNano(),
+		Sender:    sender,
+		Topic:     topic,
+		BlockHash: vote.BlockHash(),
+		Latency:   int64(latency),
+	})
+}
+
+// ObserveCommit records the block committed by the consensus
+func (l *roundLog) ObserveCommit(height uint64, round uint32, start, now time.Time, blkHash hash.Hash256) {
+	l.add(&roundstatepb.RoundEvent{
+		Height:    height,
+		Round:     round,
+		Type:      roundstatepb.RoundEventType_COMMIT,
+		Timestamp: now.UnixNano(),
+		BlockHash: blkHash[:],
+		Latency:   int64(now.Sub(start)),
+	})
+}
+
+// Events returns the events of the height in the order they are recorded, the latest height if it is 0. The
+// events are read from the db if some of them have been dropped from memory
+func (l *roundLog) Events(height uint64) ([]*roundstatepb.RoundEvent, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	ordered := make([]*roundstatepb.RoundEvent, 0, len(l.events))
+	ordered = append(ordered, l.events[l.next:]...)
+	ordered = append(ordered, l.events[:l.next]...)
+	events := []*roundstatepb.RoundEvent{}
+	if len(ordered) == 0 && height == 0 {
+		return events, nil
+	}
+	if height == 0 {
+		height = ordered[len(ordered)-1].Height
+	}
+	// the events of the height may have been dropped, or recorded before the node restarts
+	if l.kvStore != nil && (len(ordered) == 0 || ordered[0].Height >= height) {
+		if err := l.flush(); err != nil {
+			return nil, err
+		}
+		return l.load(height)
+	}
+	for _, ev := range ordered {
+		if ev.Height == height {
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// Flush writes the events pending to the db
+func (l *roundLog) Flush() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.flush()
+}
+
+func (l *roundLog) add(ev *roundstatepb.RoundEvent) {
+	if l.cfg.Size <= 0 {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if len(l.events) < l.cfg.Size {
+		l.events = append(l.events, ev)
+	} else {
+		l.events[l.next] = ev
+		l.next = (l.next + 1) % l.cfg.Size
+	}
+	if l.kvStore == nil {
+		return
+	}
+	if l.pending.Size() > 0 && ev.Height != l.pendingHeight {
+		if err := l.flush(); err != nil {
+			log.L().Error("failed to persist round events", zap.Error(err))
+		}
+	}
+	value, err := proto.Marshal(ev)
+	if err != nil {
+		log.L().Error("failed to marshal round event", zap.Error(err))
+		return
+	}
+	l.seq++
+	key := append(byteutil.Uint64ToBytesBigEndian(ev.Height), byteutil.Uint64ToBytesBigEndian(uint64(ev.Timestamp))...)
+	l.pending.Put(_roundLogNS, append(key, byteutil.Uint64ToBytesBigEndian(l.seq)...), value, "failed to put round event")
+	l.pendingHeight = ev.Height
+	if ev.Type == roundstatepb.RoundEventType_COMMIT {
+		if err := l.flush(); err != nil {
+			log.L().Error("failed to persist round events", zap.Error(err))
+		}
+	}
+}
+
+// flush writes the events pending, and prunes the events below the heights kept
+func (l *roundLog) flush() error {
+	if l.kvStore == nil || l.pending.Size() == 0 {
+		return nil
+	}
+	// the events are dropped if failing to write, as they are kept in memory anyway
+	err := l.kvStore.WriteBatch(l.pending)
+	l.pending.Clear()
+	if err != nil {
+		return errors.Wrap(err, "failed to write round events")
+	}
+	if l.cfg.PersistHeights == 0 || l.pendingHeight <= l.cfg.PersistHeights {
+		return nil
+	}
+	bottom := l.pendingHeight - l.cfg.PersistHeights
+	if bottom <= l.pruned {
+		return nil
+	}
+	keys, _, err := l.kvStore.Filter(_roundLogNS, func(k, v []byte) bool { return true }, nil, roundLogKeyBound(bottom))
+	if err != nil {
+		return errors.Wrap(err, "failed to read round events to prune")
+	}
+	b := batch.NewBatch()
+	for _, k := range keys {
+		b.Delete(_roundLogNS, k, "failed to delete round event")
+	}
+	if err := l.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrap(err, "failed to prune round events")
+	}
+	l.pruned = bottom
+	return nil
+}
+
+func (l *roundLog) load(height uint64) ([]*roundstatepb.RoundEvent, error) {
+	events := []*roundstatepb.RoundEvent{}
+	_, values, err := l.kvStore.Filter(
+		_roundLogNS,
+		func(k, v []byte) bool { return true },
+		byteutil.Uint64ToBytesBigEndian(height),
+		roundLogKeyBound(height),
+	)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return events, nil
+		}
+		return nil, errors.Wrap(err, "failed to read round events")
+	}
+	for _, v := range values {
+		ev := &roundstatepb.RoundEvent{}
+		if err := proto.Unmarshal(v, ev); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal round event")
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// roundLogKeyBound returns the max key of the events of the height
+func roundLogKeyBound(height uint64) []byte {
+	key := byteutil.Uint64ToBytesBigEndian(height)
+	for i := 0; i < 16; i++ {
+		key = append(key, 0xff)
+	}
+	return key
+}
+
+func topicName(topic ConsensusVoteTopic) string {
+	switch topic {
+	case PROPOSAL:
+		return "PROPOSAL"
+	case LOCK:
+		return "LOCK"
+	case COMMIT:
+		return "COMMIT"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+func TestRoundLog(t *testing.T) {
+	start := time.Unix(1562382592, 0)
+	sender := identityset.Address(1).String()
+	blkHash := hash.Hash256b([]byte("block"))
+	// record records a round of the height, 5 events in total
+	record := func(l *roundLog, height uint64) {
+		l.ObserveTransition(height, 0, start, start, "S_PREPARE", "S_ACCEPT_BLOCK_PROPOSAL", "E_PREPARE", false)
+		l.ObserveProposal(height, 0, start, start.Add(300*time.Millisecond), sender, blkHash)
+		l.ObserveEndorsement(height, 0, start, start.Add(time.Second), sender, NewConsensusVote(blkHash[:], LOCK))
+		l.ObserveTransition(height, 0, start, start.Add(2*time.Second), "S_ACCEPT_LOCK_ENDORSEMENT", "S_PREPARE", "E_STOP_RECEIVING_LOCK_ENDORSEMENT", true)
+		l.ObserveCommit(height, 0, start, start.Add(3*time.Second), blkHash)
+	}
+
+	t.Run("ring", func(t *testing.T) {
+		require := require.New(t)
+		l := newRoundLog(RoundLogConfig{Size: 8}, nil)
+		events, err := l.Events(0)
+		require.NoError(err)
+		require.Empty(events)
+		record(l, 1)
+		record(l, 2)
+
+		// the events of the latest height are kept, the earlier ones are dropped
+		events, err = l.Events(0)
+		require.NoError(err)
+		require.Len(events, 5)
+		require.Equal([]roundstatepb.RoundEventType{
+			roundstatepb.RoundEventType_TRANSITION,
+			roundstatepb.RoundEventType_PROPOSAL,
+			roundstatepb.RoundEventType_ENDORSEMENT,
+			roundstatepb.RoundEventType_TIMEOUT,
+			roundstatepb.RoundEventType_COMMIT,
+		}, []roundstatepb.RoundEventType{events[0].Type, events[1].Type, events[2].Type, events[3].Type, events[4].Type})
+		require.EqualValues(2, events[0].Height)
+		require.Equal(sender, events[1].Sender)
+		require.Equal(blkHash[:], events[1].BlockHash)
+		require.EqualValues(300*time.Millisecond, events[1].Latency)
+		require.Equal("LOCK", events[2].Topic)
+		require.Equal("E_STOP_RECEIVING_LOCK_ENDORSEMENT", events[3].Event)
+		require.EqualValues(start.Add(3*time.Second).UnixNano(), events[4].Timestamp)
+		events, err = l.Events(1)
+		require.NoError(err)
+		require.Len(events, 3)
+		require.Equal(roundstatepb.RoundEventType_ENDORSEMENT, events[0].Type)
+
+		// nothing is recorded if the log is disabled
+		l = newRoundLog(RoundLogConfig{}, nil)
+		record(l, 1)
+		events, err = l.Events(1)
+		require.NoError(err)
+		require.Empty(events)
+	})
+
+	t.Run("persistence", func(t *testing.T) {
+		require := require.New(t)
+		path, err := testutil.PathOfTempFile("consensus.db")
+		require.NoError(err)
+		defer testutil.CleanupPath(path)
+		cfg := db.DefaultConfig
+		cfg.DbPath = path
+		kvStore := db.NewBoltDB(cfg)
+		ctx := context.Background()
+		require.NoError(kvStore.Start(ctx))
+		defer kvStore.Stop(ctx)
+
+		l := newRoundLog(RoundLogConfig{Size: 8, Persist: true, PersistHeights: 2}, kvStore)
+		for h := uint64(1); h <= 4; h++ {
+			record(l, h)
+		}
+		// the events dropped from memory are read from the db
+		events, err := l.Events(3)
+		require.NoError(err)
+		require.Len(events, 5)
+		require.Equal(roundstatepb.RoundEventType_TRANSITION, events[0].Type)
+		require.Equal(roundstatepb.RoundEventType_COMMIT, events[4].Type)
+		// the heights below the ones kept are pruned
+		events, err = l.Events(1)
+		require.NoError(err)
+		require.Empty(events)
+
+		// the events are read from the db after restart
+		l = newRoundLog(RoundLogConfig{Size: 8, Persist: true, PersistHeights: 2}, kvStore)
+		events, err = l.Events(4)
+		require.NoError(err)
+		require.Len(events, 5)
+	})
+}
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.27.1
+// source: roundstate.proto
+
+package roundstatepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoundEventType int32
+
+const (
+	RoundEventType_TRANSITION  RoundEventType = 0
+	RoundEventType_TIMEOUT     RoundEventType = 1
+	RoundEventType_PROPOSAL    RoundEventType = 2
+	RoundEventType_ENDORSEMENT RoundEventType = 3
+	RoundEventType_COMMIT      RoundEventType = 4
+)
+
+// Enum value maps for RoundEventType.
+var (
+	RoundEventType_name = map[int32]string{
+		0: "TRANSITION",
+		1: "TIMEOUT",
+		2: "PROPOSAL",
+		3: "ENDORSEMENT",
+		4: "COMMIT",
+	}
+	RoundEventType_value = map[string]int32{
+		"TRANSITION":  0,
+		"TIMEOUT":     1,
+		"PROPOSAL":    2,
+		"ENDORSEMENT": 3,
+		"COMMIT":      4,
+	}
+)
+
+func (x RoundEventType) Enum() *RoundEventType {
+	p := new(RoundEventType)
+	*p = x
+	return p
+}
+
+func (x RoundEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_roundstate_proto_enumTypes[0].Descriptor()
+}
+
+func (RoundEventType) Type() protoreflect.EnumType {
+	return &file_roundstate_proto_enumTypes[0]
+}
+
+func (x RoundEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundEventType.Descriptor instead.
+func (RoundEventType) EnumDescriptor() ([]byte, []int) {
+	return file_roundstate_proto_rawDescGZIP(), []int{0}
+}
+
+// RoundEvent is an event in the consensus timeline of the node
+type RoundEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  uint32                 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Type   RoundEventType         `protobuf:"varint,3,opt,name=type,proto3,enum=roundstatepb.RoundEventType" json:"type,omitempty"`
+	// timestamp is the time the event happens, or the message arrives, in unix nanoseconds
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// src, dst and event are the states and the event of a state transition or timeout
+	Src   string `protobuf:"bytes,5,opt,name=src,proto3" json:"src,omitempty"`
+	Dst   string `protobuf:"bytes,6,opt,name=dst,proto3" json:"dst,omitempty"`
+	Event string `protobuf:"bytes,7,opt,name=event,proto3" json:"event,omitempty"`
+	// sender is the delegate sending the proposal or endorsement
+	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// topic is the topic of the endorsement
+	Topic     string `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+	BlockHash []byte `protobuf:"bytes,10,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	// latency is the time since the round starts, in nanoseconds
+	Latency       int64 `protobuf:"varint,11,opt,name=latency,proto3" json:"latency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundEvent) Reset() {
+	*x = RoundEvent{}
+	mi := &file_roundstate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundEvent) ProtoMessage() {}
+
+func (x *RoundEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_roundstate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundEvent.ProtoReflect.Descriptor instead.
+func (*RoundEvent) Descriptor() ([]byte, []int) {
+	return file_roundstate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoundEvent) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RoundEvent) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundEvent) GetType() RoundEventType {
+	if x != nil {
+		return x.Type
+	}
+	return RoundEventType_TRANSITION
+}
+
+func (x *RoundEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RoundEvent) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *RoundEvent) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *RoundEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *RoundEvent) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *RoundEvent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *RoundEvent) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *RoundEvent) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+type GetRoundStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*RoundEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoundStateResponse) Reset() {
+	*x = GetRoundStateResponse{}
+	mi := &file_roundstate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoundStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoundStateResponse) ProtoMessage() {}
+
+func (x *GetRoundStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roundstate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoundStateResponse.ProtoReflect.Descriptor instead.
+func (*GetRoundStateResponse) Descriptor() ([]byte, []int) {
+	return file_roundstate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoundStateResponse) GetEvents() []*RoundEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_roundstate_proto protoreflect.FileDescriptor
+
+var file_roundstate_proto_rawDesc = string([]byte{
+	0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x74, 0x61, 0x74, 0x65, 0x70, 0x62,
+	0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x74, 0x61, 0x74, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x49, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x58, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49,
+	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x53, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x10, 0x04, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x64, 0x70, 0x6f, 0x73, 0x2f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_roundstate_proto_rawDescOnce sync.Once
+	file_roundstate_proto_rawDescData []byte
+)
+
+func file_roundstate_proto_rawDescGZIP() []byte {
+	file_roundstate_proto_rawDescOnce.Do(func() {
+		file_roundstate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_roundstate_proto_rawDesc), len(file_roundstate_proto_rawDesc)))
+	})
+	return file_roundstate_proto_rawDescData
+}
+
+var file_roundstate_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_roundstate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_roundstate_proto_goTypes = []any{
+	(RoundEventType)(0),           // 0: roundstatepb.RoundEventType
+	(*RoundEvent)(nil),            // 1: roundstatepb.RoundEvent
+	(*GetRoundStateResponse)(nil), // 2: roundstatepb.GetRoundStateResponse
+}
+var file_roundstate_proto_depIdxs = []int32{
+	0, // 0: roundstatepb.RoundEvent.type:type_name -> roundstatepb.RoundEventType
+	1, // 1: roundstatepb.GetRoundStateResponse.events:type_name -> roundstatepb.RoundEvent
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_roundstate_proto_init() }
+func file_roundstate_proto_init() {
+	if File_roundstate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_roundstate_proto_rawDesc), len(file_roundstate_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_roundstate_proto_goTypes,
+		DependencyIndexes: file_roundstate_proto_depIdxs,
+		EnumInfos:         file_roundstate_proto_enumTypes,
+		MessageInfos:      file_roundstate_proto_msgTypes,
+	}.Build()
+	File_roundstate_proto = out.File
+	file_roundstate_proto_goTypes = nil
+	file_roundstate_proto_depIdxs = nil
+}
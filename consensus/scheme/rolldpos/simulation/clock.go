@@ -0,0 +1,155 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package simulation
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+)
+
+var _ clock.Clock = (*Clock)(nil)
+
+type (
+	// Clock is the simulated clock shared by the nodes. Unlike clock.Mock, it never blocks on a timer nobody
+	// waits for, and fires the timers due at the same time in the order they are created
+	Clock struct {
+		mutex  sync.Mutex
+		now    time.Time
+		seq    uint64
+		timers timerHeap
+	}
+
+	simTimer struct {
+		at     time.Time
+		seq    uint64
+		period time.Duration
+		c      chan time.Time
+	}
+
+	timerHeap []*simTimer
+)
+
+// NewClock creates a simulated clock starting at the time
+func NewClock(now time.Time) *Clock {
+	return &Clock{
+		now: now,
+	}
+}
+
+// Now returns the current time
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// After sends the time on the channel returned once the duration elapses
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return c.schedule(d, 0)
+}
+
+// Sleep blocks until the duration elapses, the clock should be advanced by another goroutine
+func (c *Clock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// Tick sends the time on the channel returned every duration, the ticks are dropped if not received
+func (c *Clock) Tick(d time.Duration) <-chan time.Time {
+	return c.schedule(d, d)
+}
+
+// AfterFunc is not supported by the simulated clock
+func (c *Clock) AfterFunc(time.Duration, func()) *clock.Timer {
+	panic("AfterFunc is not supported by the simulated clock")
+}
+
+// Timer is not supported by the simulated clock
+func (c *Clock) Timer(time.Duration) *clock.Timer {
+	panic("Timer is not supported by the simulated clock")
+}
+
+// Ticker is not supported by the simulated clock
+func (c *Clock) Ticker(time.Duration) *clock.Ticker {
+	panic("Ticker is not supported by the simulated clock")
+}
+
+// Waiters returns the number of the goroutines blocked on the clock, which is the number of the one-shot timers
+// not fired yet
+func (c *Clock) Waiters() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	n := 0
+	for _, t := range c.timers {
+		if t.period == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Add advances the clock by the duration, and fires the timers due meanwhile
+func (c *Clock) Add(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	end := c.now.Add(d)
+	for len(c.timers) > 0 && !c.timers[0].at.After(end) {
+		t := heap.Pop(&c.timers).(*simTimer)
+		c.now = t.at
+		select {
+		case t.c <- t.at:
+		default:
+		}
+		if t.period > 0 {
+			t.at = t.at.Add(t.period)
+			c.seq++
+			t.seq = c.seq
+			heap.Push(&c.timers, t)
+		}
+	}
+	c.now = end
+}
+
+func (c *Clock) schedule(d, period time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 && period == 0 {
+		ch <- c.now
+		return ch
+	}
+	c.seq++
+	heap.Push(&c.timers, &simTimer{
+		at:     c.now.Add(d),
+		seq:    c.seq,
+		period: period,
+		c:      ch,
+	})
+	return ch
+}
+
+func (h timerHeap) Len() int { return len(h) }
+
+func (h timerHeap) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].at.Before(h[j].at)
+}
+
+func (h timerHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *timerHeap) Push(x any) { *h = append(*h, x.(*simTimer)) }
+
+func (h *timerHeap) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	*h = old[:n-1]
+	return t
+}
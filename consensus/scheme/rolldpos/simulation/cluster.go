@@ -0,0 +1,476 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// Package simulation runs a cluster of roll-DPoS consensus instances in process, over a simulated network and a
+// simulated clock shared by all the nodes. The network delivers the messages on the simulated time, through the faults
+// programmed by the test, and the outcome of a run is reproducible from the seed.
+package simulation
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand"
+	"runtime"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	rp "github.com/iotexproject/iotex-core/v2/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/v2/actpool"
+	"github.com/iotexproject/iotex-core/v2/blockchain"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos"
+	cp "github.com/iotexproject/iotex-core/v2/crypto"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+)
+
+type (
+	// Config is the config of the simulation
+	Config struct {
+		// Nodes is the number of the nodes, each of which is a delegate
+		Nodes int
+		// Seed is the seed of the random faults
+		Seed int64
+		// Latency is the latency of the network
+		Latency time.Duration
+		// Step is the step the simulated clock advances by
+		Step time.Duration
+		// BlockInterval is the block interval of the chain
+		BlockInterval time.Duration
+		// Sync is true if a lagging node syncs the blocks from the others reachable
+		Sync bool
+	}
+
+	// Node is a node of the cluster
+	Node struct {
+		Index     int
+		Address   string
+		Key       crypto.PrivateKey
+		Consensus *rolldpos.RollDPoS
+		Chain     blockchain.Blockchain
+		dao       blockdao.BlockDAO
+	}
+
+	// Cluster is a cluster of consensus instances running on the simulated network and clock
+	Cluster struct {
+		cfg     Config
+		clock   *Clock
+		network *Network
+		nodes   []*Node
+	}
+)
+
+// DefaultConfig is the default config of the simulation
+var DefaultConfig = Config{
+	Nodes:         4,
+	Seed:          1,
+	Latency:       50 * time.Millisecond,
+	Step:          10 * time.Millisecond,
+	BlockInterval: 2 * time.Second,
+	Sync:          true,
+}
+
+// NewCluster creates a cluster of the config
+func NewCluster(cfg Config) (*Cluster, error) {
+	if cfg.Nodes <= 0 || cfg.Step <= 0 || cfg.BlockInterval <= 0 {
+		return nil, errors.New("invalid simulation config")
+	}
+	g := genesis.TestDefault()
+	g.BlockInterval = cfg.BlockInterval
+	g.Blockchain.NumDelegates = uint64(cfg.Nodes)
+	g.Blockchain.NumSubEpochs = 1
+	// the proposer rotates on timeout as on the mainnet, so that an absent delegate does not halt the chain
+	g.Blockchain.TimeBasedRotation = true
+	g.EnableGravityChainVoting = false
+	block.LoadGenesisHash(&g)
+
+	// the clock starts a step after the genesis block
+	ck := NewClock(time.Unix(g.Timestamp, 0).Add(cfg.Step))
+	c := &Cluster{
+		cfg:     cfg,
+		clock:   ck,
+		network: newNetwork(cfg.Seed, cfg.Latency),
+		nodes:   make([]*Node, cfg.Nodes),
+	}
+
+	keys := make([]crypto.PrivateKey, cfg.Nodes)
+	delegates := make([]string, cfg.Nodes)
+	for i := range keys {
+		keys[i] = identityset.PrivateKey(i)
+		delegates[i] = keys[i].PublicKey().Address().String()
+	}
+	cp.SortCandidates(delegates, 1, cp.CryptoSeed)
+	delegatesByEpoch := func(uint64, []byte) ([]string, error) {
+		return delegates, nil
+	}
+	for i := range c.nodes {
+		node, err := c.newNode(i, keys[i], g, delegatesByEpoch)
+		if err != nil {
+			return nil, err
+		}
+		c.nodes[i] = node
+	}
+	return c, nil
+}
+
+func (c *Cluster) newNode(i int, sk crypto.PrivateKey, g genesis.Genesis, delegatesByEpoch rolldpos.NodesSelectionByEpochFunc) (*Node, error) {
+	cfg := rolldpos.DefaultConfig
+	cfg.ConsensusDBPath = ""
+	cfg.Delay = 0
+	cfg.ToleratedOvertime = 200 * time.Millisecond
+	cfg.FSM.AcceptBlockTTL = c.cfg.BlockInterval * 2 / 5
+	cfg.FSM.AcceptProposalEndorsementTTL = c.cfg.BlockInterval / 5
+	cfg.FSM.AcceptLockEndorsementTTL = c.cfg.BlockInterval / 5
+	cfg.FSM.CommitTTL = c.cfg.BlockInterval / 5
+	cfg.FSM.UnmatchedEventTTL = c.cfg.BlockInterval / 2
+	cfg.FSM.UnmatchedEventInterval = c.cfg.Step
+	bc := blockchain.DefaultConfig
+	bc.ProducerPrivKey = hex.EncodeToString(sk.Bytes())
+
+	ctx := context.Background()
+	registry := protocol.NewRegistry()
+	sf, err := factory.NewStateDB(factory.GenerateConfig(bc, g), db.NewMemKVStore(), factory.RegistryStateDBOption(registry))
+	if err != nil {
+		return nil, err
+	}
+	if err := account.NewProtocol(rewarding.DepositGas).Register(registry); err != nil {
+		return nil, err
+	}
+	rollDPoS := rp.NewProtocol(g.NumCandidateDelegates, g.NumDelegates, g.NumSubEpochs)
+	if err := rollDPoS.Register(registry); err != nil {
+		return nil, err
+	}
+	if err := sf.Start(genesis.WithGenesisContext(protocol.WithRegistry(ctx, registry), g)); err != nil {
+		return nil, err
+	}
+	ap, err := actpool.NewActPool(g, sf, actpool.DefaultConfig)
+	if err != nil {
+		return nil, err
+	}
+	store, err := filedao.NewFileDAOInMemForTest()
+	if err != nil {
+		return nil, err
+	}
+	dao := blockdao.NewBlockDAOWithIndexersAndCache(store, []blockdao.BlockIndexer{sf}, db.DefaultConfig.MaxCacheSize)
+	minter := factory.NewMinter(sf, ap)
+	chain := blockchain.NewBlockchain(
+		bc,
+		g,
+		dao,
+		minter,
+		blockchain.BlockValidatorOption(block.NewValidator(
+			sf,
+			protocol.NewGenericValidator(sf, accountutil.AccountState),
+		)),
+	)
+	consensus, err := rolldpos.NewRollDPoSBuilder().
+		SetPriKey(sk).
+		SetConfig(rolldpos.BuilderConfig{
+			Chain:              bc,
+			Consensus:          cfg,
+			DardanellesUpgrade: consensusfsm.DefaultDardanellesUpgradeConfig,
+			DB:                 db.DefaultConfig,
+			Genesis:            g,
+			SystemActive:       true,
+		}).
+		SetChainManager(rolldpos.NewChainManager(chain, sf, minter)).
+		SetBroadcast(func(msg proto.Message) error {
+			if cMsg, ok := msg.(*iotextypes.ConsensusMessage); ok {
+				c.broadcast(i, cMsg)
+			}
+			return nil
+		}).
+		SetClock(c.clock).
+		SetDelegatesByEpochFunc(delegatesByEpoch).
+		SetProposersByEpochFunc(delegatesByEpoch).
+		RegisterProtocol(rollDPoS).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return &Node{
+		Index:     i,
+		Address:   sk.PublicKey().Address().String(),
+		Key:       sk,
+		Consensus: consensus,
+		Chain:     chain,
+		dao:       dao,
+	}, nil
+}
+
+// Start starts the nodes
+func (c *Cluster) Start(ctx context.Context) error {
+	for _, node := range c.nodes {
+		if err := node.Chain.Start(ctx); err != nil {
+			return errors.Wrapf(err, "failed to start chain of node %d", node.Index)
+		}
+		if err := node.Consensus.Start(ctx); err != nil {
+			return errors.Wrapf(err, "failed to start consensus of node %d", node.Index)
+		}
+	}
+	c.settle()
+	return nil
+}
+
+// Stop stops the nodes
+func (c *Cluster) Stop(ctx context.Context) error {
+	// keep the clock running, so that the nodes waiting on it exit
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				c.clock.Add(c.cfg.Step)
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+	for _, node := range c.nodes {
+		if err := node.Consensus.Stop(ctx); err != nil {
+			return errors.Wrapf(err, "failed to stop consensus of node %d", node.Index)
+		}
+		if err := node.Chain.Stop(ctx); err != nil {
+			return errors.Wrapf(err, "failed to stop chain of node %d", node.Index)
+		}
+	}
+	return nil
+}
+
+// Network returns the simulated network
+func (c *Cluster) Network() *Network {
+	return c.network
+}
+
+// Clock returns the simulated clock
+func (c *Cluster) Clock() clock.Clock {
+	return c.clock
+}
+
+// Node returns the i-th node
+func (c *Cluster) Node(i int) *Node {
+	return c.nodes[i]
+}
+
+// Nodes returns the number of the nodes
+func (c *Cluster) Nodes() int {
+	return len(c.nodes)
+}
+
+// Run runs the cluster for the duration of simulated time
+func (c *Cluster) Run(d time.Duration) {
+	c.RunUntil(func() bool { return false }, d)
+}
+
+// RunUntil runs the cluster until the condition is met or the duration of simulated time elapses, and returns
+// whether the condition is met
+func (c *Cluster) RunUntil(cond func() bool, d time.Duration) bool {
+	end := c.clock.Now().Add(d)
+	for c.clock.Now().Before(end) {
+		if cond() {
+			return true
+		}
+		c.step()
+	}
+	return cond()
+}
+
+// Heights returns the tip heights of the nodes
+func (c *Cluster) Heights() []uint64 {
+	heights := make([]uint64, len(c.nodes))
+	for i, node := range c.nodes {
+		heights[i] = node.Chain.TipHeight()
+	}
+	return heights
+}
+
+// ReachHeight returns a condition met once the nodes reach the height, all the nodes if none is given
+func (c *Cluster) ReachHeight(height uint64, nodes ...int) func() bool {
+	if len(nodes) == 0 {
+		nodes = c.all()
+	}
+	return func() bool {
+		for _, i := range nodes {
+			if c.nodes[i].Chain.TipHeight() < height {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// CheckSafety returns an error if two nodes commit different blocks at the same height
+func (c *Cluster) CheckSafety() error {
+	var max uint64
+	for _, h := range c.Heights() {
+		if h > max {
+			max = h
+		}
+	}
+	for height := uint64(1); height <= max; height++ {
+		committed := map[string]int{}
+		for _, node := range c.nodes {
+			if node.Chain.TipHeight() < height {
+				continue
+			}
+			header, err := node.Chain.BlockHeaderByHeight(height)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get block %d of node %d", height, node.Index)
+			}
+			h := header.HashBlock()
+			committed[hex.EncodeToString(h[:])] = node.Index
+		}
+		if len(committed) > 1 {
+			return errors.Errorf("nodes commit %d different blocks at height %d", len(committed), height)
+		}
+	}
+	return nil
+}
+
+// CheckLiveness returns an error if any of the nodes, all the nodes if none is given, is below the height
+func (c *Cluster) CheckLiveness(height uint64, nodes ...int) error {
+	if len(nodes) == 0 {
+		nodes = c.all()
+	}
+	for _, i := range nodes {
+		if h := c.nodes[i].Chain.TipHeight(); h < height {
+			return errors.Errorf("node %d is at height %d, below %d", i, h, height)
+		}
+	}
+	return nil
+}
+
+// Equivocate returns a fault making the node send a conflicting vote, for another block, to half of the peers
+func (c *Cluster) Equivocate(node int) Fault {
+	sk := c.nodes[node].Key
+	return FaultFunc(func(env *Envelope, rng *rand.Rand) []*Envelope {
+		if env.From != node || env.Msg == nil || env.Msg.GetVote() == nil || env.To%2 == 0 {
+			return []*Envelope{env}
+		}
+		msg, err := conflictingVote(env.Msg, sk, rng)
+		if err != nil {
+			log.L().Error("Failed to create conflicting vote.", zap.Error(err))
+			return []*Envelope{env}
+		}
+		return []*Envelope{env, {
+			From:      env.From,
+			To:        env.To,
+			Msg:       msg,
+			DeliverAt: env.DeliverAt,
+		}}
+	})
+}
+
+func (c *Cluster) all() []int {
+	nodes := make([]int, len(c.nodes))
+	for i := range nodes {
+		nodes[i] = i
+	}
+	return nodes
+}
+
+func (c *Cluster) broadcast(from int, msg *iotextypes.ConsensusMessage) {
+	now := c.clock.Now()
+	for to := range c.nodes {
+		if to != from {
+			c.network.send(&Envelope{From: from, To: to, Msg: msg}, now)
+		}
+	}
+}
+
+// step delivers the messages due, and advances the clock by a step
+func (c *Cluster) step() {
+	now := c.clock.Now()
+	for _, env := range c.network.due(now) {
+		c.deliver(env)
+	}
+	c.settle()
+	if c.cfg.Sync {
+		c.sync(now)
+	}
+	c.clock.Add(c.cfg.Step)
+	c.settle()
+}
+
+func (c *Cluster) deliver(env *Envelope) {
+	node := c.nodes[env.To]
+	if env.Block != nil {
+		if env.Block.Height() != node.Chain.TipHeight()+1 {
+			return
+		}
+		if err := node.Chain.ValidateBlock(env.Block); err != nil {
+			log.L().Debug("Failed to validate synced block.", zap.Int("node", env.To), zap.Error(err))
+			return
+		}
+		if err := node.Chain.CommitBlock(env.Block); err != nil {
+			log.L().Debug("Failed to commit synced block.", zap.Int("node", env.To), zap.Error(err))
+			return
+		}
+		node.Consensus.Calibrate(env.Block.Height())
+		return
+	}
+	if err := node.Consensus.HandleConsensusMsg(env.Msg); err != nil {
+		log.L().Debug("Failed to handle consensus message.", zap.Int("node", env.To), zap.Error(err))
+	}
+}
+
+// sync sends the next block to each lagging node, from the first node ahead of it. The block goes through the
+// faults as the consensus messages, so that a partitioned node does not sync either
+func (c *Cluster) sync(now time.Time) {
+	for _, to := range c.nodes {
+		height := to.Chain.TipHeight() + 1
+		if c.network.inFlight(to.Index, true) {
+			continue
+		}
+		for _, from := range c.nodes {
+			if from.Chain.TipHeight() < height {
+				continue
+			}
+			blk, err := from.dao.GetBlockByHeight(height)
+			if err != nil {
+				continue
+			}
+			c.network.send(&Envelope{From: from.Index, To: to.Index, Block: blk}, now)
+			break
+		}
+	}
+}
+
+// settle waits for the nodes to process the events produced, so that a step completes before the clock advances.
+// The nodes are idle once every goroutine handling or producing the events is blocked on the simulated clock, the
+// delayed ones waiting for their delays and the event loops either sleeping until the round starts or waiting for
+// the next event. The timers are only fired by advancing the clock, so the number of the waiters only grows while
+// settling, and it is read before and after the goroutines to make sure they are counted at once
+func (c *Cluster) settle() {
+	for {
+		waiters := c.clock.Waiters()
+		routines := 0
+		for _, node := range c.nodes {
+			routines += node.Consensus.NumEventRoutines()
+		}
+		if routines == waiters && c.clock.Waiters() == waiters {
+			return
+		}
+		runtime.Gosched()
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos"
+	"github.com/iotexproject/iotex-core/v2/endorsement"
+)
+
+// conflictingVote creates a vote of the same topic, height and time as the message, for a random block hash
+func conflictingVote(msg *iotextypes.ConsensusMessage, sk crypto.PrivateKey, rng *rand.Rand) (*iotextypes.ConsensusMessage, error) {
+	vote := &rolldpos.ConsensusVote{}
+	if err := vote.LoadProto(msg.GetVote()); err != nil {
+		return nil, err
+	}
+	blkHash := make([]byte, len(vote.BlockHash()))
+	rng.Read(blkHash)
+	conflict := rolldpos.NewConsensusVote(blkHash, vote.Topic())
+	ts := msg.GetEndorsement().GetTimestamp().AsTime()
+	ens, err := endorsement.Endorse(conflict, ts, sk)
+	if err != nil {
+		return nil, err
+	}
+	if len(ens) != 1 {
+		return nil, errors.New("failed to endorse the conflicting vote")
+	}
+	return rolldpos.NewEndorsedConsensusMessage(msg.Height, conflict, ens[0]).Proto()
+}
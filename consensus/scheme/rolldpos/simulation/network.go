@@ -0,0 +1,290 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+)
+
+type (
+	// Envelope is a message in flight from a node to another
+	Envelope struct {
+		From, To int
+		// Msg is the consensus message, nil if the envelope carries a synced block
+		Msg *iotextypes.ConsensusMessage
+		// Block is the block synced to a lagging node
+		Block *block.Block
+		// DeliverAt is the time the message arrives
+		DeliverAt time.Time
+
+		digest []byte
+	}
+
+	// Fault transforms a message sent, it returns the envelopes to deliver instead, which is empty if the message
+	// is dropped. The random source is derived from the seed and the message, so that the faults are reproducible
+	// regardless of the order the nodes send the messages
+	Fault interface {
+		Apply(*Envelope, *rand.Rand) []*Envelope
+	}
+
+	// FaultFunc is a function implementing Fault
+	FaultFunc func(*Envelope, *rand.Rand) []*Envelope
+
+	// Filter selects the messages a fault applies to
+	Filter func(*Envelope) bool
+
+	// Network is a simulated network delivering the messages on the simulated clock
+	Network struct {
+		seed    int64
+		latency time.Duration
+
+		mutex   sync.Mutex
+		faults  map[int]Fault
+		nextID  int
+		pending []*Envelope
+		sent    int
+	}
+)
+
+// Apply applies the fault function
+func (f FaultFunc) Apply(env *Envelope, rng *rand.Rand) []*Envelope {
+	return f(env, rng)
+}
+
+func newNetwork(seed int64, latency time.Duration) *Network {
+	return &Network{
+		seed:    seed,
+		latency: latency,
+		faults:  make(map[int]Fault),
+	}
+}
+
+// AddFault adds a fault to the network, and returns its ID
+func (n *Network) AddFault(f Fault) int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.nextID++
+	n.faults[n.nextID] = f
+	return n.nextID
+}
+
+// RemoveFault removes the fault of the ID
+func (n *Network) RemoveFault(id int) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	delete(n.faults, id)
+}
+
+// ClearFaults removes all the faults
+func (n *Network) ClearFaults() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.faults = make(map[int]Fault)
+}
+
+// Sent returns the number of messages sent
+func (n *Network) Sent() int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.sent
+}
+
+// send sends the envelope through the faults in the order they are added
+func (n *Network) send(env *Envelope, now time.Time) {
+	env.DeliverAt = now.Add(n.latency)
+	env.digest = digest(env)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.sent++
+	ids := make([]int, 0, len(n.faults))
+	for id := range n.faults {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	envs := []*Envelope{env}
+	for _, id := range ids {
+		next := make([]*Envelope, 0, len(envs))
+		for _, e := range envs {
+			next = append(next, n.faults[id].Apply(e, n.rng(e, id))...)
+		}
+		envs = next
+	}
+	for _, e := range envs {
+		if e.digest == nil {
+			e.digest = digest(e)
+		}
+	}
+	n.pending = append(n.pending, envs...)
+}
+
+// due pops the envelopes to deliver by the time, in a deterministic order
+func (n *Network) due(now time.Time) []*Envelope {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var due, rest []*Envelope
+	for _, e := range n.pending {
+		if e.DeliverAt.After(now) {
+			rest = append(rest, e)
+		} else {
+			due = append(due, e)
+		}
+	}
+	n.pending = rest
+	sort.Slice(due, func(i, j int) bool {
+		a, b := due[i], due[j]
+		switch {
+		case !a.DeliverAt.Equal(b.DeliverAt):
+			return a.DeliverAt.Before(b.DeliverAt)
+		case a.To != b.To:
+			return a.To < b.To
+		case a.From != b.From:
+			return a.From < b.From
+		default:
+			return bytes.Compare(a.digest, b.digest) < 0
+		}
+	})
+	return due
+}
+
+func (n *Network) inFlight(to int, blk bool) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, e := range n.pending {
+		if e.To == to && (e.Block != nil) == blk {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *Network) rng(env *Envelope, faultID int) *rand.Rand {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range []uint64{uint64(n.seed), uint64(faultID), uint64(env.From), uint64(env.To)} {
+		binary.BigEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	h.Write(env.digest)
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+func digest(env *Envelope) []byte {
+	if env.Block != nil {
+		h := env.Block.HashBlock()
+		return h[:]
+	}
+	b, err := proto.Marshal(env.Msg)
+	if err != nil {
+		return nil
+	}
+	h := hash.Hash256b(b)
+	return h[:]
+}
+
+// Drop drops the messages selected at the rate
+func Drop(rate float64, filter Filter) Fault {
+	return FaultFunc(func(env *Envelope, rng *rand.Rand) []*Envelope {
+		if match(filter, env) && rng.Float64() < rate {
+			return nil
+		}
+		return []*Envelope{env}
+	})
+}
+
+// Delay delays the messages selected by the duration
+func Delay(d time.Duration, filter Filter) Fault {
+	return FaultFunc(func(env *Envelope, _ *rand.Rand) []*Envelope {
+		if match(filter, env) {
+			env.DeliverAt = env.DeliverAt.Add(d)
+		}
+		return []*Envelope{env}
+	})
+}
+
+// Reorder delays the messages selected by a random duration up to the jitter, so that they arrive out of order
+func Reorder(jitter time.Duration, filter Filter) Fault {
+	return FaultFunc(func(env *Envelope, rng *rand.Rand) []*Envelope {
+		if match(filter, env) && jitter > 0 {
+			env.DeliverAt = env.DeliverAt.Add(time.Duration(rng.Int63n(int64(jitter))))
+		}
+		return []*Envelope{env}
+	})
+}
+
+// Partition splits the nodes into the groups, the messages between the groups are dropped. A node not in any
+// group is isolated
+func Partition(groups ...[]int) Fault {
+	group := make(map[int]int)
+	for i, nodes := range groups {
+		for _, node := range nodes {
+			group[node] = i
+		}
+	}
+	return FaultFunc(func(env *Envelope, _ *rand.Rand) []*Envelope {
+		from, ok1 := group[env.From]
+		to, ok2 := group[env.To]
+		if !ok1 || !ok2 || from != to {
+			return nil
+		}
+		return []*Envelope{env}
+	})
+}
+
+// FromNodes selects the messages sent by the nodes
+func FromNodes(nodes ...int) Filter {
+	return func(env *Envelope) bool {
+		return contains(nodes, env.From)
+	}
+}
+
+// ToNodes selects the messages sent to the nodes
+func ToNodes(nodes ...int) Filter {
+	return func(env *Envelope) bool {
+		return contains(nodes, env.To)
+	}
+}
+
+// OfType selects the consensus messages of the types, the synced blocks are not selected
+func OfType(types ...iotextypes.ConsensusVote_Topic) Filter {
+	return func(env *Envelope) bool {
+		if env.Msg == nil {
+			return false
+		}
+		vote := env.Msg.GetVote()
+		if vote == nil {
+			return contains(types, BlockProposal)
+		}
+		return contains(types, vote.Topic)
+	}
+}
+
+// BlockProposal is the type of block proposal in OfType
+const BlockProposal iotextypes.ConsensusVote_Topic = -1
+
+func match(filter Filter, env *Envelope) bool {
+	return filter == nil || filter(env)
+}
+
+func contains[T comparable](s []T, v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/roundstatepb"
+)
+
+func newCluster(t *testing.T, cfg Config) *Cluster {
+	c, err := NewCluster(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, c.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, c.Stop(ctx))
+	})
+	return c
+}
+
+func blockHashes(t *testing.T, c *Cluster, node int) []hash.Hash256 {
+	chain := c.Node(node).Chain
+	hashes := make([]hash.Hash256, 0, chain.TipHeight())
+	for h := uint64(1); h <= chain.TipHeight(); h++ {
+		header, err := chain.BlockHeaderByHeight(h)
+		require.NoError(t, err)
+		hashes = append(hashes, header.HashBlock())
+	}
+	return hashes
+}
+
+func TestSimulation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skip the consensus simulation in short mode.")
+	}
+	t.Run("healthy", func(t *testing.T) {
+		require := require.New(t)
+		c := newCluster(t, DefaultConfig)
+		require.True(c.RunUntil(c.ReachHeight(4), 20*time.Second))
+		require.NoError(c.CheckSafety())
+		require.NoError(c.CheckLiveness(4))
+		// each node records its timeline of the rounds
+		proposals := 0
+		for i := 0; i < c.Nodes(); i++ {
+			events, err := c.Node(i).Consensus.RoundState(2)
+			require.NoError(err)
+			count := map[roundstatepb.RoundEventType]int{}
+			for _, ev := range events {
+				require.EqualValues(2, ev.Height)
+				count[ev.Type]++
+			}
+			require.Equal(1, count[roundstatepb.RoundEventType_COMMIT])
+			require.Positive(count[roundstatepb.RoundEventType_TRANSITION])
+			require.Positive(count[roundstatepb.RoundEventType_ENDORSEMENT])
+			proposals += count[roundstatepb.RoundEventType_PROPOSAL]
+		}
+		// the proposal is received by the other nodes
+		require.Equal(c.Nodes()-1, proposals)
+	})
+	t.Run("reproducible", func(t *testing.T) {
+		require := require.New(t)
+		cfg := DefaultConfig
+		cfg.Seed = 7
+		run := func() []hash.Hash256 {
+			c := newCluster(t, cfg)
+			c.Network().AddFault(Reorder(300*time.Millisecond, nil))
+			c.Network().AddFault(Drop(0.1, OfType(iotextypes.ConsensusVote_PROPOSAL)))
+			c.Run(8 * time.Second)
+			require.NoError(c.CheckSafety())
+			return blockHashes(t, c, 0)
+		}
+		first, second := run(), run()
+		require.NotEmpty(first)
+		require.Equal(first, second)
+	})
+	t.Run("partition", func(t *testing.T) {
+		require := require.New(t)
+		c := newCluster(t, DefaultConfig)
+		require.True(c.RunUntil(c.ReachHeight(1), 10*time.Second))
+		// no side has enough delegates to reach the consensus
+		id := c.Network().AddFault(Partition([]int{0, 1}, []int{2, 3}))
+		height := c.Heights()[0]
+		c.Run(10 * time.Second)
+		require.NoError(c.CheckSafety())
+		for _, h := range c.Heights() {
+			require.LessOrEqual(h, height+1)
+		}
+		// the cluster recovers once the partition heals
+		c.Network().RemoveFault(id)
+		require.True(c.RunUntil(c.ReachHeight(height+3), 20*time.Second))
+		require.NoError(c.CheckSafety())
+	})
+	t.Run("isolated", func(t *testing.T) {
+		require := require.New(t)
+		c := newCluster(t, DefaultConfig)
+		// the rest of the delegates keep producing blocks without the isolated one
+		id := c.Network().AddFault(Partition([]int{1, 2, 3}))
+		require.True(c.RunUntil(c.ReachHeight(3, 1, 2, 3), 30*time.Second))
+		require.NoError(c.CheckSafety())
+		require.Zero(c.Node(0).Chain.TipHeight())
+		// and it catches up after rejoining
+		c.Network().RemoveFault(id)
+		require.True(c.RunUntil(c.ReachHeight(c.Heights()[1]), 20*time.Second))
+		require.NoError(c.CheckSafety())
+	})
+	t.Run("lossy", func(t *testing.T) {
+		require := require.New(t)
+		cfg := DefaultConfig
+		cfg.Seed = 3
+		c := newCluster(t, cfg)
+		c.Network().AddFault(Drop(0.05, nil))
+		c.Network().AddFault(Delay(200*time.Millisecond, FromNodes(1)))
+		c.Network().AddFault(Reorder(200*time.Millisecond, ToNodes(2, 3)))
+		require.True(c.RunUntil(c.ReachHeight(3), 30*time.Second))
+		require.NoError(c.CheckSafety())
+	})
+	t.Run("equivocate", func(t *testing.T) {
+		require := require.New(t)
+		c := newCluster(t, DefaultConfig)
+		c.Network().AddFault(c.Equivocate(0))
+		require.True(c.RunUntil(c.ReachHeight(3), 20*time.Second))
+		require.NoError(c.CheckSafety())
+		// the odd-numbered peers receive the conflicting votes and detect the equivocation, the others do not
+		for i := 1; i < c.Nodes(); i++ {
+			evidences := c.Node(i).Consensus.Evidences(0, 10, c.Node(0).Address)
+			if i%2 == 1 {
+				require.NotEmpty(evidences, "node %d", i)
+			} else {
+				require.Empty(evidences, "node %d", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package slashprotect
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+)
+
+// InterchangeVersion is the version of the interchange format
+const InterchangeVersion = "1"
+
+// The interchange format is a JSON document carrying the highest message signed by each key, so that the
+// slashing protection moves with the key from one node to another:
+//
+//	{
+//	  "metadata": {
+//	    "interchangeFormatVersion": "1",
+//	    "chainID": 1
+//	  },
+//	  "data": [
+//	    {
+//	      "address": "io1...",
+//	      "height": 31000000,
+//	      "round": 0,
+//	      "kind": "COMMIT",
+//	      "hash": "hex encoded block hash",
+//	      "fencingToken": 3
+//	    }
+//	  ]
+//	}
+//
+// The kind is one of BLOCK, PROPOSAL, LOCK and COMMIT, which are signed in this order within a round. A node
+// refuses to sign a message lower than the one of its address in the data, or at the same height, round and
+// kind but of another hash. The fencing token is the one of the HA lease the message is signed under, it is
+// omitted if the node does not run in HA mode.
+type (
+	interchange struct {
+		Metadata interchangeMetadata `json:"metadata"`
+		Data     []interchangeEntry  `json:"data"`
+	}
+
+	interchangeMetadata struct {
+		Version string `json:"interchangeFormatVersion"`
+		ChainID uint32 `json:"chainID"`
+	}
+
+	interchangeEntry struct {
+		Address string `json:"address"`
+		Height  uint64 `json:"height"`
+		Round   uint32 `json:"round"`
+		Kind    string `json:"kind"`
+		Hash    string `json:"hash"`
+		Token   uint64 `json:"fencingToken,omitempty"`
+	}
+)
+
+func newInterchangeEntry(addr string, rec Record) interchangeEntry {
+	return interchangeEntry{
+		Address: addr,
+		Height:  rec.Height,
+		Round:   rec.Round,
+		Kind:    rec.Kind.String(),
+		Hash:    hex.EncodeToString(rec.Hash),
+		Token:   rec.Token,
+	}
+}
+
+func (e interchangeEntry) record() (Record, error) {
+	rec := Record{
+		Height: e.Height,
+		Round:  e.Round,
+		Token:  e.Token,
+	}
+	found := false
+	for kind, name := range _kindNames {
+		if name == e.Kind {
+			rec.Kind, found = kind, true
+			break
+		}
+	}
+	if !found {
+		return rec, errors.Errorf("invalid kind %s of %s", e.Kind, e.Address)
+	}
+	hash, err := hex.DecodeString(e.Hash)
+	if err != nil {
+		return rec, errors.Wrapf(err, "invalid hash of %s", e.Address)
+	}
+	rec.Hash = hash
+	return rec, nil
+}
+
+// Export writes the records in the interchange format
+func (p *DB) Export(w io.Writer, chainID uint32) error {
+	records := p.Records()
+	doc := interchange{
+		Metadata: interchangeMetadata{
+			Version: InterchangeVersion,
+			ChainID: chainID,
+		},
+		Data: make([]interchangeEntry, 0, len(records)),
+	}
+	for addr, rec := range records {
+		doc.Data = append(doc.Data, newInterchangeEntry(addr, rec))
+	}
+	sort.Slice(doc.Data, func(i, j int) bool {
+		return doc.Data[i].Address < doc.Data[j].Address
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&doc)
+}
+
+// Import merges the records in the interchange format, keeping the higher record of each address. Nothing is
+// imported if the document is of another chain, or any record conflicts with the one in the db
+func (p *DB) Import(r io.Reader, chainID uint32) error {
+	var doc interchange
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return errors.Wrap(err, "failed to decode the interchange document")
+	}
+	if doc.Metadata.Version != InterchangeVersion {
+		return errors.Errorf("unsupported interchange format version %s", doc.Metadata.Version)
+	}
+	if doc.Metadata.ChainID != chainID {
+		return errors.Errorf("chain ID %d does not match %d", doc.Metadata.ChainID, chainID)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	updates := map[string]Record{}
+	for _, entry := range doc.Data {
+		if _, err := address.FromString(entry.Address); err != nil {
+			return errors.Wrapf(err, "invalid address %s", entry.Address)
+		}
+		rec, err := entry.record()
+		if err != nil {
+			return err
+		}
+		last, ok := updates[entry.Address]
+		if !ok {
+			last, ok = p.records[entry.Address]
+		}
+		if ok {
+			c := rec.compare(last)
+			if c == 0 && !bytes.Equal(rec.Hash, last.Hash) {
+				return errors.Wrapf(ErrSlashable, "conflicting %s of %s at height %d round %d", last.Kind, entry.Address, last.Height, last.Round)
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+		updates[entry.Address] = rec
+	}
+	for addr, rec := range updates {
+		if err := p.put(addr, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
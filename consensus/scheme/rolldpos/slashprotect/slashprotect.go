@@ -0,0 +1,229 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// Package slashprotect keeps the highest consensus message signed by each block producer key, so that a node
+// never signs a message conflicting with or lower than one it has already signed.
+package slashprotect
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+)
+
+const _slashProtectNS = "slp"
+
+// Kind is the kind of a signed consensus message. Within a round, the messages are signed in the order of
+// their kinds
+type Kind uint8
+
+const (
+	// BlockProposal is the proposal of a block
+	BlockProposal Kind = iota
+	// ProposalVote is the vote endorsing a block proposal
+	ProposalVote
+	// LockVote is the vote endorsing a lock on a proposed block
+	LockVote
+	// CommitVote is the vote endorsing a block commit
+	CommitVote
+)
+
+var (
+	// ErrSlashable indicates that the message conflicts with or is lower than the one signed before
+	ErrSlashable = errors.New("slashable message")
+	// ErrFenced indicates that the node does not hold the lease to sign
+	ErrFenced = errors.New("not allowed to sign without the lease")
+
+	_kindNames = map[Kind]string{
+		BlockProposal: "BLOCK",
+		ProposalVote:  "PROPOSAL",
+		LockVote:      "LOCK",
+		CommitVote:    "COMMIT",
+	}
+)
+
+// String returns the name of the kind used in the interchange format
+func (k Kind) String() string {
+	if name, ok := _kindNames[k]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+type (
+	// Record is a signed consensus message
+	Record struct {
+		Height uint64
+		Round  uint32
+		Kind   Kind
+		Hash   []byte
+		// Token is the fencing token of the lease the message is signed under, 0 if no lease is required
+		Token uint64
+	}
+
+	// DB records the highest message signed by each key. The records are persisted in the kv store if it is
+	// not nil, and only kept in memory otherwise
+	DB struct {
+		mutex   sync.Mutex
+		kvStore db.KVStore
+		records map[string]Record
+		fence   func(time.Time) (uint64, error)
+	}
+)
+
+// compare compares the positions of two records in the signing order
+func (r Record) compare(o Record) int {
+	switch {
+	case r.Height != o.Height:
+		return cmp(r.Height < o.Height)
+	case r.Round != o.Round:
+		return cmp(r.Round < o.Round)
+	case r.Kind != o.Kind:
+		return cmp(r.Kind < o.Kind)
+	default:
+		return 0
+	}
+}
+
+func cmp(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
+
+// New creates a slashing protection db
+func New(kvStore db.KVStore) *DB {
+	return &DB{
+		kvStore: kvStore,
+		records: map[string]Record{},
+	}
+}
+
+// Load loads the records persisted in the kv store
+func (p *DB) Load() error {
+	if p.kvStore == nil {
+		return nil
+	}
+	keys, values, err := p.kvStore.Filter(_slashProtectNS, func(k, v []byte) bool { return true }, nil, nil)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return nil
+		}
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for i := range keys {
+		var entry interchangeEntry
+		if err := json.Unmarshal(values[i], &entry); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal the record of %s", keys[i])
+		}
+		rec, err := entry.record()
+		if err != nil {
+			return err
+		}
+		p.records[string(keys[i])] = rec
+	}
+	return nil
+}
+
+// SetFence sets the function returning the fencing token of the lease the node holds to sign in the round
+// starting at the time, the node signs only if it holds the lease, with a token not lower than the one of the
+// last message signed
+func (p *DB) SetFence(fence func(time.Time) (uint64, error)) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.fence = fence
+}
+
+// Check returns ErrSlashable if a message of the kind at the height and round can no longer be signed by the
+// address, regardless of its hash. The round starts at the time
+func (p *DB) Check(addr string, height uint64, round uint32, kind Kind, roundStart time.Time) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	last, ok := p.records[addr]
+	if _, err := p.token(last, roundStart); err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	if (Record{Height: height, Round: round, Kind: kind}).compare(last) < 0 {
+		return errors.Wrapf(ErrSlashable, "%s has signed %s at height %d round %d", addr, last.Kind, last.Height, last.Round)
+	}
+	return nil
+}
+
+// Approve records the message to be signed by the address in the round starting at the time. It returns
+// ErrSlashable if the message is lower than the last one signed, or at the same position but of another hash
+func (p *DB) Approve(addr string, rec Record, roundStart time.Time) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	last, ok := p.records[addr]
+	token, err := p.token(last, roundStart)
+	if err != nil {
+		return err
+	}
+	if ok {
+		switch rec.compare(last) {
+		case -1:
+			return errors.Wrapf(ErrSlashable, "%s has signed %s at height %d round %d", addr, last.Kind, last.Height, last.Round)
+		case 0:
+			if !bytes.Equal(rec.Hash, last.Hash) {
+				return errors.Wrapf(ErrSlashable, "%s has signed another %s at height %d round %d", addr, last.Kind, last.Height, last.Round)
+			}
+			return nil
+		}
+	}
+	rec.Token = token
+	return p.put(addr, rec)
+}
+
+// token returns the fencing token to sign with, it fails if the node does not hold the lease, the round started
+// before the node took it over, or the lease is older than the one the last message is signed under
+func (p *DB) token(last Record, roundStart time.Time) (uint64, error) {
+	if p.fence == nil {
+		return 0, nil
+	}
+	token, err := p.fence(roundStart)
+	if err != nil {
+		return 0, errors.Wrap(ErrFenced, err.Error())
+	}
+	if token < last.Token {
+		return 0, errors.Wrapf(ErrFenced, "fencing token %d is lower than %d", token, last.Token)
+	}
+	return token, nil
+}
+
+// Records returns the highest message signed by each address
+func (p *DB) Records() map[string]Record {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	records := make(map[string]Record, len(p.records))
+	for addr, rec := range p.records {
+		records[addr] = rec
+	}
+	return records
+}
+
+func (p *DB) put(addr string, rec Record) error {
+	if p.kvStore != nil {
+		value, err := json.Marshal(newInterchangeEntry(addr, rec))
+		if err != nil {
+			return err
+		}
+		if err := p.kvStore.Put(_slashProtectNS, []byte(addr), value); err != nil {
+			return errors.Wrapf(err, "failed to persist the record of %s", addr)
+		}
+	}
+	p.records[addr] = rec
+	return nil
+}
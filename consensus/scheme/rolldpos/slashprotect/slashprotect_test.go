@@ -0,0 +1,148 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package slashprotect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+func TestApprove(t *testing.T) {
+	require := require.New(t)
+	p := New(nil)
+	addr := identityset.Address(1).String()
+
+	require.NoError(p.Check(addr, 10, 1, BlockProposal, time.Time{}))
+	require.NoError(p.Approve(addr, Record{Height: 10, Round: 1, Kind: BlockProposal, Hash: []byte("a")}, time.Time{}))
+	// signing the same message again is allowed
+	require.NoError(p.Approve(addr, Record{Height: 10, Round: 1, Kind: BlockProposal, Hash: []byte("a")}, time.Time{}))
+	require.NoError(p.Check(addr, 10, 1, BlockProposal, time.Time{}))
+	// a conflicting message is refused
+	require.ErrorIs(p.Approve(addr, Record{Height: 10, Round: 1, Kind: BlockProposal, Hash: []byte("b")}, time.Time{}), ErrSlashable)
+	require.NoError(p.Approve(addr, Record{Height: 10, Round: 1, Kind: ProposalVote, Hash: []byte("a")}, time.Time{}))
+	require.NoError(p.Approve(addr, Record{Height: 10, Round: 1, Kind: CommitVote, Hash: []byte("a")}, time.Time{}))
+	// so are the lower ones
+	require.ErrorIs(p.Approve(addr, Record{Height: 10, Round: 1, Kind: LockVote, Hash: []byte("a")}, time.Time{}), ErrSlashable)
+	require.ErrorIs(p.Approve(addr, Record{Height: 10, Round: 0, Kind: CommitVote, Hash: []byte("b")}, time.Time{}), ErrSlashable)
+	require.ErrorIs(p.Check(addr, 9, 5, BlockProposal, time.Time{}), ErrSlashable)
+	require.NoError(p.Approve(addr, Record{Height: 10, Round: 2, Kind: ProposalVote}, time.Time{}))
+	require.NoError(p.Approve(addr, Record{Height: 11, Round: 0, Kind: BlockProposal, Hash: []byte("c")}, time.Time{}))
+	// the addresses are protected separately
+	require.NoError(p.Approve(identityset.Address(2).String(), Record{Height: 1, Kind: ProposalVote}, time.Time{}))
+}
+
+func TestFence(t *testing.T) {
+	require := require.New(t)
+	p := New(nil)
+	addr := identityset.Address(1).String()
+	var (
+		token    uint64
+		err      error
+		takeover = time.Unix(1000, 0)
+		before   = takeover.Add(-time.Second)
+		after    = takeover.Add(time.Second)
+	)
+	p.SetFence(func(roundStart time.Time) (uint64, error) {
+		if err == nil && roundStart.Before(takeover) {
+			return 0, errors.New("takeover round")
+		}
+		return token, err
+	})
+
+	token = 2
+	require.NoError(p.Approve(addr, Record{Height: 10, Kind: BlockProposal, Hash: []byte("a")}, after))
+	require.Equal(uint64(2), p.Records()[addr].Token)
+	// the node not holding the lease does not sign
+	err = errors.New("not leader")
+	require.ErrorIs(p.Check(addr, 11, 0, BlockProposal, after), ErrFenced)
+	require.ErrorIs(p.Approve(addr, Record{Height: 11, Kind: BlockProposal, Hash: []byte("b")}, after), ErrFenced)
+	// neither does the one holding a stale lease
+	token, err = 1, nil
+	require.ErrorIs(p.Check(addr, 11, 0, BlockProposal, after), ErrFenced)
+	require.ErrorIs(p.Approve(addr, Record{Height: 11, Kind: BlockProposal, Hash: []byte("b")}, after), ErrFenced)
+	// nor in the round started before the lease was taken over
+	token = 3
+	require.ErrorIs(p.Check(addr, 11, 0, BlockProposal, before), ErrFenced)
+	require.ErrorIs(p.Approve(addr, Record{Height: 11, Kind: BlockProposal, Hash: []byte("b")}, before), ErrFenced)
+	require.NoError(p.Approve(addr, Record{Height: 11, Round: 1, Kind: BlockProposal, Hash: []byte("b")}, after))
+	require.Equal(uint64(3), p.Records()[addr].Token)
+}
+
+func TestPersistence(t *testing.T) {
+	require := require.New(t)
+	path, err := testutil.PathOfTempFile("consensus.db")
+	require.NoError(err)
+	defer testutil.CleanupPath(path)
+	cfg := db.DefaultConfig
+	cfg.DbPath = path
+	kvStore := db.NewBoltDB(cfg)
+	ctx := context.Background()
+	require.NoError(kvStore.Start(ctx))
+	defer kvStore.Stop(ctx)
+
+	p := New(kvStore)
+	require.NoError(p.Load())
+	addr := identityset.Address(1).String()
+	rec := Record{Height: 10, Round: 1, Kind: LockVote, Hash: []byte("a")}
+	require.NoError(p.Approve(addr, rec, time.Time{}))
+
+	p = New(kvStore)
+	require.NoError(p.Load())
+	require.Equal(map[string]Record{addr: rec}, p.Records())
+	require.ErrorIs(p.Approve(addr, Record{Height: 10, Round: 1, Kind: ProposalVote}, time.Time{}), ErrSlashable)
+}
+
+func TestInterchange(t *testing.T) {
+	require := require.New(t)
+	addr1, addr2 := identityset.Address(1).String(), identityset.Address(2).String()
+	src := New(nil)
+	require.NoError(src.Approve(addr1, Record{Height: 10, Round: 1, Kind: CommitVote, Hash: []byte("a")}, time.Time{}))
+	require.NoError(src.Approve(addr2, Record{Height: 8, Kind: BlockProposal, Hash: []byte("b")}, time.Time{}))
+	var buf bytes.Buffer
+	require.NoError(src.Export(&buf, 1))
+	doc := buf.String()
+	require.Contains(doc, `"interchangeFormatVersion": "1"`)
+	require.Contains(doc, `"kind": "COMMIT"`)
+
+	// the document of another chain is rejected
+	require.Error(New(nil).Import(strings.NewReader(doc), 2))
+
+	dst := New(nil)
+	require.NoError(dst.Approve(addr1, Record{Height: 12, Kind: ProposalVote}, time.Time{}))
+	require.NoError(dst.Approve(addr2, Record{Height: 7, Kind: BlockProposal}, time.Time{}))
+	require.NoError(dst.Import(strings.NewReader(doc), 1))
+	// the higher record is kept
+	require.Equal(map[string]Record{
+		addr1: {Height: 12, Kind: ProposalVote, Hash: []byte{}},
+		addr2: {Height: 8, Kind: BlockProposal, Hash: []byte("b")},
+	}, normalize(dst.Records()))
+
+	// nothing is imported if any record conflicts
+	conflict := New(nil)
+	require.NoError(conflict.Approve(addr2, Record{Height: 8, Kind: BlockProposal, Hash: []byte("c")}, time.Time{}))
+	require.ErrorIs(conflict.Import(strings.NewReader(doc), 1), ErrSlashable)
+	require.Len(conflict.Records(), 1)
+}
+
+func normalize(records map[string]Record) map[string]Record {
+	for addr, rec := range records {
+		if rec.Hash == nil {
+			rec.Hash = []byte{}
+		}
+		records[addr] = rec
+	}
+	return records
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// Package bls implements the BLS signature over BLS12-381, with the public keys in G1 and the signatures in G2. The
+// signatures of the same message can be aggregated into one and verified against the keys at the cost of one
+// pairing check. Rogue key attacks are prevented by the proof of possession, which must be verified before a key
+// is used for the aggregate verification
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PrivateKeySize is the size of the serialized private key
+	PrivateKeySize = fr.Bytes
+	// PublicKeySize is the size of the compressed public key
+	PublicKeySize = bls12381.SizeOfG1AffineCompressed
+	// SignatureSize is the size of the compressed signature
+	SignatureSize = bls12381.SizeOfG2AffineCompressed
+)
+
+var (
+	// ErrInvalidKey indicates the key is not valid
+	ErrInvalidKey = errors.New("invalid BLS key")
+	// ErrInvalidSignature indicates the signature is not valid
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+
+	// the domain separation tags of the proof of possession scheme
+	_dstSignature = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	_dstPoP       = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	_g1Gen    bls12381.G1Affine
+	_g1GenNeg bls12381.G1Affine
+)
+
+func init() {
+	_, _, _g1Gen, _ = bls12381.Generators()
+	_g1GenNeg.Neg(&_g1Gen)
+}
+
+type (
+	// PrivateKey is the BLS private key
+	PrivateKey struct {
+		sk fr.Element
+	}
+
+	// PublicKey is the BLS public key
+	PublicKey struct {
+		pk bls12381.G1Affine
+	}
+
+	// Signature is the BLS signature
+	Signature struct {
+		sig bls12381.G2Affine
+	}
+)
+
+// GenerateKey generates a random private key
+func GenerateKey() (*PrivateKey, error) {
+	sk := &PrivateKey{}
+	for sk.sk.IsZero() {
+		if _, err := sk.sk.SetRandom(); err != nil {
+			return nil, errors.Wrap(err, "failed to generate BLS private key")
+		}
+	}
+	return sk, nil
+}
+
+// BytesToPrivateKey converts the bytes to the private key
+func BytesToPrivateKey(b []byte) (*PrivateKey, error) {
+	if len(b) != PrivateKeySize {
+		return nil, errors.Wrapf(ErrInvalidKey, "invalid private key size %d", len(b))
+	}
+	sk := &PrivateKey{}
+	if err := sk.sk.SetBytesCanonical(b); err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	if sk.sk.IsZero() {
+		return nil, errors.Wrap(ErrInvalidKey, "private key is zero")
+	}
+	return sk, nil
+}
+
+// HexStringToPrivateKey converts the hex string to the private key
+func HexStringToPrivateKey(s string) (*PrivateKey, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	return BytesToPrivateKey(b)
+}
+
+// Bytes returns the bytes of the private key
+func (sk *PrivateKey) Bytes() []byte {
+	b := sk.sk.Bytes()
+	return b[:]
+}
+
+// HexString returns the hex string of the private key
+func (sk *PrivateKey) HexString() string {
+	return hex.EncodeToString(sk.Bytes())
+}
+
+// PublicKey returns the public key of the private key
+func (sk *PrivateKey) PublicKey() *PublicKey {
+	pk := &PublicKey{}
+	pk.pk.ScalarMultiplicationBase(sk.sk.BigInt(new(big.Int)))
+	return pk
+}
+
+// Sign signs the message
+func (sk *PrivateKey) Sign(msg []byte) (*Signature, error) {
+	return sk.sign(msg, _dstSignature)
+}
+
+// ProofOfPossession returns the signature of the public key followed by msg, which proves the possession of the
+// private key. msg binds the proof to whom registers the key, so that the proof cannot be replayed by others
+func (sk *PrivateKey) ProofOfPossession(msg []byte) (*Signature, error) {
+	return sk.sign(popMessage(sk.PublicKey(), msg), _dstPoP)
+}
+
+func (sk *PrivateKey) sign(msg, dst []byte) (*Signature, error) {
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash the message")
+	}
+	sig := &Signature{}
+	sig.sig.ScalarMultiplication(&h, sk.sk.BigInt(new(big.Int)))
+	return sig, nil
+}
+
+// BytesToPublicKey converts the compressed bytes to the public key
+func BytesToPublicKey(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeySize {
+		return nil, errors.Wrapf(ErrInvalidKey, "invalid public key size %d", len(b))
+	}
+	pk := &PublicKey{}
+	// the point is checked to be on the curve and in the subgroup
+	if _, err := pk.pk.SetBytes(b); err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	if pk.pk.IsInfinity() {
+		return nil, errors.Wrap(ErrInvalidKey, "public key is the point at infinity")
+	}
+	return pk, nil
+}
+
+// Bytes returns the compressed bytes of the public key
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.pk.Bytes()
+	return b[:]
+}
+
+// HexString returns the hex string of the public key
+func (pk *PublicKey) HexString() string {
+	return hex.EncodeToString(pk.Bytes())
+}
+
+// Equal checks whether the public keys are the same
+func (pk *PublicKey) Equal(other *PublicKey) bool {
+	return other != nil && pk.pk.Equal(&other.pk)
+}
+
+// Verify checks the signature of the message
+func (pk *PublicKey) Verify(msg []byte, sig *Signature) bool {
+	return verify(&pk.pk, msg, _dstSignature, sig)
+}
+
+// VerifyProofOfPossession checks the proof of possession of the public key signed with msg
+func (pk *PublicKey) VerifyProofOfPossession(msg []byte, pop *Signature) bool {
+	return verify(&pk.pk, popMessage(pk, msg), _dstPoP, pop)
+}
+
+func popMessage(pk *PublicKey, msg []byte) []byte {
+	return append(pk.Bytes(), msg...)
+}
+
+// BytesToSignature converts the compressed bytes to the signature
+func BytesToSignature(b []byte) (*Signature, error) {
+	if len(b) != SignatureSize {
+		return nil, errors.Wrapf(ErrInvalidSignature, "invalid signature size %d", len(b))
+	}
+	sig := &Signature{}
+	if _, err := sig.sig.SetBytes(b); err != nil {
+		return nil, errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return sig, nil
+}
+
+// Bytes returns the compressed bytes of the signature
+func (sig *Signature) Bytes() []byte {
+	b := sig.sig.Bytes()
+	return b[:]
+}
+
+// Aggregate aggregates the signatures into one
+func Aggregate(sigs ...*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.Wrap(ErrInvalidSignature, "no signature to aggregate")
+	}
+	var agg bls12381.G2Jac
+	agg.FromAffine(&sigs[0].sig)
+	for _, sig := range sigs[1:] {
+		agg.AddMixed(&sig.sig)
+	}
+	res := &Signature{}
+	res.sig.FromJacobian(&agg)
+	return res, nil
+}
+
+// FastAggregateVerify checks the aggregate signature of the same message signed by the public keys, the proofs of
+// possession of the keys must have been verified
+func FastAggregateVerify(pks []*PublicKey, msg []byte, sig *Signature) bool {
+	if len(pks) == 0 {
+		return false
+	}
+	var agg bls12381.G1Jac
+	agg.FromAffine(&pks[0].pk)
+	for _, pk := range pks[1:] {
+		agg.AddMixed(&pk.pk)
+	}
+	var aggPK bls12381.G1Affine
+	aggPK.FromJacobian(&agg)
+	return verify(&aggPK, msg, _dstSignature, sig)
+}
+
+// verify checks e(pk, H(msg)) == e(g1, sig)
+func verify(pk *bls12381.G1Affine, msg, dst []byte, sig *Signature) bool {
+	if sig == nil || pk.IsInfinity() {
+		return false
+	}
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+	ok, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{*pk, _g1GenNeg},
+		[]bls12381.G2Affine{h, sig.sig},
+	)
+	return err == nil && ok
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLS(t *testing.T) {
+	msg := []byte("block hash")
+
+	t.Run("key", func(t *testing.T) {
+		require := require.New(t)
+		sk, err := GenerateKey()
+		require.NoError(err)
+		sk2, err := HexStringToPrivateKey(sk.HexString())
+		require.NoError(err)
+		require.Equal(sk.Bytes(), sk2.Bytes())
+		pk, err := BytesToPublicKey(sk.PublicKey().Bytes())
+		require.NoError(err)
+		require.True(pk.Equal(sk2.PublicKey()))
+		require.Len(pk.Bytes(), PublicKeySize)
+
+		_, err = BytesToPrivateKey(make([]byte, PrivateKeySize))
+		require.ErrorIs(err, ErrInvalidKey)
+		_, err = BytesToPublicKey(pk.Bytes()[1:])
+		require.ErrorIs(err, ErrInvalidKey)
+		// the point at infinity
+		inf := make([]byte, PublicKeySize)
+		inf[0] = 0xc0
+		_, err = BytesToPublicKey(inf)
+		require.ErrorIs(err, ErrInvalidKey)
+	})
+
+	t.Run("sign", func(t *testing.T) {
+		require := require.New(t)
+		sk, err := GenerateKey()
+		require.NoError(err)
+		sig, err := sk.Sign(msg)
+		require.NoError(err)
+		require.Len(sig.Bytes(), SignatureSize)
+		sig, err = BytesToSignature(sig.Bytes())
+		require.NoError(err)
+		require.True(sk.PublicKey().Verify(msg, sig))
+		require.False(sk.PublicKey().Verify([]byte("another hash"), sig))
+		other, err := GenerateKey()
+		require.NoError(err)
+		require.False(other.PublicKey().Verify(msg, sig))
+
+		// the proof of possession is not a valid signature of the key and vice versa
+		pop, err := sk.ProofOfPossession(msg)
+		require.NoError(err)
+		require.True(sk.PublicKey().VerifyProofOfPossession(msg, pop))
+		require.False(sk.PublicKey().Verify(append(sk.PublicKey().Bytes(), msg...), pop))
+		require.False(other.PublicKey().VerifyProofOfPossession(msg, pop))
+		sig, err = sk.Sign(append(sk.PublicKey().Bytes(), msg...))
+		require.NoError(err)
+		require.False(sk.PublicKey().VerifyProofOfPossession(msg, sig))
+		// the proof is bound to the message
+		require.False(sk.PublicKey().VerifyProofOfPossession([]byte("another hash"), pop))
+		require.False(sk.PublicKey().VerifyProofOfPossession(nil, pop))
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		require := require.New(t)
+		var (
+			pks  []*PublicKey
+			sigs []*Signature
+		)
+		for i := 0; i < 4; i++ {
+			sk, err := GenerateKey()
+			require.NoError(err)
+			sig, err := sk.Sign(msg)
+			require.NoError(err)
+			pks = append(pks, sk.PublicKey())
+			sigs = append(sigs, sig)
+		}
+		agg, err := Aggregate(sigs...)
+		require.NoError(err)
+		require.True(FastAggregateVerify(pks, msg, agg))
+		require.False(FastAggregateVerify(pks[1:], msg, agg))
+		require.False(FastAggregateVerify(pks, []byte("another hash"), agg))
+		require.False(FastAggregateVerify(nil, msg, agg))
+		agg, err = Aggregate(sigs[:1]...)
+		require.NoError(err)
+		require.True(FastAggregateVerify(pks[:1], msg, agg))
+		_, err = Aggregate()
+		require.ErrorIs(err, ErrInvalidSignature)
+	})
+}
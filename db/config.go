@@ -5,6 +5,12 @@
 
 package db
 
+import (
+	"time"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/objectstore"
+)
+
 // Config is the config for database
 type Config struct {
 	DbPath string `yaml:"dbPath"`
@@ -17,6 +23,9 @@ type Config struct {
 	// V2BlocksToSplitDB is the accumulated number of blocks to split a new file after v1.1.2
 	V2BlocksToSplitDB uint64 `yaml:"v2BlocksToSplitDB"`
 	// Compressor is the compression used on block data, used by new DB file after v1.1.2
+	// Supported values are Gzip, Snappy, Zstd and ZstdDict (zstd with a dictionary trained
+	// on the first BlockStoreBatchSize blocks of the file). Existing files keep the
+	// compressor recorded in their header.
 	Compressor string `yaml:"compressor"`
 	// CompressLegacy enables gzip compression on block data, used by legacy DB file before v1.1.2
 	CompressLegacy bool `yaml:"compressLegacy"`
@@ -24,12 +33,30 @@ type Config struct {
 	SplitDBSizeMB uint64 `yaml:"splitDBSizeMB"`
 	// SplitDBHeight is the config for DB's split start height
 	SplitDBHeight uint64 `yaml:"splitDBHeight"`
-	// HistoryStateRetention is the number of blocks account/contract state will be retained
+	// HistoryStateRetention is the number of blocks account/contract state will be retained, 0 means no pruning
 	HistoryStateRetention uint64 `yaml:"historyStateRetention"`
 	// ReadOnly is set db to be opened in read only mode
 	ReadOnly bool `yaml:"readOnly"`
 	// DBType is the type of database
 	DBType string `yaml:"dbType"`
+	// TrieNodeCacheSizeMB is the memory budget of the trie node cache shared by all tries, 0 means disabled
+	TrieNodeCacheSizeMB uint64 `yaml:"trieNodeCacheSizeMB"`
+	// BlockOffload is the config to offload sealed v2 chain db files to object storage
+	BlockOffload BlockOffloadConfig `yaml:"blockOffload"`
+}
+
+// BlockOffloadConfig is the config to offload sealed v2 chain db files to object storage
+type BlockOffloadConfig struct {
+	// Height is the height below which sealed v2 files are offloaded, 0 means disabled
+	Height uint64 `yaml:"height"`
+	// CacheDir is the directory to keep offloaded files downloaded on demand
+	CacheDir string `yaml:"cacheDir"`
+	// CacheSize is the max number of offloaded files kept in CacheDir
+	CacheSize int `yaml:"cacheSize"`
+	// Interval is the interval to offload the files sealed while running
+	Interval time.Duration `yaml:"interval"`
+	// Store is the config of the S3-compatible object storage
+	Store objectstore.Config `yaml:"store"`
 }
 
 // Database types
@@ -43,6 +70,11 @@ func (cfg Config) SplitDBSize() uint64 {
 	return cfg.SplitDBSizeMB * 1024 * 1024
 }
 
+// TrieNodeCacheSize returns the configured TrieNodeCacheSizeMB in bytes
+func (cfg Config) TrieNodeCacheSize() uint64 {
+	return cfg.TrieNodeCacheSizeMB * 1024 * 1024
+}
+
 // DefaultConfig returns the default config
 var DefaultConfig = Config{
 	NumRetries:            3,
@@ -53,6 +85,12 @@ var DefaultConfig = Config{
 	CompressLegacy:        false,
 	SplitDBSizeMB:         0,
 	SplitDBHeight:         900000,
-	HistoryStateRetention: 2000,
+	HistoryStateRetention: 0,
 	DBType:                DBBolt,
+	TrieNodeCacheSizeMB:   64,
+	BlockOffload: BlockOffloadConfig{
+		CacheSize: 2,
+		Interval:  10 * time.Minute,
+		Store:     objectstore.DefaultConfig,
+	},
 }
@@ -416,6 +416,34 @@ func writeVersionedEntry(version uint64, bucket *bolt.Bucket, ve *batch.WriteInf
 // 2. splits entries into 2 slices according to the input namespace map
 // 3. return a map of input namespace's keyLength
 func dedup(vns map[string]int, kvsb batch.KVStoreBatch) ([]*batch.WriteInfo, []*batch.WriteInfo, error) {
+	var (
+		nsKeyLen = make(map[string]int)
+		pickAll  = len(vns) == 0
+	)
+	return dedupBy(kvsb, func(ns string, key []byte) (bool, error) {
+		if pickAll {
+			if n, ok := nsKeyLen[ns]; !ok {
+				nsKeyLen[ns] = len(key)
+			} else if n != len(key) {
+				return false, errors.Wrapf(ErrInvalid, "invalid key length, expecting %d, got %d", n, len(key))
+			}
+			return true, nil
+		}
+		keyLen := vns[ns]
+		if keyLen == 0 {
+			return false, nil
+		}
+		// verify key size
+		if keyLen != len(key) {
+			return false, errors.Wrapf(ErrInvalid, "invalid key length, expecting %d, got %d", keyLen, len(key))
+		}
+		return true, nil
+	})
+}
+
+// dedupBy deduplicates entries in the batch, and splits them into versioned and
+// non-versioned entries per the versioned() check
+func dedupBy(kvsb batch.KVStoreBatch, versioned func(string, []byte) (bool, error)) ([]*batch.WriteInfo, []*batch.WriteInfo, error) {
 	kvsb.Lock()
 	defer kvsb.Unlock()
 
@@ -426,10 +454,8 @@ func dedup(vns map[string]int, kvsb batch.KVStoreBatch) ([]*batch.WriteInfo, []*
 
 	var (
 		entryKeySet = make(map[doubleKey]bool)
-		nsKeyLen    = make(map[string]int)
 		nsInMap     = make([]*batch.WriteInfo, 0)
 		other       = make([]*batch.WriteInfo, 0)
-		pickAll     = len(vns) == 0
 	)
 	for i := kvsb.Size() - 1; i >= 0; i-- {
 		write, e := kvsb.Entry(i)
@@ -454,20 +480,11 @@ func dedup(vns map[string]int, kvsb batch.KVStoreBatch) ([]*batch.WriteInfo, []*
 			// otherwise, the DELETE might return not-exist
 			entryKeySet[k] = true
 		}
-		if pickAll {
-			if n, ok := nsKeyLen[k.ns]; !ok {
-				nsKeyLen[k.ns] = len(write.Key())
-			} else {
-				if n != len(write.Key()) {
-					return nil, nil, errors.Wrapf(ErrInvalid, "invalid key length, expecting %d, got %d", n, len(write.Key()))
-				}
-			}
-			nsInMap = append(nsInMap, write)
-		} else if keyLen := vns[k.ns]; keyLen > 0 {
-			// verify key size
-			if keyLen != len(write.Key()) {
-				return nil, nil, errors.Wrapf(ErrInvalid, "invalid key length, expecting %d, got %d", keyLen, len(write.Key()))
-			}
+		isVersioned, err := versioned(ns, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isVersioned {
 			nsInMap = append(nsInMap, write)
 		} else {
 			other = append(other, write)
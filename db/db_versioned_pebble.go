@@ -0,0 +1,789 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/routine"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+const (
+	// _versionedMetaNS is the namespace storing the metadata of PebbleDBVersioned
+	_versionedMetaNS = "VersionedMeta"
+	// _defaultPruneInterval is the default interval to run the history pruner
+	_defaultPruneInterval = time.Minute
+	// _pruneBatchSize is the max number of deletes committed in one pruning batch
+	_pruneBatchSize = 10000
+)
+
+var (
+	_tipVersionKey    = []byte("tip")
+	_prunedVersionKey = []byte("pruned")
+	// _namespaceKeyPrefix prefixes the namespaces versioned by PebbleVersionAllOption
+	_namespaceKeyPrefix = []byte("ns.")
+
+	// ErrPruned indicates the requested version has been pruned
+	ErrPruned = errors.New("version has been pruned")
+)
+
+type (
+	// PebbleDBVersioned is VersionedDB implementation based on pebble DB
+	//
+	// Each version of a key is stored at (key + 8-byte big-endian version + 1-byte
+	// flag), where flag = 1 is a write and flag = 0 is a delete marker. Reading a
+	// key at a certain version is a reverse seek to the largest entry not greater
+	// than (key + version + 1).
+	//
+	// With PebbleVersionAllOption, every namespace is versioned and its keys can
+	// be of any length. Such a key is prefixed with its 2-byte big-endian length,
+	// so that no key is the prefix of another one
+	//
+	// If HistoryStateRetention is non-zero, a background pruner periodically
+	// removes the versions that are no longer visible to a read at or above
+	// (tip - HistoryStateRetention)
+	PebbleDBVersioned struct {
+		db            *PebbleDB
+		vns           map[string]int // map of versioned namespace
+		versionAll    bool
+		retention     uint64
+		pruneInterval time.Duration
+		pruner        *routine.RecurringTask
+		mutex         sync.RWMutex        // guards tip, pruned and namespaces
+		tip           uint64              // the highest version written so far
+		pruned        uint64              // versions below this have been pruned
+		namespaces    map[string]struct{} // namespaces versioned by versionAll
+	}
+
+	// PebbleDBVersionedOption sets option for PebbleDBVersioned
+	PebbleDBVersionedOption func(*PebbleDBVersioned)
+
+	pebbleReader interface {
+		NewIter(*pebble.IterOptions) (*pebble.Iterator, error)
+	}
+)
+
+// PebbleVnsOption sets the versioned namespaces
+func PebbleVnsOption(ns ...Namespace) PebbleDBVersionedOption {
+	return func(k *PebbleDBVersioned) {
+		for _, v := range ns {
+			k.vns[v.ns] = int(v.keyLen)
+		}
+	}
+}
+
+// PebbleVersionAllOption versions every namespace, the namespaces not set by
+// PebbleVnsOption accept keys of any length
+func PebbleVersionAllOption() PebbleDBVersionedOption {
+	return func(k *PebbleDBVersioned) {
+		k.versionAll = true
+	}
+}
+
+// PruneIntervalOption sets the interval of the history pruner
+func PruneIntervalOption(d time.Duration) PebbleDBVersionedOption {
+	return func(k *PebbleDBVersioned) {
+		k.pruneInterval = d
+	}
+}
+
+// NewPebbleDBVersioned instantiates a PebbleDB which implements VersionedDB
+func NewPebbleDBVersioned(cfg Config, opts ...PebbleDBVersionedOption) *PebbleDBVersioned {
+	b := PebbleDBVersioned{
+		db:            NewPebbleDB(cfg),
+		vns:           make(map[string]int),
+		namespaces:    make(map[string]struct{}),
+		retention:     cfg.HistoryStateRetention,
+		pruneInterval: _defaultPruneInterval,
+	}
+	for _, opt := range opts {
+		opt(&b)
+	}
+	return &b
+}
+
+// Start starts the DB
+func (b *PebbleDBVersioned) Start(ctx context.Context) error {
+	if err := b.db.Start(ctx); err != nil {
+		return err
+	}
+	if err := b.loadMeta(); err != nil {
+		return err
+	}
+	if !b.db.config.ReadOnly {
+		if err := b.addVersionedNamespace(); err != nil {
+			return err
+		}
+	}
+	if b.retention > 0 && !b.db.config.ReadOnly {
+		b.pruner = routine.NewRecurringTask(func() {
+			if err := b.Prune(); err != nil {
+				log.L().Error("failed to prune history versions", zap.Error(err))
+			}
+		}, b.pruneInterval)
+		return b.pruner.Start(ctx)
+	}
+	return nil
+}
+
+// Stop stops the DB
+func (b *PebbleDBVersioned) Stop(ctx context.Context) error {
+	if b.pruner != nil {
+		if err := b.pruner.Stop(ctx); err != nil {
+			return err
+		}
+	}
+	return b.db.Stop(ctx)
+}
+
+func (b *PebbleDBVersioned) loadMeta() error {
+	for _, v := range []struct {
+		key   []byte
+		value *uint64
+	}{
+		{_tipVersionKey, &b.tip},
+		{_prunedVersionKey, &b.pruned},
+	} {
+		data, err := b.db.Get(_versionedMetaNS, v.key)
+		switch errors.Cause(err) {
+		case nil:
+			*v.value = byteutil.BytesToUint64BigEndian(data)
+		case ErrNotExist:
+		default:
+			return err
+		}
+	}
+	return b.loadNamespaces()
+}
+
+func (b *PebbleDBVersioned) loadNamespaces() error {
+	prefix := nsKey(_versionedMetaNS, _namespaceKeyPrefix)
+	iter, err := b.db.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create iterator")
+	}
+	defer func() {
+		if e := iter.Close(); e != nil {
+			log.L().Error("Failed to close iterator", zap.Error(e))
+		}
+	}()
+	for iter.First(); iter.Valid(); iter.Next() {
+		b.namespaces[string(iter.Key()[len(prefix):])] = struct{}{}
+	}
+	return nil
+}
+
+// registerNamespace records a namespace versioned by PebbleVersionAllOption,
+// so that the pruner can find it after restart
+func (b *PebbleDBVersioned) registerNamespace(ns string) error {
+	if _, ok := b.vns[ns]; ok {
+		return nil
+	}
+	b.mutex.RLock()
+	_, ok := b.namespaces[ns]
+	b.mutex.RUnlock()
+	if ok {
+		return nil
+	}
+	if err := b.db.Put(_versionedMetaNS, append(append([]byte{}, _namespaceKeyPrefix...), ns...), []byte{}); err != nil {
+		return err
+	}
+	b.mutex.Lock()
+	b.namespaces[ns] = struct{}{}
+	b.mutex.Unlock()
+	return nil
+}
+
+// versioned returns whether the namespace is versioned, and its key length,
+// where 0 means the keys are of variable length
+func (b *PebbleDBVersioned) versioned(ns string) (int, bool) {
+	if keyLen, ok := b.vns[ns]; ok {
+		return keyLen, true
+	}
+	return 0, b.versionAll && ns != _versionedMetaNS
+}
+
+// versionedKey checks the key length, and returns the key the versions are
+// stored under
+func versionedKey(keyLen int, key []byte) ([]byte, error) {
+	if keyLen > 0 {
+		if len(key) != keyLen {
+			return nil, errors.Wrapf(ErrInvalid, "invalid key length, expecting %d, got %d", keyLen, len(key))
+		}
+		return key, nil
+	}
+	if len(key) > math.MaxUint16 {
+		return nil, errors.Wrapf(ErrInvalid, "key length %d exceeds %d", len(key), math.MaxUint16)
+	}
+	vk := make([]byte, 2, 2+len(key))
+	binary.BigEndian.PutUint16(vk, uint16(len(key)))
+	return append(vk, key...), nil
+}
+
+// userKey returns the key of a stored version, false if the stored key is not
+// a version (the namespace's metadata)
+func userKey(keyLen int, k []byte) ([]byte, bool) {
+	if keyLen > 0 {
+		if len(k) != keyLen+9 {
+			return nil, false
+		}
+		return k[:keyLen], true
+	}
+	if len(k) < 11 || int(binary.BigEndian.Uint16(k))+11 != len(k) {
+		return nil, false
+	}
+	return k[2 : len(k)-9], true
+}
+
+func (b *PebbleDBVersioned) addVersionedNamespace() error {
+	for ns, keyLen := range b.vns {
+		vn, err := b.checkNamespace(ns)
+		if errors.Cause(err) == ErrNotExist {
+			// create metadata for namespace
+			if err = b.db.Put(ns, _minKey, (&versionedNamespace{
+				keyLen: uint32(keyLen),
+			}).serialize()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if vn.keyLen != uint32(keyLen) {
+			return errors.Wrapf(ErrInvalid, "namespace %s already exists with key length = %d, got %d", ns, vn.keyLen, keyLen)
+		}
+	}
+	return nil
+}
+
+func (b *PebbleDBVersioned) checkNamespace(ns string) (*versionedNamespace, error) {
+	data, err := b.db.Get(ns, _minKey)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeVersionedNamespace(data)
+}
+
+// Put writes a <key, value> record
+func (b *PebbleDBVersioned) Put(version uint64, ns string, key, value []byte) error {
+	if !b.db.IsReady() {
+		return ErrDBNotStarted
+	}
+	keyLen, ok := b.versioned(ns)
+	if !ok {
+		return b.db.Put(ns, key, value)
+	}
+	vk, err := versionedKey(keyLen, key)
+	if err != nil {
+		return err
+	}
+	if err := b.registerNamespace(ns); err != nil {
+		return err
+	}
+	last, _, err := b.get(b.db.db, math.MaxUint64, ns, vk)
+	if !isNotExist(err) && version < last {
+		// not allowed to perform write on an earlier version
+		return errors.Wrapf(ErrInvalid, "cannot write at earlier version %d", version)
+	}
+	buf := batch.NewBatch()
+	if version == last {
+		buf.Delete(ns, keyForDelete(vk, version), fmt.Sprintf("failed to delete key %x", key))
+	}
+	buf.Put(ns, keyForWrite(vk, version), value, fmt.Sprintf("failed to put key %x", key))
+	return b.writeBatch(version, buf)
+}
+
+// Get retrieves the value of key at the given version
+func (b *PebbleDBVersioned) Get(version uint64, ns string, key []byte) ([]byte, error) {
+	if !b.db.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+	keyLen, ok := b.versioned(ns)
+	if !ok {
+		return b.db.Get(ns, key)
+	}
+	vk, err := versionedKey(keyLen, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.checkPruned(version); err != nil {
+		return nil, err
+	}
+	_, v, err := b.get(b.db.db, version, ns, vk)
+	if errors.Cause(err) == ErrDeleted {
+		err = errors.Wrapf(ErrNotExist, "key %x deleted", key)
+	}
+	return v, err
+}
+
+// get does a reverse seek from (key + version) to find the latest entry at or
+// before the given version
+func (b *PebbleDBVersioned) get(r pebbleReader, version uint64, ns string, key []byte) (uint64, []byte, error) {
+	var (
+		min   = keyForDelete(key, 0)
+		upper = append(keyForWrite(key, version), 0)
+	)
+	iter, err := r.NewIter(&pebble.IterOptions{
+		LowerBound: nsKey(ns, key),
+		UpperBound: nsKey(ns, upper),
+	})
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to create iterator")
+	}
+	defer func() {
+		if e := iter.Close(); e != nil {
+			log.L().Error("Failed to close iterator", zap.Error(e))
+		}
+	}()
+	if !iter.Last() {
+		return 0, nil, ErrNotExist
+	}
+	k, err := decodeKey(iter.Key())
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(k) != len(min) || bytes.Compare(k, min) <= 0 {
+		// smaller than minimum key
+		return 0, nil, ErrNotExist
+	}
+	isDelete, last := parseKey(k)
+	if isDelete {
+		return last, nil, ErrDeleted
+	}
+	value := make([]byte, len(iter.Value()))
+	copy(value, iter.Value())
+	return last, value, nil
+}
+
+// Delete deletes a record, if key does not exist, it returns nil
+func (b *PebbleDBVersioned) Delete(version uint64, ns string, key []byte) error {
+	if !b.db.IsReady() {
+		return ErrDBNotStarted
+	}
+	keyLen, ok := b.versioned(ns)
+	if !ok {
+		return b.db.Delete(ns, key)
+	}
+	vk, err := versionedKey(keyLen, key)
+	if err != nil {
+		return err
+	}
+	last, _, err := b.get(b.db.db, math.MaxUint64, ns, vk)
+	if isNotExist(err) {
+		return nil
+	}
+	if version < last {
+		// not allowed to perform delete on an earlier version
+		return errors.Wrapf(ErrInvalid, "cannot delete at earlier version %d", version)
+	}
+	buf := batch.NewBatch()
+	buf.Put(ns, keyForDelete(vk, version), nil, fmt.Sprintf("failed to delete key %x", key))
+	if version == last {
+		buf.Delete(ns, keyForWrite(vk, version), fmt.Sprintf("failed to delete key %x", key))
+	}
+	return b.writeBatch(version, buf)
+}
+
+// Version returns the key's most recent version
+func (b *PebbleDBVersioned) Version(ns string, key []byte) (uint64, error) {
+	if !b.db.IsReady() {
+		return 0, ErrDBNotStarted
+	}
+	keyLen, ok := b.versioned(ns)
+	if !ok {
+		return 0, errors.Errorf("namespace %s is non-versioned", ns)
+	}
+	vk, err := versionedKey(keyLen, key)
+	if err != nil {
+		return 0, err
+	}
+	last, _, err := b.get(b.db.db, math.MaxUint64, ns, vk)
+	if isNotExist(err) {
+		// key not yet written
+		err = errors.Wrapf(ErrNotExist, "key = %x doesn't exist", key)
+	}
+	return last, err
+}
+
+// Filter returns <k, v> pair in a bucket that meet the condition
+//
+// For versioned namespace, the value of each key at the given version is
+// passed to the condition, deleted keys are skipped. Keys of variable length
+// are returned in order of (length, key)
+func (b *PebbleDBVersioned) Filter(version uint64, ns string, cond Condition, minKey, maxKey []byte) ([][]byte, [][]byte, error) {
+	if !b.db.IsReady() {
+		return nil, nil, ErrDBNotStarted
+	}
+	keyLen, ok := b.versioned(ns)
+	if !ok {
+		return b.db.Filter(ns, cond, minKey, maxKey)
+	}
+	if err := b.checkPruned(version); err != nil {
+		return nil, nil, err
+	}
+	var (
+		keys, vals [][]byte
+		curKey     []byte
+		curValue   []byte
+		curExist   bool
+	)
+	flush := func() {
+		if curExist && cond(curKey, curValue) {
+			keys = append(keys, curKey)
+			vals = append(vals, curValue)
+		}
+	}
+	start := minKey
+	if keyLen == 0 {
+		// keys of variable length are not ordered by bytes, scan the namespace
+		start = nil
+	}
+	if err := b.forEachVersion(b.db.db, ns, keyLen, start, func(key, _ []byte, v uint64, isDelete bool, value []byte) (bool, error) {
+		if len(maxKey) > 0 && bytes.Compare(key, maxKey) > 0 {
+			return keyLen == 0, nil
+		}
+		if bytes.Compare(key, minKey) < 0 {
+			return true, nil
+		}
+		if curKey == nil || !bytes.Equal(key, curKey) {
+			flush()
+			curKey, curValue, curExist = append([]byte{}, key...), nil, false
+		}
+		if v <= version {
+			curExist = !isDelete
+			curValue = append([]byte{}, value...)
+		}
+		return true, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	flush()
+	if len(keys) == 0 {
+		return nil, nil, errors.Wrap(ErrNotExist, "filter returns no match")
+	}
+	return keys, vals, nil
+}
+
+// forEachVersion iterates over every versioned entry in the namespace, starting
+// from minKey, in order of (key, version). The callback receives the key and
+// the stored key of the version
+func (b *PebbleDBVersioned) forEachVersion(r pebbleReader, ns string, keyLen int, minKey []byte, fn func([]byte, []byte, uint64, bool, []byte) (bool, error)) error {
+	prefix := nsToPrefix(ns)
+	iter, err := r.NewIter(&pebble.IterOptions{
+		LowerBound: nsKey(ns, minKey),
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create iterator")
+	}
+	defer func() {
+		if e := iter.Close(); e != nil {
+			log.L().Error("Failed to close iterator", zap.Error(e))
+		}
+	}()
+	for iter.First(); iter.Valid(); iter.Next() {
+		k, err := decodeKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		key, ok := userKey(keyLen, k)
+		if !ok {
+			// namespace's metadata
+			continue
+		}
+		isDelete, v := parseKey(k)
+		next, err := fn(key, k, v, isDelete, iter.Value())
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return nil
+}
+
+// CommitBatch write a batch to DB, where the batch can contain keys for
+// both versioned and non-versioned namespace
+func (b *PebbleDBVersioned) CommitBatch(version uint64, kvsb batch.KVStoreBatch) error {
+	if !b.db.IsReady() {
+		return ErrDBNotStarted
+	}
+	ve, nve, err := dedupBy(kvsb, func(ns string, key []byte) (bool, error) {
+		keyLen, ok := b.versioned(ns)
+		if !ok {
+			return false, nil
+		}
+		if _, err := versionedKey(keyLen, key); err != nil {
+			return false, err
+		}
+		return true, b.registerNamespace(ns)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "PebbleDBVersioned failed to write batch")
+	}
+	// use indexed batch so that later writes can see earlier ones
+	ch := b.db.db.NewIndexedBatch()
+	defer ch.Close()
+	// keep order of the writes same as the original batch
+	for i := len(ve) - 1; i >= 0; i-- {
+		if err := b.writeVersionedEntry(ch, version, ve[i]); err != nil {
+			return err
+		}
+	}
+	// write non-versioned keys
+	for i := len(nve) - 1; i >= 0; i-- {
+		write := nve[i]
+		switch write.WriteType() {
+		case batch.Put:
+			err = ch.Set(nsKey(write.Namespace(), write.Key()), write.Value(), nil)
+		case batch.Delete:
+			err = ch.Delete(nsKey(write.Namespace(), write.Key()), nil)
+		}
+		if err != nil {
+			return errors.Wrap(err, write.Error())
+		}
+	}
+	return b.commit(ch, version)
+}
+
+func (b *PebbleDBVersioned) writeVersionedEntry(ch *pebble.Batch, version uint64, ve *batch.WriteInfo) error {
+	var (
+		ns       = ve.Namespace()
+		keyLen   = b.vns[ns]
+		notexist bool
+		deleted  bool
+	)
+	key, err := versionedKey(keyLen, ve.Key())
+	if err != nil {
+		return err
+	}
+	last, _, err := b.get(ch, math.MaxUint64, ns, key)
+	switch errors.Cause(err) {
+	case nil:
+	case ErrDeleted:
+		deleted = true
+	case ErrNotExist:
+		notexist = true
+	default:
+		return err
+	}
+	switch ve.WriteType() {
+	case batch.Put:
+		if !notexist && version < last {
+			// not allowed to perform write on an earlier version
+			return errors.Wrapf(ErrInvalid, "cannot write at earlier version %d", version)
+		}
+		if deleted && version == last {
+			// a write overwrites the delete at the same version
+			if err := ch.Delete(nsKey(ns, keyForDelete(key, version)), nil); err != nil {
+				return errors.Wrap(err, ve.Error())
+			}
+		}
+		if err := ch.Set(nsKey(ns, keyForWrite(key, version)), ve.Value(), nil); err != nil {
+			return errors.Wrap(err, ve.Error())
+		}
+	case batch.Delete:
+		if notexist {
+			return nil
+		}
+		if version < last {
+			// not allowed to perform delete on an earlier version
+			return errors.Wrapf(ErrInvalid, "cannot delete at earlier version %d", version)
+		}
+		if err := ch.Set(nsKey(ns, keyForDelete(key, version)), nil, nil); err != nil {
+			return errors.Wrap(err, ve.Error())
+		}
+		if version == last {
+			if err := ch.Delete(nsKey(ns, keyForWrite(key, version)), nil); err != nil {
+				return errors.Wrap(err, ve.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func (b *PebbleDBVersioned) writeBatch(version uint64, kvsb batch.KVStoreBatch) error {
+	ch, err := b.db.dedup(kvsb)
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	return b.commit(ch, version)
+}
+
+// commit writes the batch together with the updated tip version
+func (b *PebbleDBVersioned) commit(ch *pebble.Batch, version uint64) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if version > b.tip {
+		if err := ch.Set(nsKey(_versionedMetaNS, _tipVersionKey), byteutil.Uint64ToBytesBigEndian(version), nil); err != nil {
+			return err
+		}
+	}
+	if err := ch.Commit(nil); err != nil {
+		if errors.Is(err, syscall.ENOSPC) {
+			log.L().Fatal("PebbleDBVersioned failed to write batch", zap.Error(err))
+		}
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if version > b.tip {
+		b.tip = version
+	}
+	return nil
+}
+
+func (b *PebbleDBVersioned) checkPruned(version uint64) error {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	if version < b.pruned {
+		return errors.Wrapf(ErrPruned, "version %d is lower than the pruned version %d", version, b.pruned)
+	}
+	return nil
+}
+
+// Prune removes the history versions that are not visible to any read at or
+// above (tip - HistoryStateRetention)
+func (b *PebbleDBVersioned) Prune() error {
+	if !b.db.IsReady() {
+		return ErrDBNotStarted
+	}
+	b.mutex.RLock()
+	tip, pruned := b.tip, b.pruned
+	b.mutex.RUnlock()
+	if b.retention == 0 || tip <= b.retention {
+		return nil
+	}
+	target := tip - b.retention
+	if target <= pruned {
+		return nil
+	}
+	// update the pruned version first, so reads below it are rejected before
+	// the data is actually removed
+	if err := b.db.Put(_versionedMetaNS, _prunedVersionKey, byteutil.Uint64ToBytesBigEndian(target)); err != nil {
+		return err
+	}
+	b.mutex.Lock()
+	b.pruned = target
+	b.mutex.Unlock()
+	for ns, keyLen := range b.vns {
+		if err := b.pruneNamespace(ns, keyLen, target); err != nil {
+			return errors.Wrapf(err, "failed to prune namespace %s", ns)
+		}
+	}
+	b.mutex.RLock()
+	namespaces := make([]string, 0, len(b.namespaces))
+	for ns := range b.namespaces {
+		namespaces = append(namespaces, ns)
+	}
+	b.mutex.RUnlock()
+	for _, ns := range namespaces {
+		if err := b.pruneNamespace(ns, 0, target); err != nil {
+			return errors.Wrapf(err, "failed to prune namespace %s", ns)
+		}
+	}
+	return nil
+}
+
+func (b *PebbleDBVersioned) pruneNamespace(ns string, keyLen int, target uint64) error {
+	var (
+		ch      = b.db.db.NewBatch()
+		curKey  []byte
+		visible []byte // the entry visible to a read at target version
+		count   int
+	)
+	defer func() {
+		ch.Close()
+	}()
+	remove := func(k []byte) error {
+		if err := ch.Delete(nsKey(ns, k), nil); err != nil {
+			return err
+		}
+		count++
+		if count < _pruneBatchSize {
+			return nil
+		}
+		if err := ch.Commit(nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		ch.Close()
+		ch, count = b.db.db.NewBatch(), 0
+		return nil
+	}
+	// a delete marker at or below the target version makes the key invisible
+	// to the target version, hence it can be removed as well
+	flush := func() error {
+		if visible == nil {
+			return nil
+		}
+		if isDelete, _ := parseKey(visible); isDelete {
+			return remove(visible)
+		}
+		return nil
+	}
+	// iterate a snapshot so that the batch commits do not affect the iterator
+	snap := b.db.db.NewSnapshot()
+	defer snap.Close()
+	if err := b.forEachVersion(snap, ns, keyLen, nil, func(key, k []byte, v uint64, _ bool, _ []byte) (bool, error) {
+		if !bytes.Equal(key, curKey) {
+			if err := flush(); err != nil {
+				return false, err
+			}
+			curKey, visible = append([]byte{}, key...), nil
+		}
+		if v > target {
+			return true, nil
+		}
+		if visible != nil {
+			// superseded by a later version at or below target
+			if err := remove(visible); err != nil {
+				return false, err
+			}
+		}
+		visible = append([]byte{}, k...)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if err := ch.Commit(nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+func prefixUpperBound(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// prefix is all 0xff
+	return nil
+}
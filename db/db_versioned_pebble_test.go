@@ -0,0 +1,310 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+func newTestPebbleDBVersioned(t *testing.T, retention uint64, ns ...Namespace) (*PebbleDBVersioned, func()) {
+	r := require.New(t)
+	cfg := DefaultConfig
+	cfg.DbPath = t.TempDir()
+	cfg.DBType = DBPebble
+	cfg.HistoryStateRetention = retention
+	db := NewPebbleDBVersioned(cfg, PebbleVnsOption(ns...))
+	ctx := context.Background()
+	r.NoError(db.Start(ctx))
+	return db, func() {
+		r.NoError(db.Stop(ctx))
+	}
+}
+
+func checkVersionTests(r *require.Assertions, db VersionedDB, tests []versionTest) {
+	for _, e := range tests {
+		value, err := db.Get(e.height, e.ns, e.k)
+		if len(e.err) == 0 {
+			r.NoError(err)
+		} else {
+			r.ErrorContains(err, e.err)
+		}
+		r.Equal(e.v, value)
+	}
+}
+
+func TestPebbleDBVersioned(t *testing.T) {
+	r := require.New(t)
+	db, cleanup := newTestPebbleDBVersioned(t, 0, Namespace{_bucket1, uint32(len(_k2))})
+	defer cleanup()
+
+	// namespace created
+	vn, err := db.checkNamespace(_bucket1)
+	r.NoError(err)
+	r.Equal(uint32(len(_k2)), vn.keyLen)
+	// check Put/Get
+	r.ErrorContains(db.Put(1, _bucket1, _k10, _v1), "invalid key length, expecting 5, got 6: invalid input")
+	r.NoError(db.Put(0, _bucket1, _k2, _v2))
+	r.NoError(db.Put(1, _bucket1, _k2, _v1))
+	r.NoError(db.Put(3, _bucket1, _k2, _v3))
+	r.NoError(db.Put(6, _bucket1, _k2, _v2))
+	r.NoError(db.Put(2, _bucket1, _k4, _v2))
+	r.NoError(db.Put(4, _bucket1, _k4, _v1))
+	r.NoError(db.Put(7, _bucket1, _k4, _v3))
+	checkVersionTests(r, db, []versionTest{
+		{_bucket2, _k1, nil, 0, _errNotExist}, // non-versioned namespace
+		{_bucket1, _k1, nil, 0, _errNotExist},
+		{_bucket1, _k2, _v2, 0, ""},
+		{_bucket1, _k2, _v1, 1, ""},
+		{_bucket1, _k2, _v1, 2, ""},
+		{_bucket1, _k2, _v3, 3, ""},
+		{_bucket1, _k2, _v3, 5, ""},
+		{_bucket1, _k2, _v2, 6, ""},
+		{_bucket1, _k2, _v2, 7, ""}, // after last write version
+		{_bucket1, _k3, nil, 0, _errNotExist},
+		{_bucket1, _k4, nil, 1, _errNotExist}, // before first write version
+		{_bucket1, _k4, _v2, 2, ""},
+		{_bucket1, _k4, _v1, 4, ""},
+		{_bucket1, _k4, _v3, 7, ""},
+		{_bucket1, _k4, _v3, math.MaxUint64, ""}, // larger than last key in namespace
+		{_bucket1, _k5, nil, 0, _errNotExist},
+		{_bucket1, _k10, nil, 0, ErrInvalid.Error()},
+	})
+	// overwrite the same height again
+	r.NoError(db.Put(6, _bucket1, _k2, _v4))
+	r.ErrorContains(db.Put(3, _bucket1, _k2, _v4), "cannot write at earlier version 3: invalid input")
+	v, err := db.Version(_bucket1, _k2)
+	r.NoError(err)
+	r.EqualValues(6, v)
+	_, err = db.Version(_bucket1, _k1)
+	r.ErrorContains(err, _errNotExist)
+	_, err = db.Version(_bucket2, _k1)
+	r.ErrorContains(err, "namespace test_ns2 is non-versioned")
+	// test delete
+	r.NoError(db.Delete(11, _bucket1, _k2))
+	r.ErrorContains(db.Delete(10, _bucket1, _k2), "cannot delete at earlier version 10: invalid input")
+	r.NoError(db.Delete(10, _bucket1, _k1))
+	_, err = db.Version(_bucket1, _k2)
+	r.Equal(ErrDeleted, errors.Cause(err))
+	r.NoError(db.Put(12, _bucket1, _k2, _k2))
+	checkVersionTests(r, db, []versionTest{
+		{_bucket1, _k2, _v4, 6, ""},
+		{_bucket1, _k2, _v4, 10, ""},          // before delete version
+		{_bucket1, _k2, nil, 11, _errDeleted}, // after delete version
+		{_bucket1, _k2, _k2, 12, ""},          // after next write version
+	})
+	// delete-after-write and write-after-delete at same version
+	r.NoError(db.Put(15, _bucket1, _k2, _v1))
+	r.NoError(db.Delete(15, _bucket1, _k2))
+	checkVersionTests(r, db, []versionTest{{_bucket1, _k2, nil, 15, _errDeleted}})
+	r.NoError(db.Put(15, _bucket1, _k2, _v3))
+	checkVersionTests(r, db, []versionTest{{_bucket1, _k2, _v3, 15, ""}})
+}
+
+func TestPebbleDBVersionedCommitBatch(t *testing.T) {
+	r := require.New(t)
+	db, cleanup := newTestPebbleDBVersioned(t, 0, Namespace{_bucket1, uint32(len(_k1))})
+	defer cleanup()
+
+	b := batch.NewBatch()
+	b.Put(_bucket1, _k1, _v1, "test")
+	b.Put(_bucket1, _k2, _v2, "test")
+	b.Put(_bucket2, _v1, _k1, "test")
+	r.NoError(db.CommitBatch(1, b))
+	b.Clear()
+	// batch with wrong key length would fail
+	b.Put(_bucket1, _v1, _k1, "test")
+	r.Equal(ErrInvalid, errors.Cause(db.CommitBatch(3, b)))
+	b.Clear()
+	b.Put(_bucket1, _k1, _v3, "test")
+	b.Delete(_bucket1, _k2, "test")
+	b.Put(_bucket1, _k3, _v1, "test")
+	b.Delete(_bucket1, _k3, "test")
+	b.Put(_bucket1, _k4, _v1, "test")
+	b.Delete(_bucket1, _k4, "test")
+	b.Put(_bucket1, _k4, _v4, "test")
+	b.Delete(_bucket2, _v1, "test")
+	r.NoError(db.CommitBatch(5, b))
+	b.Clear()
+	checkVersionTests(r, db, []versionTest{
+		{_bucket1, _k1, nil, 0, _errNotExist},
+		{_bucket1, _k1, _v1, 1, ""},
+		{_bucket1, _k1, _v1, 4, ""},
+		{_bucket1, _k1, _v3, 5, ""},
+		{_bucket1, _k2, _v2, 4, ""},
+		{_bucket1, _k2, nil, 5, _errDeleted},
+		{_bucket1, _k3, nil, 4, _errNotExist},
+		{_bucket1, _k3, nil, 5, _errDeleted},
+		{_bucket1, _k4, _v4, 5, ""},
+		{_bucket2, _v1, nil, 5, _errNotExist},
+	})
+	// cannot write to earlier version
+	b.Put(_bucket1, _k1, _v2, "test")
+	r.ErrorIs(db.CommitBatch(4, b), ErrInvalid)
+	// filter at version
+	keys, vals, err := db.Filter(4, _bucket1, func(k, v []byte) bool { return true }, nil, nil)
+	r.NoError(err)
+	r.Equal([][]byte{_k1, _k2}, keys)
+	r.Equal([][]byte{_v1, _v2}, vals)
+	keys, vals, err = db.Filter(5, _bucket1, func(k, v []byte) bool { return true }, nil, nil)
+	r.NoError(err)
+	r.Equal([][]byte{_k1, _k4}, keys)
+	r.Equal([][]byte{_v3, _v4}, vals)
+	keys, _, err = db.Filter(5, _bucket1, func(k, v []byte) bool { return true }, _k2, _k3)
+	r.ErrorContains(err, _errNotExist)
+	r.Nil(keys)
+}
+
+func TestPebbleDBVersionedPrune(t *testing.T) {
+	r := require.New(t)
+	db, cleanup := newTestPebbleDBVersioned(t, 10, Namespace{_bucket1, uint32(len(_k1))})
+	defer cleanup()
+
+	// _k1 is written at every version, _k2 deleted at 8, _k3 written once
+	r.NoError(db.Put(1, _bucket1, _k3, _v3))
+	for i := uint64(1); i <= 30; i++ {
+		r.NoError(db.Put(i, _bucket1, _k1, byteutil.Uint64ToBytesBigEndian(i)))
+		if i < 8 {
+			r.NoError(db.Put(i, _bucket1, _k2, _v2))
+		}
+	}
+	r.NoError(db.Delete(8, _bucket1, _k2))
+	r.NoError(db.Prune())
+	// versions below 20 are pruned
+	_, err := db.Get(19, _bucket1, _k1)
+	r.ErrorIs(err, ErrPruned)
+	for i := uint64(20); i <= 30; i++ {
+		v, err := db.Get(i, _bucket1, _k1)
+		r.NoError(err)
+		r.Equal(byteutil.Uint64ToBytesBigEndian(i), v)
+	}
+	checkVersionTests(r, db, []versionTest{
+		{_bucket1, _k2, nil, 20, _errNotExist},
+		{_bucket1, _k3, _v3, 20, ""},
+	})
+	// only the version visible at 20 and later ones remain
+	count := 0
+	r.NoError(db.forEachVersion(db.db.db, _bucket1, len(_k1), nil, func([]byte, []byte, uint64, bool, []byte) (bool, error) {
+		count++
+		return true, nil
+	}))
+	r.Equal(12, count)
+	// pruned version survives restart
+	ctx := context.Background()
+	r.NoError(db.Stop(ctx))
+	r.NoError(db.Start(ctx))
+	_, err = db.Get(19, _bucket1, _k1)
+	r.ErrorIs(err, ErrPruned)
+	r.EqualValues(30, db.tip)
+}
+
+func TestPebbleDBVersionAll(t *testing.T) {
+	r := require.New(t)
+	const _bucket3 = "test_ns3"
+	cfg := DefaultConfig
+	cfg.DbPath = t.TempDir()
+	cfg.DBType = DBPebble
+	cfg.HistoryStateRetention = 5
+	db := NewPebbleDBVersioned(cfg, PebbleVnsOption(Namespace{_bucket1, uint32(len(_k1))}), PebbleVersionAllOption())
+	ctx := context.Background()
+	r.NoError(db.Start(ctx))
+	defer func() {
+		r.NoError(db.Stop(ctx))
+	}()
+
+	// keys of any length, including a key being the prefix of another one
+	r.ErrorIs(db.Put(1, _bucket1, _k10, _v1), ErrInvalid)
+	r.NoError(db.Put(1, _bucket2, _k1, _v1))
+	r.NoError(db.Put(1, _bucket2, _k10, _v2))
+	r.NoError(db.Put(2, _bucket2, []byte{}, _v3))
+	b := batch.NewBatch()
+	b.Put(_bucket2, _k1, _v3, "test")
+	b.Delete(_bucket2, _k10, "test")
+	b.Put(_bucket1, _k1, _v4, "test")
+	r.NoError(db.CommitBatch(3, b))
+	// a write at the same version overwrites
+	b.Clear()
+	b.Put(_bucket2, _k1, _v4, "test")
+	b.Put(_bucket2, _k10, _v4, "test")
+	r.NoError(db.CommitBatch(3, b))
+	checkVersionTests(r, db, []versionTest{
+		{_bucket2, _k1, nil, 0, _errNotExist},
+		{_bucket2, _k1, _v1, 1, ""},
+		{_bucket2, _k1, _v1, 2, ""},
+		{_bucket2, _k1, _v4, 3, ""},
+		{_bucket2, _k10, _v2, 2, ""},
+		{_bucket2, _k10, _v4, 3, ""},
+		{_bucket2, []byte{}, nil, 1, _errNotExist},
+		{_bucket2, []byte{}, _v3, 2, ""},
+		{_bucket1, _k1, _v4, 3, ""},
+		{_bucket3, _k1, nil, 3, _errNotExist},
+	})
+	v, err := db.Version(_bucket2, _k10)
+	r.NoError(err)
+	r.EqualValues(3, v)
+	// keys of variable length are in order of (length, key)
+	keys, vals, err := db.Filter(2, _bucket2, func(k, v []byte) bool { return true }, nil, nil)
+	r.NoError(err)
+	r.Equal([][]byte{{}, _k1, _k10}, keys)
+	r.Equal([][]byte{_v3, _v1, _v2}, vals)
+	keys, _, err = db.Filter(3, _bucket2, func(k, v []byte) bool { return true }, _k10, nil)
+	r.NoError(err)
+	r.Equal([][]byte{_k10}, keys)
+
+	// namespaces are pruned after restart
+	r.NoError(db.Stop(ctx))
+	r.NoError(db.Start(ctx))
+	for i := uint64(4); i <= 10; i++ {
+		r.NoError(db.Put(i, _bucket3, _k10, byteutil.Uint64ToBytesBigEndian(i)))
+	}
+	r.NoError(db.Prune())
+	_, err = db.Get(4, _bucket2, _k1)
+	r.ErrorIs(err, ErrPruned)
+	count := 0
+	for _, ns := range []string{_bucket2, _bucket3} {
+		r.NoError(db.forEachVersion(db.db.db, ns, 0, nil, func([]byte, []byte, uint64, bool, []byte) (bool, error) {
+			count++
+			return true, nil
+		}))
+	}
+	// _k1, _k10, empty key in _bucket2, versions 5 to 10 of _k10 in _bucket3
+	r.Equal(9, count)
+	checkVersionTests(r, db, []versionTest{
+		{_bucket2, _k1, _v4, 5, ""},
+		{_bucket2, _k10, _v4, 5, ""},
+		{_bucket3, _k10, byteutil.Uint64ToBytesBigEndian(5), 5, ""},
+	})
+}
+
+func TestKVStoreWithVersionPebble(t *testing.T) {
+	r := require.New(t)
+	cfg := DefaultConfig
+	cfg.DbPath = t.TempDir()
+	cfg.DBType = DBPebble
+	db := NewKVStoreWithVersion(cfg, VersionedNamespaceOption(Namespace{_bucket1, 5}))
+	_, ok := db.db.(*PebbleDBVersioned)
+	r.True(ok)
+	ctx := context.Background()
+	r.NoError(db.Start(ctx))
+	defer func() {
+		db.Stop(ctx)
+	}()
+	r.NoError(db.SetVersion(1).Put(_bucket1, _k2, _v1))
+	r.NoError(db.SetVersion(3).Put(_bucket1, _k2, _v3))
+	v, err := db.SetVersion(2).Get(_bucket1, _k2)
+	r.NoError(err)
+	r.Equal(_v1, v)
+	n, err := db.Version(_bucket1, _k2)
+	r.NoError(err)
+	r.EqualValues(3, n)
+}
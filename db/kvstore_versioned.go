@@ -32,7 +32,7 @@ type (
 	//
 	// How to use a versioned key-value store:
 	//
-	// db := NewKVStoreWithVersion(cfg) // creates a versioned DB
+	// db := NewKVStoreWithVersion(cfg) // creates a versioned DB on bolt or pebble, per cfg.DBType
 	// db.Start(ctx)
 	// defer func() { db.Stop(ctx) }()
 	//
@@ -53,9 +53,10 @@ type (
 
 	// KvWithVersion wraps the versioned DB implementation with a certain version
 	KvWithVersion struct {
-		db      VersionedDB
-		version uint64      // the current version
-		vns     []Namespace // versioned namespace
+		db         VersionedDB
+		version    uint64      // the current version
+		vns        []Namespace // versioned namespace
+		versionAll bool        // all namespaces are versioned
 	}
 )
 
@@ -69,6 +70,15 @@ func VersionedNamespaceOption(ns ...Namespace) Option {
 	}
 }
 
+// VersionAllOption versions every namespace, the namespaces not passed in by
+// VersionedNamespaceOption accept keys of any length. Only pebble supports it,
+// so the versioned DB is created on pebble regardless of cfg.DBType
+func VersionAllOption() Option {
+	return func(k *KvWithVersion) {
+		k.versionAll = true
+	}
+}
+
 // NewKVStoreWithVersion implements a KVStore that can handle both versioned
 // and non-versioned namespace
 func NewKVStoreWithVersion(cfg Config, opts ...Option) *KvWithVersion {
@@ -76,6 +86,17 @@ func NewKVStoreWithVersion(cfg Config, opts ...Option) *KvWithVersion {
 	for _, opt := range opts {
 		opt(&kv)
 	}
+	if cfg.DBType == DBPebble || kv.versionAll {
+		var dbOpts []PebbleDBVersionedOption
+		if len(kv.vns) > 0 {
+			dbOpts = append(dbOpts, PebbleVnsOption(kv.vns...))
+		}
+		if kv.versionAll {
+			dbOpts = append(dbOpts, PebbleVersionAllOption())
+		}
+		kv.db = NewPebbleDBVersioned(cfg, dbOpts...)
+		return &kv
+	}
 	var dbOpts []BoltDBVersionedOption
 	if len(kv.vns) > 0 {
 		dbOpts = append(dbOpts, VnsOption(kv.vns...))
@@ -127,9 +148,10 @@ func (b *KvWithVersion) Version(ns string, key []byte) (uint64, error) {
 // SetVersion sets the version, and returns a KVStore to call Put()/Get()
 func (b *KvWithVersion) SetVersion(v uint64) KVStore {
 	kv := KvWithVersion{
-		db:      b.db,
-		version: v,
-		vns:     b.vns,
+		db:         b.db,
+		version:    v,
+		vns:        b.vns,
+		versionAll: b.versionAll,
 	}
 	return &kv
 }
@@ -82,7 +82,8 @@ func (e *extensionNode) Delete(cli client, key keyType, offset uint8) (node, err
 	}
 	switch node := newChild.(type) {
 	case *extensionNode:
-		return node.updatePath(cli, append(e.path, node.path...))
+		// cap the path, which may be shared with the other copies of the node, before appending to it
+		return node.updatePath(cli, append(e.path[:len(e.path):len(e.path)], node.path...))
 	case *branchNode:
 		return e.updateChild(cli, node)
 	default:
@@ -23,3 +23,16 @@ func NewLayerTwoLeafIterator(tr trie.TwoLayerTrie, layerOneKey []byte, l int) (t
 	}
 	return NewLeafIterator(lt.tr)
 }
+
+// NewLayerTwoRangeIterator returns a new range iterator of the layer two trie
+func NewLayerTwoRangeIterator(tr trie.TwoLayerTrie, layerOneKey []byte, l int, start, end []byte, limit int) (trie.Iterator, error) {
+	tlt, ok := tr.(*twoLayerTrie)
+	if !ok {
+		return nil, errors.New("trie is not supported type")
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, l)
+	if err != nil {
+		return nil, err
+	}
+	return NewRangeIterator(lt.tr, start, end, limit)
+}
@@ -32,6 +32,9 @@ type (
 		hashFunc      HashFunc
 		async         bool
 		emptyRootHash []byte
+		// parallelThreshold is the number of dirty children of a branch node to hash them concurrently
+		parallelThreshold int
+		nodeCache         *NodeCache
 	}
 )
 
@@ -88,12 +91,35 @@ func AsyncOption() Option {
 	}
 }
 
+// ParallelHashOption hashes the dirty children of a branch node concurrently when there are at least
+// threshold of them. It takes effect with AsyncOption, and the hash func must be safe for
+// concurrent use
+func ParallelHashOption(threshold int) Option {
+	return func(mpt *merklePatriciaTrie) error {
+		if threshold <= 0 {
+			return errors.New("invalid parallel hash threshold")
+		}
+		mpt.parallelThreshold = threshold
+		return nil
+	}
+}
+
+// NodeCacheOption sets the cache of the nodes loaded from the kvStore, nil disables it. The shared node
+// cache is used by default
+func NodeCacheOption(c *NodeCache) Option {
+	return func(mpt *merklePatriciaTrie) error {
+		mpt.nodeCache = c
+		return nil
+	}
+}
+
 // New creates a trie with DB filename
 func New(options ...Option) (trie.Trie, error) {
 	t := &merklePatriciaTrie{
 		keyLength: 20,
 		hashFunc:  DefaultHashFunc,
 		kvStore:   trie.NewMemKVStore(),
+		nodeCache: _sharedNodeCache.Load(),
 	}
 	for _, opt := range options {
 		if err := opt(t); err != nil {
@@ -130,19 +156,33 @@ func (mpt *merklePatriciaTrie) Stop(_ context.Context) error {
 
 func (mpt *merklePatriciaTrie) RootHash() ([]byte, error) {
 	if mpt.async {
-		if err := mpt.root.Flush(mpt); err != nil {
+		if err := mpt.flush(); err != nil {
 			return nil, err
 		}
-		h, err := mpt.root.Hash(mpt)
-		if err != nil {
-			return nil, err
-		}
-		mpt.rootHash = h
 	}
 
 	return mpt.rootHash, nil
 }
 
+// flush hashes and stores the dirty nodes, and updates the root hash
+func (mpt *merklePatriciaTrie) flush() error {
+	if mpt.parallelThreshold > 0 {
+		if err := hashDirty(mpt, mpt.root, mpt.parallelThreshold); err != nil {
+			return err
+		}
+	}
+	if err := mpt.root.Flush(mpt); err != nil {
+		return err
+	}
+	h, err := mpt.root.Hash(mpt)
+	if err != nil {
+		return err
+	}
+	mpt.rootHash = h
+
+	return nil
+}
+
 func (mpt *merklePatriciaTrie) SetRootHash(rootHash []byte) error {
 	mpt.mutex.Lock()
 	defer mpt.mutex.Unlock()
@@ -302,12 +342,8 @@ func (mpt *merklePatriciaTrie) putNode(key []byte, value []byte) error {
 }
 
 func (mpt *merklePatriciaTrie) loadNode(key []byte) (node, error) {
-	s, err := mpt.kvStore.Get(key)
+	pb, err := mpt.loadNodePb(key)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get key %x", key)
-	}
-	pb := triepb.NodePb{}
-	if err := proto.Unmarshal(s, &pb); err != nil {
 		return nil, err
 	}
 	if pbBranch := pb.GetBranch(); pbBranch != nil {
@@ -323,6 +359,26 @@ func (mpt *merklePatriciaTrie) loadNode(key []byte) (node, error) {
 	return nil, errors.New("invalid node type")
 }
 
+func (mpt *merklePatriciaTrie) loadNodePb(key []byte) (*triepb.NodePb, error) {
+	if mpt.nodeCache != nil {
+		if pb, ok := mpt.nodeCache.Get(key); ok {
+			return pb, nil
+		}
+	}
+	s, err := mpt.kvStore.Get(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get key %x", key)
+	}
+	pb := &triepb.NodePb{}
+	if err := proto.Unmarshal(s, pb); err != nil {
+		return nil, err
+	}
+	if mpt.nodeCache != nil {
+		mpt.nodeCache.Add(key, pb, len(s))
+	}
+	return pb, nil
+}
+
 func (mpt *merklePatriciaTrie) Clone(kvStore trie.KVStore) (trie.Trie, error) {
 	mpt.mutex.RLock()
 	defer mpt.mutex.RUnlock()
@@ -336,13 +392,15 @@ func (mpt *merklePatriciaTrie) Clone(kvStore trie.KVStore) (trie.Trie, error) {
 	copy(erh, mpt.emptyRootHash)
 
 	return &merklePatriciaTrie{
-		keyLength:     mpt.keyLength,
-		root:          root,
-		rootHash:      rh,
-		rootKey:       mpt.rootKey,
-		kvStore:       kvStore,
-		hashFunc:      mpt.hashFunc,
-		async:         mpt.async,
-		emptyRootHash: erh,
+		keyLength:         mpt.keyLength,
+		root:              root,
+		rootHash:          rh,
+		rootKey:           mpt.rootKey,
+		kvStore:           kvStore,
+		hashFunc:          mpt.hashFunc,
+		async:             mpt.async,
+		emptyRootHash:     erh,
+		parallelThreshold: mpt.parallelThreshold,
+		nodeCache:         mpt.nodeCache,
 	}, nil
 }
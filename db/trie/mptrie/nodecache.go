@@ -0,0 +1,137 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie/triepb"
+)
+
+// _nodeCacheEntryOverhead is the approximate memory taken by an entry besides the key and the node
+const _nodeCacheEntryOverhead = 128
+
+var (
+	_nodeCacheMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_trie_node_cache",
+			Help: "IoTeX Trie Node Cache",
+		},
+		[]string{"type"},
+	)
+	_nodeCacheSizeMtc = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "iotex_trie_node_cache_size",
+			Help: "IoTeX Trie Node Cache size in bytes",
+		},
+	)
+
+	// _sharedNodeCache is the node cache used by the tries created without NodeCacheOption
+	_sharedNodeCache atomic.Pointer[NodeCache]
+)
+
+func init() {
+	prometheus.MustRegister(_nodeCacheMtc)
+	prometheus.MustRegister(_nodeCacheSizeMtc)
+}
+
+type (
+	// NodeCache is a cache of decoded trie nodes keyed by node hash, which evicts the least recently used
+	// nodes once their total size exceeds the budget. The nodes are content addressed, so a cache can be
+	// shared by all the tries in the process
+	NodeCache struct {
+		mutex    sync.Mutex
+		maxBytes int
+		size     int
+		ll       *list.List
+		items    map[string]*list.Element
+	}
+
+	nodeCacheEntry struct {
+		key  string
+		pb   *triepb.NodePb
+		size int
+	}
+)
+
+// NewNodeCache creates a node cache holding up to maxBytes of nodes
+func NewNodeCache(maxBytes int) *NodeCache {
+	return &NodeCache{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// SetSharedNodeCache sets the node cache used by the tries created afterwards without NodeCacheOption,
+// nil disables it
+func SetSharedNodeCache(c *NodeCache) {
+	_sharedNodeCache.Store(c)
+}
+
+// Get returns the node of the hash
+func (c *NodeCache) Get(key []byte) (*triepb.NodePb, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.items[string(key)]
+	if !ok {
+		_nodeCacheMtc.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	_nodeCacheMtc.WithLabelValues("hit").Inc()
+	c.ll.MoveToFront(e)
+	return e.Value.(*nodeCacheEntry).pb, true
+}
+
+// Add adds the node of the hash, whose serialized size is size. The node must not be modified afterwards
+func (c *NodeCache) Add(key []byte, pb *triepb.NodePb, size int) {
+	size += len(key) + _nodeCacheEntryOverhead
+	if size > c.maxBytes {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.items[string(key)]; ok {
+		c.ll.MoveToFront(e)
+		return
+	}
+	entry := &nodeCacheEntry{key: string(key), pb: pb, size: size}
+	c.items[entry.key] = c.ll.PushFront(entry)
+	c.size += size
+	for c.size > c.maxBytes {
+		c.removeOldest()
+	}
+	_nodeCacheSizeMtc.Set(float64(c.size))
+}
+
+// Len returns the number of nodes in the cache
+func (c *NodeCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.ll.Len()
+}
+
+// Size returns the total size of the nodes in the cache in bytes
+func (c *NodeCache) Size() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.size
+}
+
+func (c *NodeCache) removeOldest() {
+	e := c.ll.Back()
+	if e == nil {
+		return
+	}
+	entry := c.ll.Remove(e).(*nodeCacheEntry)
+	delete(c.items, entry.key)
+	c.size -= entry.size
+	_nodeCacheMtc.WithLabelValues("evict").Inc()
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie"
+	"github.com/iotexproject/iotex-core/v2/db/trie/triepb"
+)
+
+func TestNodeCache(t *testing.T) {
+	r := require.New(t)
+	entrySize := 1 + 100 + _nodeCacheEntryOverhead
+	c := NewNodeCache(3 * entrySize)
+	for i := byte(0); i < 3; i++ {
+		c.Add([]byte{i}, &triepb.NodePb{}, 100)
+	}
+	r.Equal(3, c.Len())
+	r.Equal(3*entrySize, c.Size())
+	// touch 0, so 1 is the least recently used
+	_, ok := c.Get([]byte{0})
+	r.True(ok)
+	c.Add([]byte{3}, &triepb.NodePb{}, 100)
+	r.Equal(3, c.Len())
+	_, ok = c.Get([]byte{1})
+	r.False(ok)
+	for _, k := range []byte{0, 2, 3} {
+		_, ok = c.Get([]byte{k})
+		r.True(ok)
+	}
+	// a node larger than the budget is not cached
+	c.Add([]byte{4}, &triepb.NodePb{}, 3*entrySize)
+	_, ok = c.Get([]byte{4})
+	r.False(ok)
+	r.Equal(3, c.Len())
+}
+
+func TestTrieWithNodeCache(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kvStore := trie.NewMemKVStore()
+	c := NewNodeCache(1 << 20)
+	tr, err := New(KVStoreOption(kvStore), KeyLengthOption(4), NodeCacheOption(c))
+	r.NoError(err)
+	r.NoError(tr.Start(ctx))
+	for i := byte(0); i < 100; i++ {
+		r.NoError(tr.Upsert([]byte{i % 4, i, 0, i}, []byte{i}))
+	}
+	rootHash, err := tr.RootHash()
+	r.NoError(err)
+	r.NoError(tr.Stop(ctx))
+
+	// tries sharing the cache load the nodes from it
+	for j := 0; j < 2; j++ {
+		tr, err = New(KVStoreOption(kvStore), KeyLengthOption(4), NodeCacheOption(c), RootHashOption(rootHash))
+		r.NoError(err)
+		r.NoError(tr.Start(ctx))
+		for i := byte(0); i < 100; i++ {
+			value, err := tr.Get([]byte{i % 4, i, 0, i})
+			r.NoError(err)
+			r.Equal([]byte{i}, value)
+		}
+		if j == 0 {
+			r.NotZero(c.Len())
+			// a new store without the nodes can still be read through the cache
+			kvStore = trie.NewMemKVStore()
+		}
+	}
+	// the cached nodes are not changed by the updates
+	r.NoError(tr.Upsert([]byte{1, 1, 0, 1}, []byte{2}))
+	r.NoError(tr.Delete([]byte{2, 2, 0, 2}))
+	tr, err = New(KVStoreOption(kvStore), KeyLengthOption(4), NodeCacheOption(c), RootHashOption(rootHash))
+	r.NoError(err)
+	r.NoError(tr.Start(ctx))
+	value, err := tr.Get([]byte{1, 1, 0, 1})
+	r.NoError(err)
+	r.Equal([]byte{1}, value)
+	value, err = tr.Get([]byte{2, 2, 0, 2})
+	r.NoError(err)
+	r.Equal([]byte{2}, value)
+}
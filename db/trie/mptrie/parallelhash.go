@@ -0,0 +1,85 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"golang.org/x/sync/errgroup"
+)
+
+// ParallelHashThreshold is the number of dirty children of a branch node to hash them concurrently, which is
+// used by the tries written in async mode
+const ParallelHashThreshold = 4
+
+// hashDirty computes the hashes of the dirty nodes under n, without storing them. The subtrees of
+// the children of a branch node are independent, so they are hashed concurrently if at least
+// threshold of them are dirty
+func hashDirty(cli client, n node, threshold int) error {
+	switch n := n.(type) {
+	case *branchNode:
+		if !needHash(&n.cacheNode) {
+			return nil
+		}
+		dirty := make([]node, 0, len(n.children))
+		for _, idx := range n.indices.List() {
+			if c := n.children[idx]; isDirty(c) {
+				dirty = append(dirty, c)
+			}
+		}
+		if len(dirty) >= threshold {
+			var eg errgroup.Group
+			for _, c := range dirty {
+				eg.Go(func() error {
+					return hashDirty(cli, c, threshold)
+				})
+			}
+			if err := eg.Wait(); err != nil {
+				return err
+			}
+		} else {
+			for _, c := range dirty {
+				if err := hashDirty(cli, c, threshold); err != nil {
+					return err
+				}
+			}
+		}
+		_, err := n.Hash(cli)
+		return err
+	case *extensionNode:
+		if !needHash(&n.cacheNode) {
+			return nil
+		}
+		if err := hashDirty(cli, n.child, threshold); err != nil {
+			return err
+		}
+		_, err := n.Hash(cli)
+		return err
+	case *leafNode:
+		if !needHash(&n.cacheNode) {
+			return nil
+		}
+		_, err := n.Hash(cli)
+		return err
+	default:
+		return nil
+	}
+}
+
+func isDirty(n node) bool {
+	switch n := n.(type) {
+	case *branchNode:
+		return needHash(&n.cacheNode)
+	case *extensionNode:
+		return needHash(&n.cacheNode)
+	case *leafNode:
+		return needHash(&n.cacheNode)
+	default:
+		return false
+	}
+}
+
+func needHash(cn *cacheNode) bool {
+	return cn.dirty && len(cn.hashVal) == 0
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie"
+)
+
+const _fuzzKeyLength = 4
+
+// checkRootHash applies the ops to a trie in the default mode, and to tries hashing the dirty nodes in async
+// mode serially and concurrently, and checks the root hashes are identical after every batch. Every op takes 1 byte
+// for the type, _fuzzKeyLength bytes for the key and 1 byte for the value
+func checkRootHash(t *testing.T, ops []byte) {
+	r := require.New(t)
+	ctx := context.Background()
+	newTrie := func(opts ...Option) (trie.Trie, trie.KVStore) {
+		kv := trie.NewMemKVStore()
+		tr, err := New(append(opts, KVStoreOption(kv), KeyLengthOption(_fuzzKeyLength))...)
+		r.NoError(err)
+		r.NoError(tr.Start(ctx))
+		return tr, kv
+	}
+	expected, _ := newTrie()
+	var (
+		tries = []trie.Trie{}
+		kvs   = []trie.KVStore{}
+	)
+	for _, opts := range [][]Option{
+		{AsyncOption()},
+		{AsyncOption(), ParallelHashOption(1)},
+		{AsyncOption(), ParallelHashOption(ParallelHashThreshold)},
+	} {
+		tr, kv := newTrie(opts...)
+		tries = append(tries, tr)
+		kvs = append(kvs, kv)
+	}
+	values := map[string][]byte{}
+	check := func() {
+		h, err := expected.RootHash()
+		r.NoError(err)
+		for i, tr := range tries {
+			rh, err := tr.RootHash()
+			r.NoError(err)
+			r.Equal(h, rh)
+			r.Equal(expected.IsEmpty(), tr.IsEmpty())
+			// the dirty nodes are stored with the hashes computed
+			loaded, err := New(KVStoreOption(kvs[i]), KeyLengthOption(_fuzzKeyLength), RootHashOption(rh))
+			r.NoError(err)
+			r.NoError(loaded.Start(ctx))
+			for k, v := range values {
+				value, err := loaded.Get([]byte(k))
+				r.NoError(err)
+				r.Equal(v, value)
+			}
+		}
+	}
+	for len(ops) >= 2+_fuzzKeyLength {
+		op, key, value := ops[0], ops[1:1+_fuzzKeyLength], ops[1+_fuzzKeyLength:2+_fuzzKeyLength]
+		ops = ops[2+_fuzzKeyLength:]
+		switch op % 8 {
+		case 0:
+			check()
+		case 1, 2:
+			err := expected.Delete(key)
+			for _, tr := range tries {
+				r.Equal(err == nil, tr.Delete(key) == nil)
+			}
+			delete(values, string(key))
+		default:
+			v := []byte{op, value[0]}
+			r.NoError(expected.Upsert(key, v))
+			for _, tr := range tries {
+				r.NoError(tr.Upsert(key, v))
+			}
+			values[string(key)] = v
+		}
+	}
+	check()
+}
+
+func TestParallelHash(t *testing.T) {
+	r := require.New(t)
+	_, err := New(ParallelHashOption(0))
+	r.Error(err)
+
+	rnd := rand.New(rand.NewSource(0))
+	for i := 0; i < 10; i++ {
+		ops := make([]byte, (2+_fuzzKeyLength)*(i+1)*40)
+		_, err := rnd.Read(ops)
+		r.NoError(err)
+		// narrow the keys down to collide on the prefixes
+		for j := 1; j < len(ops); j += 2 + _fuzzKeyLength {
+			ops[j] %= 4
+		}
+		checkRootHash(t, ops)
+	}
+}
+
+func FuzzRootHash(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 0, 0, 0, 1, 1, 3, 0, 0, 0, 2, 2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 0})
+	f.Add([]byte{3, 1, 2, 3, 4, 5, 3, 1, 2, 3, 5, 6, 3, 2, 2, 3, 4, 7, 0, 0, 0, 0, 0, 0, 2, 1, 2, 3, 5, 0})
+	f.Fuzz(checkRootHash)
+}
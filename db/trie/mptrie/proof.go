@@ -0,0 +1,291 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie"
+)
+
+// ErrInvalidProof is an error when a proof does not match the root hash
+var ErrInvalidProof = errors.New("invalid proof")
+
+// proofKVStore serves the nodes in a proof keyed by their hashes
+type proofKVStore struct {
+	trie.KVStore
+}
+
+func newProofKVStore(proof [][]byte, hashFunc HashFunc) (*proofKVStore, error) {
+	kv := trie.NewMemKVStore()
+	for _, n := range proof {
+		if err := kv.Put(hashFunc(n), n); err != nil {
+			return nil, err
+		}
+	}
+	return &proofKVStore{KVStore: kv}, nil
+}
+
+func (kv *proofKVStore) Get(key []byte) ([]byte, error) {
+	value, err := kv.KVStore.Get(key)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidProof, "node %x is missing in the proof", key)
+	}
+	return value, nil
+}
+
+// Prove generates the proof of the key against the root hash of the trie, which consists of the nodes
+// on the path to the key. The proof of an absent key ends at the node where the path diverges
+func Prove(tr trie.Trie, key []byte) ([][]byte, error) {
+	mpt, ok := tr.(*merklePatriciaTrie)
+	if !ok {
+		return nil, errors.New("trie is not supported type")
+	}
+	mpt.mutex.Lock()
+	defer mpt.mutex.Unlock()
+
+	kt, err := mpt.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		proof  [][]byte
+		n      node = mpt.root
+		offset uint8
+	)
+	for {
+		if hn, ok := n.(*hashNode); ok {
+			if n, err = hn.LoadNode(mpt); err != nil {
+				return nil, err
+			}
+		}
+		ser, err := serialize(mpt, n)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, ser)
+		switch node := n.(type) {
+		case *branchNode:
+			child, err := node.child(kt[offset])
+			if err != nil {
+				return proof, nil
+			}
+			n = child
+			offset++
+		case *extensionNode:
+			matched := node.commonPrefixLength(kt[offset:])
+			if matched != uint8(len(node.path)) {
+				return proof, nil
+			}
+			n = node.child
+			offset += matched
+		case *leafNode:
+			return proof, nil
+		default:
+			return nil, errors.New("unexpected node type")
+		}
+	}
+}
+
+// VerifyProof verifies the proof of the key against the root hash, and returns the value of the key.
+// It returns trie.ErrNotExist if the proof shows the key does not exist
+func VerifyProof(rootHash, key []byte, proof [][]byte, hashFunc HashFunc) ([]byte, error) {
+	tr, err := newProofTrie(rootHash, proof, hashFunc, KeyLengthOption(len(key)))
+	if err != nil {
+		return nil, err
+	}
+	return tr.Get(key)
+}
+
+// ProveRange returns the leaves with start <= key < end up to the limit, and the proof of them against the
+// root hash of the trie, which consists of the nodes walked through to collect them and the next leaf
+func ProveRange(tr trie.Trie, start, end []byte, limit int) ([][]byte, [][]byte, [][]byte, error) {
+	mpt, ok := tr.(*merklePatriciaTrie)
+	if !ok {
+		return nil, nil, nil, errors.New("trie is not supported type")
+	}
+	mpt.mutex.Lock()
+	defer mpt.mutex.Unlock()
+
+	var (
+		keys, values, proof [][]byte
+		iterLimit           = limit
+	)
+	if limit > 0 {
+		// walk to the next leaf, to show whether there are more leaves in the range
+		iterLimit++
+	}
+	iter := newRangeIterator(mpt, mpt.root, start, end, iterLimit)
+	iter.visit = func(n node) error {
+		ser, err := serialize(mpt, n)
+		if err != nil {
+			return err
+		}
+		proof = append(proof, ser)
+		return nil
+	}
+	for {
+		key, value, err := iter.Next()
+		if errors.Cause(err) == trie.ErrEndOfIterator {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if limit == 0 || len(keys) < limit {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+	}
+	return keys, values, proof, nil
+}
+
+// VerifyRangeProof verifies the proof that the keys and values are all the leaves from start in the trie of
+// the root hash, and returns whether there are more leaves after them before end
+func VerifyRangeProof(rootHash, start, end []byte, keys, values, proof [][]byte, hashFunc HashFunc) (bool, error) {
+	if len(keys) != len(values) {
+		return false, errors.Errorf("%d keys do not match %d values", len(keys), len(values))
+	}
+	tr, err := newProofTrie(rootHash, proof, hashFunc)
+	if err != nil {
+		return false, err
+	}
+	iter := newRangeIterator(tr, tr.root, start, end, len(keys)+1)
+	for i := 0; ; i++ {
+		key, value, err := iter.Next()
+		if errors.Cause(err) == trie.ErrEndOfIterator {
+			if i != len(keys) {
+				return false, errors.Wrapf(ErrInvalidProof, "%d leaves are in the range, %d expected", i, len(keys))
+			}
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if i == len(keys) {
+			return true, nil
+		}
+		if !bytes.Equal(key, keys[i]) || !bytes.Equal(value, values[i]) {
+			return false, errors.Wrapf(ErrInvalidProof, "leaf %x does not match the proof", keys[i])
+		}
+	}
+}
+
+// newProofTrie creates a read-only trie of the root hash on the nodes in the proof
+func newProofTrie(rootHash []byte, proof [][]byte, hashFunc HashFunc, opts ...Option) (*merklePatriciaTrie, error) {
+	kv, err := newProofKVStore(proof, hashFunc)
+	if err != nil {
+		return nil, err
+	}
+	// the nodes must all come from the proof rather than the node cache
+	tr, err := New(append([]Option{
+		KVStoreOption(kv), HashFuncOption(hashFunc), RootHashOption(rootHash), NodeCacheOption(nil),
+	}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return tr.(*merklePatriciaTrie), nil
+}
+
+func serialize(cli client, n node) ([]byte, error) {
+	sn, ok := n.(serializable)
+	if !ok {
+		return nil, errors.New("unexpected node type")
+	}
+	pb, err := sn.proto(cli, false)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// ProveLayerTwo generates the proof of the key in the layer two trie against the root hash of the two layer
+// trie, which consists of the proofs in both layers. It commits the pending changes of the trie first
+func ProveLayerTwo(tr trie.TwoLayerTrie, layerOneKey, layerTwoKey []byte) ([][]byte, error) {
+	tlt, ok := tr.(*twoLayerTrie)
+	if !ok {
+		return nil, errors.New("trie is not supported type")
+	}
+	proof, err := tlt.proveLayerOne(layerOneKey)
+	if err != nil {
+		return nil, err
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
+	if err != nil {
+		return nil, err
+	}
+	layerTwoProof, err := Prove(lt.tr, layerTwoKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(proof, layerTwoProof...), nil
+}
+
+// VerifyLayerTwoProof verifies the proof of the key in the layer two trie against the root hash of the two
+// layer trie, and returns the value of the key
+func VerifyLayerTwoProof(rootHash, layerOneKey, layerTwoKey []byte, proof [][]byte) ([]byte, error) {
+	layerTwoRoot, err := VerifyProof(rootHash, layerOneKey, proof, DefaultHashFunc)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyProof(layerTwoRoot, layerTwoKey, proof, DefaultHashFunc)
+}
+
+// ProveLayerTwoRange returns the leaves in the range of the layer two trie, and the proof of them against
+// the root hash of the two layer trie. It commits the pending changes of the trie first
+func ProveLayerTwoRange(tr trie.TwoLayerTrie, layerOneKey []byte, l int, start, end []byte, limit int) ([][]byte, [][]byte, [][]byte, error) {
+	tlt, ok := tr.(*twoLayerTrie)
+	if !ok {
+		return nil, nil, nil, errors.New("trie is not supported type")
+	}
+	proof, err := tlt.proveLayerOne(layerOneKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, l)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keys, values, layerTwoProof, err := ProveRange(lt.tr, start, end, limit)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return keys, values, append(proof, layerTwoProof...), nil
+}
+
+// VerifyLayerTwoRangeProof verifies the proof of the leaves in the range of the layer two trie against the
+// root hash of the two layer trie, and returns whether there are more leaves after them before end
+func VerifyLayerTwoRangeProof(rootHash, layerOneKey, start, end []byte, keys, values, proof [][]byte) (bool, error) {
+	layerTwoRoot, err := VerifyProof(rootHash, layerOneKey, proof, DefaultHashFunc)
+	switch errors.Cause(err) {
+	case nil:
+		return VerifyRangeProof(layerTwoRoot, start, end, keys, values, proof, DefaultHashFunc)
+	case trie.ErrNotExist:
+		// the layer two trie is empty
+		if len(keys) != 0 || len(values) != 0 {
+			return false, errors.Wrapf(ErrInvalidProof, "layer two trie of %x does not exist", layerOneKey)
+		}
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// proveLayerOne commits the pending changes of the layer two tries, and generates the proof of the layer
+// one key
+func (tlt *twoLayerTrie) proveLayerOne(layerOneKey []byte) ([][]byte, error) {
+	if _, err := tlt.RootHash(); err != nil {
+		return nil, err
+	}
+	return Prove(tlt.layerOne, layerOneKey)
+}
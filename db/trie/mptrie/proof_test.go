@@ -0,0 +1,191 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie"
+)
+
+func TestRangeAndProof(t *testing.T) {
+	for _, async := range []bool{false, true} {
+		r := require.New(t)
+		opts := []Option{KVStoreOption(trie.NewMemKVStore()), KeyLengthOption(_fuzzKeyLength)}
+		if async {
+			opts = append(opts, AsyncOption())
+		}
+		tr, err := New(opts...)
+		r.NoError(err)
+		r.NoError(tr.Start(context.Background()))
+
+		// an empty trie proves the absence of any key
+		rootHash, err := tr.RootHash()
+		r.NoError(err)
+		proof, err := Prove(tr, []byte{1, 2, 3, 4})
+		r.NoError(err)
+		_, err = VerifyProof(rootHash, []byte{1, 2, 3, 4}, proof, DefaultHashFunc)
+		r.Equal(trie.ErrNotExist, errors.Cause(err))
+		keys, values, proof, err := ProveRange(tr, nil, nil, 0)
+		r.NoError(err)
+		r.Empty(keys)
+		more, err := VerifyRangeProof(rootHash, nil, nil, keys, values, proof, DefaultHashFunc)
+		r.NoError(err)
+		r.False(more)
+
+		rnd := rand.New(rand.NewSource(1))
+		kvs := map[string][]byte{}
+		for i := 0; i < 200; i++ {
+			key := make([]byte, _fuzzKeyLength)
+			_, err := rnd.Read(key)
+			r.NoError(err)
+			// narrow the keys down to collide on the prefixes
+			key[0] %= 4
+			key[1] %= 8
+			value := []byte{byte(i), byte(i >> 8)}
+			r.NoError(tr.Upsert(key, value))
+			kvs[string(key)] = value
+		}
+		sorted := make([]string, 0, len(kvs))
+		for k := range kvs {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		rootHash, err = tr.RootHash()
+		r.NoError(err)
+
+		for _, e := range []struct {
+			start, end []byte
+			limit      int
+		}{
+			{nil, nil, 0},
+			{nil, nil, 10},
+			{[]byte{1, 0, 0, 0}, []byte{2, 0, 0, 0}, 0},
+			{[]byte{1, 3}, []byte{2, 5, 7}, 0},
+			{[]byte{1, 3, 0, 0}, nil, 7},
+			{[]byte{3, 7, 255, 255}, nil, 0},
+			{[]byte{2, 0, 0, 0}, []byte{2, 0, 0, 0}, 0},
+		} {
+			var expected []string
+			for _, k := range sorted {
+				if bytes.Compare([]byte(k), e.start) >= 0 && (e.end == nil || bytes.Compare([]byte(k), e.end) < 0) {
+					expected = append(expected, k)
+				}
+			}
+			hasMore := e.limit > 0 && len(expected) > e.limit
+			if hasMore {
+				expected = expected[:e.limit]
+			}
+			iter, err := NewRangeIterator(tr, e.start, e.end, e.limit)
+			r.NoError(err)
+			for _, k := range expected {
+				key, value, err := iter.Next()
+				r.NoError(err)
+				r.Equal(k, string(key))
+				r.Equal(kvs[k], value)
+			}
+			_, _, err = iter.Next()
+			r.Equal(trie.ErrEndOfIterator, errors.Cause(err))
+
+			keys, values, proof, err := ProveRange(tr, e.start, e.end, e.limit)
+			r.NoError(err)
+			r.Len(keys, len(expected))
+			more, err := VerifyRangeProof(rootHash, e.start, e.end, keys, values, proof, DefaultHashFunc)
+			r.NoError(err)
+			r.Equal(hasMore, more)
+			if len(keys) > 0 {
+				// a leaf left out or a tampered value fails the verification
+				_, err = VerifyRangeProof(rootHash, e.start, e.end, keys[1:], values[1:], proof, DefaultHashFunc)
+				r.Equal(ErrInvalidProof, errors.Cause(err))
+				tampered := append([][]byte{{0}}, values[1:]...)
+				_, err = VerifyRangeProof(rootHash, e.start, e.end, keys, tampered, proof, DefaultHashFunc)
+				r.Equal(ErrInvalidProof, errors.Cause(err))
+				_, err = VerifyRangeProof(rootHash, e.start, e.end, keys, values, proof[1:], DefaultHashFunc)
+				r.Equal(ErrInvalidProof, errors.Cause(err))
+			}
+		}
+
+		for _, k := range sorted[:20] {
+			proof, err := Prove(tr, []byte(k))
+			r.NoError(err)
+			value, err := VerifyProof(rootHash, []byte(k), proof, DefaultHashFunc)
+			r.NoError(err)
+			r.Equal(kvs[k], value)
+			_, err = VerifyProof(rootHash, []byte(k), proof[:len(proof)-1], DefaultHashFunc)
+			r.Equal(ErrInvalidProof, errors.Cause(err))
+			// the proof of a key does not prove the others
+			_, err = VerifyProof(rootHash, []byte(sorted[len(sorted)-1]), proof, DefaultHashFunc)
+			r.Error(err)
+		}
+		for _, k := range [][]byte{{0, 0, 0, 0}, {3, 7, 255, 255}, {4, 0, 0, 0}} {
+			r.NotContains(kvs, string(k))
+			proof, err := Prove(tr, k)
+			r.NoError(err)
+			_, err = VerifyProof(rootHash, k, proof, DefaultHashFunc)
+			r.Equal(trie.ErrNotExist, errors.Cause(err))
+		}
+	}
+}
+
+func TestTwoLayerTrieRangeAndProof(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	tlt := NewTwoLayerTrie(trie.NewMemKVStore(), "root")
+	r.NoError(tlt.Start(ctx))
+	defer func() {
+		r.NoError(tlt.Stop(ctx))
+	}()
+
+	layerOneKey := []byte("00000000000000000001")
+	for i := byte(0); i < 10; i++ {
+		r.NoError(tlt.Upsert(layerOneKey, []byte{i, i}, []byte{i}))
+	}
+	r.NoError(tlt.Upsert([]byte("00000000000000000002"), []byte{0, 0}, []byte{0}))
+	rootHash, err := tlt.RootHash()
+	r.NoError(err)
+
+	iter, err := NewLayerTwoRangeIterator(tlt, layerOneKey, 2, []byte{3, 3}, []byte{6, 6}, 0)
+	r.NoError(err)
+	for i := byte(3); i < 6; i++ {
+		key, value, err := iter.Next()
+		r.NoError(err)
+		r.Equal([]byte{i, i}, key)
+		r.Equal([]byte{i}, value)
+	}
+	_, _, err = iter.Next()
+	r.Equal(trie.ErrEndOfIterator, errors.Cause(err))
+
+	proof, err := ProveLayerTwo(tlt, layerOneKey, []byte{5, 5})
+	r.NoError(err)
+	value, err := VerifyLayerTwoProof(rootHash, layerOneKey, []byte{5, 5}, proof)
+	r.NoError(err)
+	r.Equal([]byte{5}, value)
+	proof, err = ProveLayerTwo(tlt, layerOneKey, []byte{5, 6})
+	r.NoError(err)
+	_, err = VerifyLayerTwoProof(rootHash, layerOneKey, []byte{5, 6}, proof)
+	r.Equal(trie.ErrNotExist, errors.Cause(err))
+
+	keys, values, proof, err := ProveLayerTwoRange(tlt, layerOneKey, 2, []byte{3, 3}, nil, 4)
+	r.NoError(err)
+	r.Equal([][]byte{{3, 3}, {4, 4}, {5, 5}, {6, 6}}, keys)
+	more, err := VerifyLayerTwoRangeProof(rootHash, layerOneKey, []byte{3, 3}, nil, keys, values, proof)
+	r.NoError(err)
+	r.True(more)
+	absent := []byte("00000000000000000003")
+	keys, values, proof, err = ProveLayerTwoRange(tlt, absent, 2, nil, nil, 0)
+	r.NoError(err)
+	r.Empty(keys)
+	more, err = VerifyLayerTwoRangeProof(rootHash, absent, nil, nil, keys, values, proof)
+	r.NoError(err)
+	r.False(more)
+}
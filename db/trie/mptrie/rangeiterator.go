@@ -0,0 +1,115 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/db/trie"
+)
+
+type (
+	// RangeIterator defines an iterator to go through the leaves in [start, end) in key order
+	RangeIterator struct {
+		cli   client
+		stack []rangeEntry
+		start []byte
+		end   []byte
+		limit int
+		count int
+		// visit is called with every node the iterator walks through
+		visit func(node) error
+	}
+
+	rangeEntry struct {
+		n      node
+		prefix []byte
+	}
+)
+
+// NewRangeIterator returns a new iterator of the leaves with start <= key < end in key order. A nil start or
+// end leaves the range unbounded on that side, and a limit of 0 returns all the leaves in the range
+func NewRangeIterator(tr trie.Trie, start, end []byte, limit int) (trie.Iterator, error) {
+	mpt, ok := tr.(*merklePatriciaTrie)
+	if !ok {
+		return nil, errors.New("trie is not supported type")
+	}
+	return newRangeIterator(mpt, mpt.root, start, end, limit), nil
+}
+
+func newRangeIterator(cli client, root node, start, end []byte, limit int) *RangeIterator {
+	return &RangeIterator{
+		cli:   cli,
+		stack: []rangeEntry{{n: root}},
+		start: start,
+		end:   end,
+		limit: limit,
+	}
+}
+
+// Next moves iterator to next leaf
+func (ri *RangeIterator) Next() ([]byte, []byte, error) {
+	for len(ri.stack) > 0 && (ri.limit == 0 || ri.count < ri.limit) {
+		size := len(ri.stack)
+		e := ri.stack[size-1]
+		ri.stack = ri.stack[:size-1]
+		n := e.n
+		if hn, ok := n.(*hashNode); ok {
+			var err error
+			if n, err = hn.LoadNode(ri.cli); err != nil {
+				return nil, nil, err
+			}
+		}
+		if ri.visit != nil {
+			if err := ri.visit(n); err != nil {
+				return nil, nil, err
+			}
+		}
+		switch node := n.(type) {
+		case leaf:
+			key := node.Key()
+			if bytes.Compare(key, ri.start) < 0 {
+				continue
+			}
+			if ri.end != nil && bytes.Compare(key, ri.end) >= 0 {
+				// the leaves left in the stack are all after this one
+				ri.stack = nil
+				return nil, nil, trie.ErrEndOfIterator
+			}
+			ri.count++
+			value := node.Value()
+			return append(key[:0:0], key...), append(value[:0:0], value...), nil
+		case *branchNode:
+			// push the children in descending order, so they are popped in key order
+			indices := node.indices.List()
+			for i := len(indices) - 1; i >= 0; i-- {
+				prefix := append(e.prefix[:len(e.prefix):len(e.prefix)], indices[i])
+				if ri.inRange(prefix) {
+					ri.stack = append(ri.stack, rangeEntry{n: node.children[indices[i]], prefix: prefix})
+				}
+			}
+		case *extensionNode:
+			prefix := append(e.prefix[:len(e.prefix):len(e.prefix)], node.path...)
+			if ri.inRange(prefix) {
+				ri.stack = append(ri.stack, rangeEntry{n: node.child, prefix: prefix})
+			}
+		default:
+			return nil, nil, errors.New("unexpected node type")
+		}
+	}
+
+	return nil, nil, trie.ErrEndOfIterator
+}
+
+// inRange returns false if the keys with the prefix are all out of the range
+func (ri *RangeIterator) inRange(prefix []byte) bool {
+	if len(ri.start) > 0 && bytes.Compare(prefix, ri.start[:min(len(prefix), len(ri.start))]) < 0 {
+		return false
+	}
+	return ri.end == nil || bytes.Compare(prefix, ri.end[:min(len(prefix), len(ri.end))]) <= 0
+}
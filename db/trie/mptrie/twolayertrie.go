@@ -43,7 +43,7 @@ func (tlt *twoLayerTrie) layerTwoTrie(key []byte, layerTwoTrieKeyLen int) (*laye
 	if lt, ok := tlt.layerTwoMap[hk]; ok {
 		return lt, nil
 	}
-	opts := []Option{KVStoreOption(tlt.kvStore), KeyLengthOption(layerTwoTrieKeyLen), AsyncOption()}
+	opts := []Option{KVStoreOption(tlt.kvStore), KeyLengthOption(layerTwoTrieKeyLen), AsyncOption(), ParallelHashOption(ParallelHashThreshold)}
 	value, err := tlt.layerOne.Get(key)
 	switch errors.Cause(err) {
 	case trie.ErrNotExist:
@@ -84,6 +84,7 @@ func (tlt *twoLayerTrie) Start(ctx context.Context) error {
 		KVStoreOption(tlt.kvStore),
 		RootHashOption(rootHash),
 		AsyncOption(),
+		ParallelHashOption(ParallelHashThreshold),
 	)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate trie for %s", tlt.rootKey)
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"time"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
+)
+
+// ErrInvalidAggregate indicates the aggregate endorsement is malformed
+var ErrInvalidAggregate = errors.New("invalid aggregate endorsement")
+
+// AggregateEndorsement is the BLS signatures of the endorsements of a document at the same time aggregated into one.
+// The endorsers are marked in the bitmap by their indexes in an ordered list of endorsers, the delegates of the epoch
+// for the consensus endorsements
+type AggregateEndorsement struct {
+	bitmap    []byte
+	signature []byte
+}
+
+// NewAggregateEndorsement creates a new AggregateEndorsement
+func NewAggregateEndorsement(bitmap, signature []byte) *AggregateEndorsement {
+	return &AggregateEndorsement{
+		bitmap:    append([]byte{}, bitmap...),
+		signature: append([]byte{}, signature...),
+	}
+}
+
+// Aggregate aggregates the BLS signatures of the endorsements, indexes are the positions of the endorsers in the list
+// of size endorsers
+func Aggregate(ens []*Endorsement, indexes []int, size int) (*AggregateEndorsement, error) {
+	if len(ens) == 0 || len(ens) != len(indexes) {
+		return nil, errors.Wrap(ErrInvalidAggregate, "mismatched endorsements and indexes")
+	}
+	bitmap := make([]byte, (size+7)/8)
+	sigs := make([]*bls.Signature, 0, len(ens))
+	for i, en := range ens {
+		idx := indexes[i]
+		if idx < 0 || idx >= size {
+			return nil, errors.Wrapf(ErrInvalidAggregate, "endorser index %d out of range", idx)
+		}
+		if bitmap[idx/8]&(1<<(idx%8)) != 0 {
+			return nil, errors.Wrapf(ErrInvalidAggregate, "duplicate endorser index %d", idx)
+		}
+		if !en.Timestamp().Equal(ens[0].Timestamp()) {
+			return nil, errors.Wrap(ErrInvalidAggregate, "endorsements at different time")
+		}
+		sig, err := bls.BytesToSignature(en.blsSignature)
+		if err != nil {
+			return nil, err
+		}
+		bitmap[idx/8] |= 1 << (idx % 8)
+		sigs = append(sigs, sig)
+	}
+	agg, err := bls.Aggregate(sigs...)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregateEndorsement{
+		bitmap:    bitmap,
+		signature: agg.Bytes(),
+	}, nil
+}
+
+// VerifyAggregateEndorsement checks the aggregate signature against the document endorsed at the time, pks are the
+// keys of the endorsers marked in the bitmap, in the order of their indexes
+func VerifyAggregateEndorsement(doc Document, ts time.Time, agg *AggregateEndorsement, pks []*bls.PublicKey) bool {
+	sig, err := bls.BytesToSignature(agg.signature)
+	if err != nil {
+		return false
+	}
+	hash, err := hashDocWithTime(doc, ts)
+	if err != nil {
+		return false
+	}
+	return bls.FastAggregateVerify(pks, hash, sig)
+}
+
+// Bitmap returns the bitmap of the endorsers
+func (agg *AggregateEndorsement) Bitmap() []byte {
+	return append([]byte{}, agg.bitmap...)
+}
+
+// Signature returns the aggregate BLS signature
+func (agg *AggregateEndorsement) Signature() []byte {
+	return append([]byte{}, agg.signature...)
+}
+
+// Endorsers returns the indexes of the endorsers marked in the bitmap, in ascending order, size is the number of the
+// endorsers in the list
+func (agg *AggregateEndorsement) Endorsers(size int) ([]int, error) {
+	if len(agg.bitmap) != (size+7)/8 {
+		return nil, errors.Wrapf(ErrInvalidAggregate, "bitmap of %d bytes mismatches %d endorsers", len(agg.bitmap), size)
+	}
+	indexes := []int{}
+	for i := 0; i < len(agg.bitmap)*8; i++ {
+		if agg.bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= size {
+			return nil, errors.Wrapf(ErrInvalidAggregate, "endorser index %d out of range", i)
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+// Proto converts the aggregate endorsement to protobuf message
+func (agg *AggregateEndorsement) Proto() *iotextypes.AggregateEndorsement {
+	return &iotextypes.AggregateEndorsement{
+		Bitmap:    agg.Bitmap(),
+		Signature: agg.Signature(),
+	}
+}
+
+// LoadProto converts a protobuf message to the aggregate endorsement
+func (agg *AggregateEndorsement) LoadProto(pb *iotextypes.AggregateEndorsement) error {
+	if len(pb.GetBitmap()) == 0 || len(pb.GetSignature()) == 0 {
+		return errors.Wrap(ErrInvalidAggregate, "missing bitmap or signature")
+	}
+	agg.bitmap = append([]byte{}, pb.GetBitmap()...)
+	agg.signature = append([]byte{}, pb.GetSignature()...)
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package endorsement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
+)
+
+type testDoc []byte
+
+func (doc testDoc) Hash() ([]byte, error) { return doc, nil }
+
+func TestAggregateEndorsement(t *testing.T) {
+	r := require.New(t)
+	const size = 10
+	var (
+		doc     = testDoc("block hash")
+		ts      = time.Now()
+		sks     = make([]crypto.PrivateKey, size)
+		blsKeys = make([]*bls.PrivateKey, size)
+	)
+	for i := 0; i < size; i++ {
+		sk, err := crypto.GenerateKey()
+		r.NoError(err)
+		sks[i] = sk
+		blsKeys[i], err = bls.GenerateKey()
+		r.NoError(err)
+	}
+	ens, err := Endorse(doc, ts, sks...)
+	r.NoError(err)
+	for i, en := range ens {
+		r.NoError(SignBLS(doc, en, blsKeys[i]))
+		r.True(VerifyBLSEndorsement(doc, en, blsKeys[i].PublicKey()))
+	}
+	pubKeys := func(indexes ...int) []*bls.PublicKey {
+		pks := make([]*bls.PublicKey, 0, len(indexes))
+		for _, i := range indexes {
+			pks = append(pks, blsKeys[i].PublicKey())
+		}
+		return pks
+	}
+
+	t.Run("aggregate", func(t *testing.T) {
+		r := require.New(t)
+		indexes := []int{0, 3, 8, 9}
+		agg, err := Aggregate([]*Endorsement{ens[0], ens[3], ens[8], ens[9]}, indexes, size)
+		r.NoError(err)
+		r.Equal([]byte{0x09, 0x03}, agg.Bitmap())
+		endorsers, err := agg.Endorsers(size)
+		r.NoError(err)
+		r.Equal(indexes, endorsers)
+		r.True(VerifyAggregateEndorsement(doc, ts, agg, pubKeys(indexes...)))
+		// the signature is bound to the document, the time and every endorser
+		r.False(VerifyAggregateEndorsement(testDoc("another hash"), ts, agg, pubKeys(indexes...)))
+		r.False(VerifyAggregateEndorsement(doc, ts.Add(time.Second), agg, pubKeys(indexes...)))
+		r.False(VerifyAggregateEndorsement(doc, ts, agg, pubKeys(0, 3, 8)))
+		r.False(VerifyAggregateEndorsement(doc, ts, agg, pubKeys(0, 3, 8, 7)))
+
+		agg2 := &AggregateEndorsement{}
+		r.NoError(agg2.LoadProto(agg.Proto()))
+		r.Equal(agg, agg2)
+	})
+
+	t.Run("bitmap bounds", func(t *testing.T) {
+		r := require.New(t)
+		_, err := Aggregate(ens[:2], []int{0, size}, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		_, err = Aggregate(ens[:2], []int{-1, 1}, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		_, err = Aggregate(ens[:2], []int{1, 1}, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		_, err = Aggregate(ens[:2], []int{1}, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		_, err = Aggregate(nil, nil, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+
+		agg, err := Aggregate(ens[:2], []int{0, 1}, size)
+		r.NoError(err)
+		// the bitmap of 2 bytes mismatches the number of endorsers
+		_, err = agg.Endorsers(size + 8)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		_, err = agg.Endorsers(8)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		// a bit set beyond the number of endorsers
+		_, err = NewAggregateEndorsement([]byte{0x01, 0x04}, agg.Signature()).Endorsers(size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		r := require.New(t)
+		en, err := Endorse(doc, ts, sks[0])
+		r.NoError(err)
+		// no BLS signature attached
+		r.False(VerifyBLSEndorsement(doc, en[0], blsKeys[0].PublicKey()))
+		_, err = Aggregate(en, []int{0}, size)
+		r.ErrorIs(err, bls.ErrInvalidSignature)
+		// signed by another key
+		r.NoError(SignBLS(doc, en[0], blsKeys[1]))
+		r.False(VerifyBLSEndorsement(doc, en[0], blsKeys[0].PublicKey()))
+		agg, err := Aggregate(en, []int{0}, size)
+		r.NoError(err)
+		r.False(VerifyAggregateEndorsement(doc, ts, agg, pubKeys(0)))
+		// endorsed at another time
+		later, err := Endorse(doc, ts.Add(time.Second), sks[1])
+		r.NoError(err)
+		r.NoError(SignBLS(doc, later[0], blsKeys[1]))
+		_, err = Aggregate([]*Endorsement{ens[0], later[0]}, []int{0, 1}, size)
+		r.ErrorIs(err, ErrInvalidAggregate)
+		// malformed aggregate signature
+		r.False(VerifyAggregateEndorsement(doc, ts, NewAggregateEndorsement([]byte{0x01, 0x00}, []byte{1, 2, 3}), pubKeys(0)))
+	})
+
+	t.Run("malformed fields", func(t *testing.T) {
+		r := require.New(t)
+		b, err := proto.Marshal(ens[0].Proto())
+		r.NoError(err)
+		en := &Endorsement{}
+		pb := &iotextypes.Endorsement{}
+		r.NoError(proto.Unmarshal(b, pb))
+		r.NoError(en.LoadProto(pb))
+		r.Equal(ens[0].BLSSignature(), en.BLSSignature())
+		r.True(VerifyBLSEndorsement(doc, en, blsKeys[0].PublicKey()))
+		// a truncated BLS signature fails to decode
+		_, err = bls.BytesToSignature(en.BLSSignature()[1:])
+		r.ErrorIs(err, bls.ErrInvalidSignature)
+		r.Error(proto.Unmarshal(b[:len(b)-1], &iotextypes.Endorsement{}))
+
+		// the BLS signature field of a mismatched wire type is kept as an unknown field, not the signature
+		pb = ens[0].Proto()
+		pb.BlsSignature = nil
+		b, err = proto.Marshal(pb)
+		r.NoError(err)
+		b = protowire.AppendVarint(protowire.AppendTag(b, 4, protowire.VarintType), 1)
+		pb = &iotextypes.Endorsement{}
+		r.NoError(proto.Unmarshal(b, pb))
+		r.NotEmpty(pb.ProtoReflect().GetUnknown())
+		en = &Endorsement{}
+		r.NoError(en.LoadProto(pb))
+		r.Nil(en.BLSSignature())
+		r.False(VerifyBLSEndorsement(doc, en, blsKeys[0].PublicKey()))
+
+		// the aggregate misses the bitmap or the signature
+		agg, err := Aggregate(ens[:1], []int{0}, size)
+		r.NoError(err)
+		for _, pb := range []*iotextypes.AggregateEndorsement{
+			{Signature: agg.Signature()},
+			{Bitmap: agg.Bitmap()},
+			{},
+		} {
+			r.ErrorIs((&AggregateEndorsement{}).LoadProto(pb), ErrInvalidAggregate)
+		}
+	})
+}
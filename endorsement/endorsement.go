@@ -13,6 +13,7 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/iotexproject/iotex-core/v2/crypto/bls"
 	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 )
 
@@ -24,9 +25,10 @@ type (
 
 	// Endorsement defines an endorsement with timestamp
 	Endorsement struct {
-		ts        time.Time
-		endorser  crypto.PublicKey
-		signature []byte
+		ts           time.Time
+		endorser     crypto.PublicKey
+		signature    []byte
+		blsSignature []byte
 	}
 
 	// EndorsedDocument is an signed document
@@ -98,6 +100,37 @@ func VerifyEndorsement(doc Document, en *Endorsement) bool {
 	return en.Endorser().Verify(hash, en.Signature())
 }
 
+// SignBLS signs the document with the BLS key at the time of the endorsement, and attaches the signature to the
+// endorsement
+func SignBLS(doc Document, en *Endorsement, sk *bls.PrivateKey) error {
+	hash, err := hashDocWithTime(doc, en.Timestamp())
+	if err != nil {
+		return err
+	}
+	sig, err := sk.Sign(hash)
+	if err != nil {
+		return err
+	}
+	en.blsSignature = sig.Bytes()
+	return nil
+}
+
+// VerifyBLSEndorsement checks the BLS signature in an endorsement against a document
+func VerifyBLSEndorsement(doc Document, en *Endorsement, pk *bls.PublicKey) bool {
+	if len(en.blsSignature) == 0 {
+		return false
+	}
+	sig, err := bls.BytesToSignature(en.blsSignature)
+	if err != nil {
+		return false
+	}
+	hash, err := hashDocWithTime(doc, en.Timestamp())
+	if err != nil {
+		return false
+	}
+	return pk.Verify(hash, sig)
+}
+
 // Timestamp returns the signature time
 func (en *Endorsement) Timestamp() time.Time {
 	return en.ts
@@ -116,13 +149,25 @@ func (en *Endorsement) Signature() []byte {
 	return signature
 }
 
+// BLSSignature returns the BLS signature of this endorsement, nil if it is not signed by a BLS key
+func (en *Endorsement) BLSSignature() []byte {
+	if len(en.blsSignature) == 0 {
+		return nil
+	}
+	signature := make([]byte, len(en.blsSignature))
+	copy(signature, en.blsSignature)
+
+	return signature
+}
+
 // Proto converts an endorsement to protobuf message
 func (en *Endorsement) Proto() *iotextypes.Endorsement {
 	ts := timestamppb.New(en.ts)
 	return &iotextypes.Endorsement{
-		Timestamp: ts,
-		Endorser:  en.endorser.Bytes(),
-		Signature: en.Signature(),
+		Timestamp:    ts,
+		Endorser:     en.endorser.Bytes(),
+		Signature:    en.Signature(),
+		BlsSignature: en.BLSSignature(),
 	}
 }
 
@@ -139,6 +184,10 @@ func (en *Endorsement) LoadProto(ePb *iotextypes.Endorsement) (err error) {
 	}
 	en.signature = make([]byte, len(ePb.Signature))
 	copy(en.signature, ePb.Signature)
+	if len(ePb.BlsSignature) > 0 {
+		en.blsSignature = make([]byte, len(ePb.BlsSignature))
+		copy(en.blsSignature, ePb.BlsSignature)
+	}
 
 	return nil
 }
@@ -10,6 +10,7 @@ require (
 	github.com/cenkalti/backoff v2.2.1+incompatible
 	github.com/cespare/xxhash/v2 v2.3.0
 	github.com/cockroachdb/pebble v0.0.0-20230928194634-aa077af62593
+	github.com/consensys/gnark-crypto v0.12.1
 	github.com/erigontech/erigon v1.9.7-0.20250305121304-76181961ed24
 	github.com/erigontech/erigon-lib v1.0.0
 	github.com/ethereum-optimism/go-ethereum-hdwallet v0.1.3
@@ -33,6 +34,7 @@ require (
 	github.com/iotexproject/iotex-election v0.3.7
 	github.com/iotexproject/iotex-proto v0.6.4
 	github.com/ipfs/go-ipfs-api v0.7.0
+	github.com/klauspost/compress v1.17.11
 	github.com/libp2p/go-libp2p v0.39.0
 	github.com/mackerelio/go-osstat v0.2.4
 	github.com/minio/blake2b-simd v0.0.0-20160723061019-3f5f724cb5b1
@@ -91,7 +93,6 @@ require (
 	github.com/cockroachdb/sentry-go v0.6.1-cockroachdb.2 // indirect
 	github.com/cockroachdb/tokenbucket v0.0.0-20230807174530-cc333fc44b06 // indirect
 	github.com/consensys/bavard v0.1.13 // indirect
-	github.com/consensys/gnark-crypto v0.12.1 // indirect
 	github.com/containerd/cgroups v1.1.0 // indirect
 	github.com/containerd/cgroups/v3 v3.0.3 // indirect
 	github.com/coreos/go-systemd/v22 v22.5.0 // indirect
@@ -117,7 +118,6 @@ require (
 	github.com/gofrs/flock v0.8.1 // indirect
 	github.com/google/btree v1.1.2 // indirect
 	github.com/google/pprof v0.0.0-20250202011525-fc3143867406 // indirect
-	github.com/klauspost/compress v1.17.11 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
 	github.com/kr/text v0.2.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
@@ -291,6 +291,9 @@ replace golang.org/x/xerrors => golang.org/x/xerrors v0.0.0-20190212162355-a5947
 
 replace github.com/iotexproject/go-pkgs => github.com/iotexproject/go-pkgs v0.1.15-0.20250409042536-4996a88dd629
 
+// iotex-proto with the message fields which are not in a tagged release yet, see third_party/README.md
+replace github.com/iotexproject/iotex-proto => ./third_party/iotex-proto
+
 // replace github.com/erigontech/erigon => github.com/erigontech/erigon v1.9.7-0.20250305121304-76181961ed24
 
 replace github.com/erigontech/erigon-lib => github.com/erigontech/erigon/erigon-lib v0.0.0-20250305121304-76181961ed24
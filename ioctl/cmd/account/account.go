@@ -84,6 +84,8 @@ func init() {
 	AccountCmd.AddCommand(_accountUpdateCmd)
 	AccountCmd.AddCommand(_accountVerifyCmd)
 	AccountCmd.AddCommand(_accountActionsCmd)
+	AccountCmd.AddCommand(_accountBalanceHistoryCmd)
+	AccountCmd.AddCommand(_accountBalanceAtCmd)
 	AccountCmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint",
 		config.ReadConfig.Endpoint, config.TranslateInLang(_flagEndpoint, config.UILanguage))
 	AccountCmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure, config.TranslateInLang(_flagInsecure, config.UILanguage))
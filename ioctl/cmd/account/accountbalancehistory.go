@@ -0,0 +1,235 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory/balancehistorypb"
+	"github.com/iotexproject/iotex-core/v2/ioctl/config"
+	"github.com/iotexproject/iotex-core/v2/ioctl/flag"
+	"github.com/iotexproject/iotex-core/v2/ioctl/output"
+	"github.com/iotexproject/iotex-core/v2/ioctl/util"
+)
+
+// Multi-language support
+var (
+	_balanceHistoryCmdUses = map[config.Language]string{
+		config.English: "balancehistory (ALIAS|ADDRESS) [-o OFFSET] [-l LIMIT]",
+		config.Chinese: "balancehistory (别名|地址) [-o 偏移] [-l 数量]",
+	}
+	_balanceHistoryCmdShorts = map[config.Language]string{
+		config.English: "Show the balance changes of an account",
+		config.Chinese: "显示账户的余额变动记录",
+	}
+	_balanceAtCmdUses = map[config.Language]string{
+		config.English: "balanceat (ALIAS|ADDRESS) [--height HEIGHT | --time TIME]",
+		config.Chinese: "balanceat (别名|地址) [--height 高度 | --time 时间]",
+	}
+	_balanceAtCmdShorts = map[config.Language]string{
+		config.English: "Get the balance of an account at a height or time (RFC3339)",
+		config.Chinese: "查询账户在某高度或某时间(RFC3339)的余额",
+	}
+)
+
+var (
+	_balanceHistoryOffsetFlag = flag.NewUint64VarP("offset", "o", 0, "offset of the first balance change")
+	_balanceHistoryLimitFlag  = flag.NewUint64VarP("limit", "l", 100, "number of balance changes to show")
+	_balanceAtHeightFlag      = flag.NewUint64VarP("height", "", 0, "block height")
+	_balanceAtTimeFlag        = flag.NewStringVarP("time", "", "", "time in RFC3339 format, e.g. 2025-01-02T15:04:05Z")
+)
+
+// _accountBalanceHistoryCmd represents the account balancehistory command
+var _accountBalanceHistoryCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_balanceHistoryCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_balanceHistoryCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := balanceHistory(args[0], _balanceHistoryOffsetFlag.Value().(uint64), _balanceHistoryLimitFlag.Value().(uint64))
+		return output.PrintError(err)
+	},
+}
+
+// _accountBalanceAtCmd represents the account balanceat command
+var _accountBalanceAtCmd = &cobra.Command{
+	Use:   config.TranslateInLang(_balanceAtCmdUses, config.UILanguage),
+	Short: config.TranslateInLang(_balanceAtCmdShorts, config.UILanguage),
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := balanceAt(args[0], _balanceAtHeightFlag.Value().(uint64), _balanceAtTimeFlag.Value().(string))
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	_balanceHistoryOffsetFlag.RegisterCommand(_accountBalanceHistoryCmd)
+	_balanceHistoryLimitFlag.RegisterCommand(_accountBalanceHistoryCmd)
+	_balanceAtHeightFlag.RegisterCommand(_accountBalanceAtCmd)
+	_balanceAtTimeFlag.RegisterCommand(_accountBalanceAtCmd)
+}
+
+type (
+	balanceChangeMessage struct {
+		Height     uint64 `json:"height"`
+		Time       string `json:"time"`
+		ActionHash string `json:"actionHash"`
+		Amount     string `json:"amount"`
+		Type       string `json:"type"`
+	}
+
+	balanceHistoryMessage struct {
+		Address string                  `json:"address"`
+		Total   uint64                  `json:"total"`
+		Changes []*balanceChangeMessage `json:"changes"`
+	}
+
+	balanceAtMessage struct {
+		Address string `json:"address"`
+		Height  uint64 `json:"height"`
+		Balance string `json:"balance"`
+	}
+)
+
+func balanceHistory(arg string, offset, limit uint64) error {
+	addr, err := util.Address(arg)
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get address", err)
+	}
+	ctx, cli, closeConn, err := balanceHistoryClient()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	response, err := cli.GetBalanceChanges(ctx, &balancehistorypb.GetBalanceChangesRequest{
+		Address: addr,
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		return balanceHistoryAPIError(err, "GetBalanceChanges")
+	}
+	message := balanceHistoryMessage{
+		Address: addr,
+		Total:   response.Total,
+		Changes: make([]*balanceChangeMessage, 0, len(response.Changes)),
+	}
+	for _, c := range response.Changes {
+		amount, ok := new(big.Int).SetString(c.Amount, 10)
+		if !ok {
+			return output.NewError(output.ConvertError, "failed to convert amount "+c.Amount, nil)
+		}
+		message.Changes = append(message.Changes, &balanceChangeMessage{
+			Height:     c.Height,
+			Time:       time.Unix(c.Timestamp, 0).UTC().Format(time.RFC3339),
+			ActionHash: c.ActionHash,
+			Amount:     signedRauToString(amount),
+			Type:       c.Type.String(),
+		})
+	}
+	fmt.Println(message.String())
+	return nil
+}
+
+func balanceAt(arg string, height uint64, timeStr string) error {
+	addr, err := util.Address(arg)
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get address", err)
+	}
+	request := &balancehistorypb.GetBalanceAtRequest{
+		Address: addr,
+		Height:  height,
+	}
+	if timeStr != "" {
+		if height != 0 {
+			return output.NewError(output.FlagError, "only one of --height and --time can be set", nil)
+		}
+		t, err := time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return output.NewError(output.FlagError, "invalid time", err)
+		}
+		request.Timestamp = t.Unix()
+	}
+	ctx, cli, closeConn, err := balanceHistoryClient()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	response, err := cli.GetBalanceAt(ctx, request)
+	if err != nil {
+		return balanceHistoryAPIError(err, "GetBalanceAt")
+	}
+	balance, ok := new(big.Int).SetString(response.Balance, 10)
+	if !ok {
+		return output.NewError(output.ConvertError, "failed to convert balance "+response.Balance, nil)
+	}
+	message := balanceAtMessage{
+		Address: addr,
+		Height:  response.Height,
+		Balance: util.RauToString(balance, util.IotxDecimalNum),
+	}
+	fmt.Println(message.String())
+	return nil
+}
+
+func balanceHistoryClient() (context.Context, balancehistorypb.BalanceHistoryServiceClient, func(), error) {
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return nil, nil, nil, output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	ctx := context.Background()
+	jwtMD, err := util.JwtAuth()
+	if err == nil {
+		ctx = metautils.NiceMD(jwtMD).ToOutgoing(ctx)
+	}
+	return ctx, balancehistorypb.NewBalanceHistoryServiceClient(conn), func() { conn.Close() }, nil
+}
+
+func balanceHistoryAPIError(err error, api string) error {
+	if sta, ok := status.FromError(err); ok {
+		return output.NewError(output.APIError, sta.Message(), nil)
+	}
+	return output.NewError(output.NetworkError, "failed to invoke "+api+" api", err)
+}
+
+// signedRauToString converts a signed amount in Rau to IOTX
+func signedRauToString(amount *big.Int) string {
+	if amount.Sign() < 0 {
+		return "-" + util.RauToString(new(big.Int).Neg(amount), util.IotxDecimalNum)
+	}
+	return "+" + util.RauToString(amount, util.IotxDecimalNum)
+}
+
+func (m *balanceHistoryMessage) String() string {
+	if output.Format != "" {
+		return output.FormatString(output.Result, m)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %d balance changes\n", m.Address, m.Total)
+	tb := table.New("Height", "Time", "ActHash", "Type", "Amount").WithWriter(&sb)
+	for _, c := range m.Changes {
+		tb.AddRow(c.Height, c.Time, c.ActionHash, c.Type, c.Amount+" IOTX")
+	}
+	tb.Print()
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (m *balanceAtMessage) String() string {
+	if output.Format == "" {
+		return fmt.Sprintf("%s: %s IOTX at height %d", m.Address, m.Balance, m.Height)
+	}
+	return output.FormatString(output.Result, m)
+}
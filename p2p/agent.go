@@ -30,6 +30,7 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/pkg/compress"
 	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 	"github.com/iotexproject/iotex-core/v2/pkg/routine"
@@ -73,8 +74,10 @@ const (
 	_broadcastSubTopicConsensus = "_consensus"
 	_broadcastSubTopicAction    = "_action"
 	_unicastTopic               = "unicast"
+	_unicastSubTopicZstd        = "_zstd"
 	_numDialRetries             = 8
 	_dialRetryInterval          = 2 * time.Second
+	_zstdPeerCacheSize          = 1000
 )
 
 type (
@@ -106,6 +109,11 @@ type (
 		MaxMessageSize    int                 `yaml:"maxMessageSize"`
 		RpcMsgCacheSize   int                 `yaml:"rpcMsgCacheSize"`
 		RpcDedupCacheSize int                 `yaml:"rpcDedupCacheSize"`
+		// EnableZstd enables zstd compression of unicast block messages. The
+		// compression is only used with peers which have enabled it as well.
+		EnableZstd bool `yaml:"enableZstd"`
+		// ZstdMinSize is the minimum size of a block message to be compressed
+		ZstdMinSize int `yaml:"zstdMinSize"`
 	}
 
 	// Agent is the agent to help the blockchain node connect into the P2P networks and send/receive messages
@@ -144,6 +152,7 @@ type (
 		qosMetrics                 *Qos
 		unifiedTopic               atomic.Bool
 		isUnifiedTopic             func(height uint64) bool
+		zstdPeers                  cache.LRUCache
 	}
 
 	cacheValue struct {
@@ -178,6 +187,8 @@ var DefaultConfig = Config{
 	MaxMessageSize:    p2p.DefaultConfig.MaxMessageSize,
 	RpcMsgCacheSize:   10000,
 	RpcDedupCacheSize: 40000,
+	EnableZstd:        false,
+	ZstdMinSize:       16 << 10,
 }
 
 // NewDummyAgent creates a dummy p2p agent
@@ -246,6 +257,9 @@ func NewAgent(
 		reconnectTimeout:           cfg.ReconnectInterval,
 		qosMetrics:                 NewQoS(time.Now(), 2*cfg.ReconnectInterval),
 	}
+	if cfg.EnableZstd {
+		a.zstdPeers = cache.NewThreadSafeLruCache(_zstdPeerCacheSize)
+	}
 	a.unifiedTopic.Store(true)
 	for _, opt := range opts {
 		opt(a)
@@ -410,44 +424,24 @@ func (p *agent) Start(ctx context.Context) error {
 		broadcastValidator, broadcastHandler); err != nil {
 		return errors.Wrap(err, "error when adding broadcast pubsub")
 	}
-	if err := host.AddUnicastPubSub(_unicastTopic+p.topicSuffix, func(ctx context.Context, peerInfo peer.AddrInfo, data []byte) (err error) {
+	if err := host.AddUnicastPubSub(_unicastTopic+p.topicSuffix, func(ctx context.Context, peerInfo peer.AddrInfo, data []byte) error {
 		// Blocking handling the unicast message until the agent is started
 		<-ready
-		var (
-			unicast iotexrpc.UnicastMsg
-			peerID  = peerInfo.ID.String()
-			latency int64
-		)
-		defer func() {
-			status := _successStr
+		return p.handleUnicast(ctx, peerInfo, data)
+	}); err != nil {
+		return errors.Wrap(err, "error when adding unicast pubsub")
+	}
+	if p.cfg.EnableZstd {
+		if err := host.AddUnicastPubSub(_unicastTopic+_unicastSubTopicZstd+p.topicSuffix, func(ctx context.Context, peerInfo peer.AddrInfo, data []byte) error {
+			<-ready
+			data, err := compress.DecompZstdWithLimit(data, p.maxMessageSize())
 			if err != nil {
-				status = _failureStr
+				return errors.Wrap(err, "error when decompressing unicast message")
 			}
-			_p2pMsgCounter.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), "in", peerID, status).Inc()
-			_p2pMsgLatency.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), status).Observe(float64(latency))
-		}()
-		if err = proto.Unmarshal(data, &unicast); err != nil {
-			err = errors.Wrap(err, "error when marshaling unicast message")
-			return
+			return p.handleUnicast(ctx, peerInfo, data)
+		}); err != nil {
+			return errors.Wrap(err, "error when adding unicast pubsub")
 		}
-		msg, err := goproto.TypifyRPCMsg(unicast.MsgType, unicast.MsgBody)
-		if err != nil {
-			err = errors.Wrap(err, "error when typifying unicast message")
-			return
-		}
-		if unicast.ChainId != p.chainID {
-			err = errors.Errorf("chain ID mismatch, received %d, expecting %d", unicast.ChainId, p.chainID)
-			return
-		}
-
-		t := unicast.GetTimestamp().AsTime()
-		latency = time.Since(t).Nanoseconds() / time.Millisecond.Nanoseconds()
-
-		p.unicastInboundAsyncHandler(ctx, unicast.ChainId, peerInfo, msg)
-		p.qosMetrics.updateRecvUnicast(peerID, time.Now())
-		return
-	}); err != nil {
-		return errors.Wrap(err, "error when adding unicast pubsub")
 	}
 
 	// create boot nodes list except itself
@@ -483,6 +477,45 @@ func (p *agent) Start(ctx context.Context) error {
 	return p.reconnectTask.Start(ctx)
 }
 
+func (p *agent) handleUnicast(ctx context.Context, peerInfo peer.AddrInfo, data []byte) (err error) {
+	var (
+		unicast iotexrpc.UnicastMsg
+		peerID  = peerInfo.ID.String()
+		latency int64
+	)
+	defer func() {
+		status := _successStr
+		if err != nil {
+			status = _failureStr
+		}
+		_p2pMsgCounter.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), "in", peerID, status).Inc()
+		_p2pMsgLatency.WithLabelValues("unicast", strconv.Itoa(int(unicast.MsgType)), status).Observe(float64(latency))
+	}()
+	if err = proto.Unmarshal(data, &unicast); err != nil {
+		err = errors.Wrap(err, "error when marshaling unicast message")
+		return
+	}
+	msg, err := goproto.TypifyRPCMsg(unicast.MsgType, unicast.MsgBody)
+	if err != nil {
+		err = errors.Wrap(err, "error when typifying unicast message")
+		return
+	}
+	if unicast.ChainId != p.chainID {
+		err = errors.Errorf("chain ID mismatch, received %d, expecting %d", unicast.ChainId, p.chainID)
+		return
+	}
+	if p.zstdPeers != nil && unicast.GetAcceptZstd() {
+		p.zstdPeers.Add(peerID, struct{}{})
+	}
+
+	t := unicast.GetTimestamp().AsTime()
+	latency = time.Since(t).Nanoseconds() / time.Millisecond.Nanoseconds()
+
+	p.unicastInboundAsyncHandler(ctx, unicast.ChainId, peerInfo, msg)
+	p.qosMetrics.updateRecvUnicast(peerID, time.Now())
+	return
+}
+
 func (p *agent) Stop(ctx context.Context) error {
 	if p.host == nil {
 		return ErrAgentNotStarted
@@ -595,15 +628,25 @@ func (p *agent) UnicastOutbound(ctx context.Context, peer peer.AddrInfo, msg pro
 		MsgType:   msgType,
 		MsgBody:   msgBody,
 		Timestamp: timestamppb.Now(),
+		// nodes not supporting zstd ignore the field
+		AcceptZstd: p.zstdPeers != nil,
 	}
 	data, err := proto.Marshal(&unicast)
 	if err != nil {
 		err = errors.Wrap(err, "error when marshaling unicast message")
 		return
 	}
+	topic := _unicastTopic + p.topicSuffix
+	if p.useZstd(peerName, msgType, len(data)) {
+		if data, err = compress.CompZstd(data); err != nil {
+			err = errors.Wrap(err, "error when compressing unicast message")
+			return
+		}
+		topic = _unicastTopic + _unicastSubTopicZstd + p.topicSuffix
+	}
 
 	t := time.Now()
-	if err = host.Unicast(ctx, peer, _unicastTopic+p.topicSuffix, data); err != nil {
+	if err = host.Unicast(ctx, peer, topic, data); err != nil {
 		err = errors.Wrap(err, "error when sending unicast message")
 		p.qosMetrics.updateSendUnicast(peerName, t, false)
 		return
@@ -612,6 +655,23 @@ func (p *agent) UnicastOutbound(ctx context.Context, peer peer.AddrInfo, msg pro
 	return
 }
 
+// useZstd returns true if the message should be compressed, which is a large
+// block message sent to a peer that accepts zstd
+func (p *agent) useZstd(peerID string, msgType iotexrpc.MessageType, size int) bool {
+	if p.zstdPeers == nil || msgType != iotexrpc.MessageType_BLOCK || size < p.cfg.ZstdMinSize {
+		return false
+	}
+	_, ok := p.zstdPeers.Get(peerID)
+	return ok
+}
+
+func (p *agent) maxMessageSize() uint64 {
+	if p.cfg.MaxMessageSize > 0 {
+		return uint64(p.cfg.MaxMessageSize)
+	}
+	return uint64(p2p.DefaultConfig.MaxMessageSize)
+}
+
 func (p *agent) Info() (peer.AddrInfo, error) {
 	if p.host == nil {
 		return peer.AddrInfo{}, ErrAgentNotStarted
@@ -18,6 +18,8 @@ import (
 
 	"github.com/iotexproject/go-p2p"
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/iotexproject/iotex-proto/golang/testingpb"
 
 	"github.com/iotexproject/iotex-core/v2/testutil"
@@ -169,3 +171,81 @@ func TestUnicast(t *testing.T) {
 		}))
 	}
 }
+
+func TestUnicastZstd(t *testing.T) {
+	r := require.New(t)
+
+	ctx := context.Background()
+	var (
+		mutex    sync.RWMutex
+		received = make(map[string][]proto.Message)
+		agents   []Agent
+		infos    []peer.AddrInfo
+	)
+	b := func(_ context.Context, _ uint32, _ string, _ proto.Message) {}
+	for i := 0; i < 3; i++ {
+		i := i
+		u := func(_ context.Context, _ uint32, _ peer.AddrInfo, msg proto.Message) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			received[strconv.Itoa(i)] = append(received[strconv.Itoa(i)], msg)
+		}
+		agent := NewAgent(Config{
+			Host:              "127.0.0.1",
+			Port:              testutil.RandomPort(),
+			ReconnectInterval: 150 * time.Second,
+			MasterKey:         "zstd" + strconv.Itoa(i),
+			// the last agent does not support zstd
+			EnableZstd:  i < 2,
+			ZstdMinSize: 100,
+		}, 2, hash.ZeroHash256, func(proto.Message) (bool, error) {
+			return false, nil
+		}, b, u)
+		r.NoError(agent.Start(ctx))
+		defer agent.Stop(ctx)
+		info, err := agent.Info()
+		r.NoError(err)
+		agents = append(agents, agent)
+		infos = append(infos, info)
+	}
+	waitReceived := func(idx string, n int) {
+		r.NoError(testutil.WaitUntil(100*time.Millisecond, 20*time.Second, func() (bool, error) {
+			mutex.RLock()
+			defer mutex.RUnlock()
+			return len(received[idx]) == n, nil
+		}))
+	}
+
+	// agent 1 and 2 tell agent 0 whether they accept zstd
+	for _, i := range []int{1, 2} {
+		r.NoError(agents[i].UnicastOutbound(ctx, infos[0], &testingpb.TestPayload{MsgBody: []byte{1}}))
+	}
+	waitReceived("0", 2)
+	a := agents[0].(*agent)
+	_, ok := a.zstdPeers.Get(infos[1].ID.String())
+	r.True(ok)
+	_, ok = a.zstdPeers.Get(infos[2].ID.String())
+	r.False(ok)
+	r.Nil(agents[2].(*agent).zstdPeers)
+
+	blk := &iotextypes.Block{
+		Header: &iotextypes.BlockHeader{
+			Core: &iotextypes.BlockHeaderCore{
+				Height:    10,
+				TxRoot:    make([]byte, 32),
+				LogsBloom: make([]byte, 256),
+			},
+		},
+	}
+	r.True(a.useZstd(infos[1].ID.String(), iotexrpc.MessageType_BLOCK, 1000))
+	r.False(a.useZstd(infos[1].ID.String(), iotexrpc.MessageType_BLOCK, 99))
+	r.False(a.useZstd(infos[1].ID.String(), iotexrpc.MessageType_TEST, 1000))
+	r.False(a.useZstd(infos[2].ID.String(), iotexrpc.MessageType_BLOCK, 1000))
+	for _, i := range []int{1, 2} {
+		r.NoError(agents[0].UnicastOutbound(ctx, infos[i], blk))
+		waitReceived(strconv.Itoa(i), 1)
+		mutex.RLock()
+		r.True(proto.Equal(blk, received[strconv.Itoa(i)][0]))
+		mutex.RUnlock()
+	}
+}
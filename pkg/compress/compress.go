@@ -18,6 +18,10 @@ import (
 const (
 	Gzip   = "Gzip"
 	Snappy = "Snappy"
+	Zstd   = "Zstd"
+	// ZstdDict is zstd with a dictionary trained on the data, the caller keeps
+	// the dictionary, see ZstdCodec
+	ZstdDict = "ZstdDict"
 )
 
 // error definition
@@ -36,6 +40,8 @@ func Compress(value []byte, compressor string) ([]byte, error) {
 		return CompGzip(value)
 	case Snappy:
 		return CompSnappy(value)
+	case Zstd:
+		return CompZstd(value)
 	default:
 		panic("unsupported compressor")
 	}
@@ -48,6 +54,8 @@ func Decompress(value []byte, compressor string) ([]byte, error) {
 		return DecompGzip(value)
 	case Snappy:
 		return DecompSnappy(value)
+	case Zstd:
+		return DecompZstd(value)
 	default:
 		panic("unsupported compressor")
 	}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// ZstdDictSize is the default size of a trained zstd dictionary
+const ZstdDictSize = 64 << 10
+
+var (
+	// ErrOutputTooLarge indicates the decompressed data exceeds the limit
+	ErrOutputTooLarge = errors.New("decompressed data exceeds limit")
+
+	// EncodeAll and DecodeAll are safe for concurrent use
+	_zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithZeroFrames(true))
+	_zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+type (
+	// ZstdCodec compresses and decompresses with a trained zstd dictionary.
+	// Data compressed without dictionary can be decompressed as well.
+	ZstdCodec struct {
+		dict []byte
+		enc  *zstd.Encoder
+		dec  *zstd.Decoder
+	}
+)
+
+// CompZstd uses zstd to compress the input bytes
+func CompZstd(data []byte) ([]byte, error) {
+	return _zstdEncoder.EncodeAll(data, nil), nil
+}
+
+// DecompZstd uses zstd to decompress the input bytes
+func DecompZstd(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInputEmpty
+	}
+	v, err := _zstdDecoder.DecodeAll(data, nil)
+	if len(v) == 0 {
+		v = []byte{}
+	}
+	return v, err
+}
+
+// DecompZstdWithLimit uses zstd to decompress the input bytes, it fails if the
+// decompressed data is larger than limit
+func DecompZstdWithLimit(data []byte, limit uint64) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInputEmpty
+	}
+	var h zstd.Header
+	if err := h.Decode(data); err != nil {
+		return nil, err
+	}
+	if h.HasFCS && h.FrameContentSize > limit {
+		return nil, errors.Wrapf(ErrOutputTooLarge, "frame content size %d, limit %d", h.FrameContentSize, limit)
+	}
+	dec, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+	v, err := io.ReadAll(io.LimitReader(dec, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(v)) > limit {
+		return nil, errors.Wrapf(ErrOutputTooLarge, "limit %d", limit)
+	}
+	return v, nil
+}
+
+// TrainZstdDict trains a zstd dictionary of at most size bytes from the samples
+func TrainZstdDict(samples [][]byte, size int) ([]byte, error) {
+	d, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: size,
+		HashBytes:   6,
+		ZstdLevel:   zstd.SpeedDefault,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to train zstd dictionary")
+	}
+	return d, nil
+}
+
+// NewZstdCodec creates a codec with the zstd dictionary
+func NewZstdCodec(d []byte) (*ZstdCodec, error) {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithEncoderDict(d), zstd.WithZeroFrames(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid zstd dictionary")
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderDicts(d))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid zstd dictionary")
+	}
+	return &ZstdCodec{
+		dict: d,
+		enc:  enc,
+		dec:  dec,
+	}, nil
+}
+
+// Bytes returns the dictionary
+func (z *ZstdCodec) Bytes() []byte {
+	return z.dict
+}
+
+// Compress compresses the input bytes with the dictionary
+func (z *ZstdCodec) Compress(data []byte) ([]byte, error) {
+	return z.enc.EncodeAll(data, nil), nil
+}
+
+// Decompress decompresses the input bytes with the dictionary
+func (z *ZstdCodec) Decompress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInputEmpty
+	}
+	v, err := z.dec.DecodeAll(data, nil)
+	if len(v) == 0 {
+		v = []byte{}
+	}
+	return v, err
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package compress
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompZstdWithLimit(t *testing.T) {
+	r := require.New(t)
+
+	_, err := DecompZstdWithLimit(nil, 100)
+	r.Equal(ErrInputEmpty, err)
+	_, err = DecompZstdWithLimit([]byte{1, 2, 3, 4, 5, 6}, 100)
+	r.Error(err)
+
+	data := bytes.Repeat([]byte("iotex"), 1000)
+	v, err := CompZstd(data)
+	r.NoError(err)
+	r.Less(len(v), len(data))
+	ser, err := DecompZstdWithLimit(v, uint64(len(data)))
+	r.NoError(err)
+	r.Equal(data, ser)
+	_, err = DecompZstdWithLimit(v, uint64(len(data)-1))
+	r.Equal(ErrOutputTooLarge, errors.Cause(err))
+}
+
+func TestZstdCodec(t *testing.T) {
+	r := require.New(t)
+
+	var samples [][]byte
+	for i := 0; i < 200; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(`{"height":%d,"producer":"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms","actions":[{"nonce":%d,"gasLimit":21000,"amount":"%d"}]}`, i, i*7, i*1000)))
+	}
+	d, err := TrainZstdDict(samples, 4<<10)
+	r.NoError(err)
+	r.NotEmpty(d)
+	_, err = NewZstdCodec([]byte{1, 2, 3})
+	r.Error(err)
+	z, err := NewZstdCodec(d)
+	r.NoError(err)
+	r.Equal(d, z.Bytes())
+
+	_, err = z.Decompress(nil)
+	r.Equal(ErrInputEmpty, err)
+	data := samples[100]
+	v, err := z.Compress(data)
+	r.NoError(err)
+	plain, err := CompZstd(data)
+	r.NoError(err)
+	r.Less(len(v), len(plain))
+	for _, b := range [][]byte{v, plain} {
+		ser, err := z.Decompress(b)
+		r.NoError(err)
+		r.Equal(data, ser)
+	}
+	// cannot decompress without the dictionary
+	_, err = DecompZstd(v)
+	r.Error(err)
+}
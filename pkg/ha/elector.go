@@ -0,0 +1,240 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/pkg/routine"
+)
+
+var _ lifecycle.StartStopper = (*Elector)(nil)
+
+type (
+	// Activator is the component activated by the election
+	Activator interface {
+		Activate(bool)
+	}
+
+	// Fenced is the component signing only with the fencing token of the lease, the fence is called with the
+	// start time of the round to sign in
+	Fenced interface {
+		SetSigningFence(func(time.Time) (uint64, error))
+	}
+
+	// Status is the status of the election
+	Status struct {
+		ID     string    `json:"id"`
+		Active bool      `json:"active"`
+		Holder string    `json:"holder"`
+		Token  uint64    `json:"token"`
+		Expiry time.Time `json:"expiry"`
+	}
+
+	// Elector elects the active node among the nodes sharing a block producer identity by a lease. The node
+	// holding the lease is active and renews it, the others stand by and take over once it expires. The leader
+	// steps down if it fails to renew the lease a renew interval before it expires, so that there is at most one
+	// active node as long as the clocks drift less than that. The new leader does not sign in the rounds started
+	// before it took over the lease, which the former one may have signed in
+	Elector struct {
+		cfg     Config
+		id      string
+		backend Backend
+		target  Activator
+		clock   clock.Clock
+		task    *routine.RecurringTask
+
+		mutex      sync.RWMutex
+		lease      Lease
+		leading    bool
+		validUntil time.Time
+		since      time.Time
+	}
+
+	// ElectorOption is the option of the elector
+	ElectorOption func(*Elector)
+)
+
+// WithClock sets the clock of the elector
+func WithClock(c clock.Clock) ElectorOption {
+	return func(e *Elector) {
+		e.clock = c
+	}
+}
+
+// NewElector creates an elector activating the target when the node holds the lease
+func NewElector(cfg Config, backend Backend, target Activator, opts ...ElectorOption) (*Elector, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := cfg.nodeID()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the node ID")
+	}
+	e := &Elector{
+		cfg:     cfg,
+		id:      id,
+		backend: backend,
+		target:  target,
+		clock:   clock.New(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.task = routine.NewRecurringTask(e.elect, cfg.RenewInterval, routine.WithClock(e.clock))
+	if fenced, ok := target.(Fenced); ok {
+		fenced.SetSigningFence(e.Fence)
+	}
+	return e, nil
+}
+
+// Start stands the node by and starts the election
+func (e *Elector) Start(ctx context.Context) error {
+	e.target.Activate(false)
+	e.elect()
+	return e.task.Start(ctx)
+}
+
+// Stop stops the election, and releases the lease if the node holds it
+func (e *Elector) Stop(ctx context.Context) error {
+	if err := e.task.Stop(ctx); err != nil {
+		return err
+	}
+	e.mutex.Lock()
+	leading := e.stepDown()
+	e.mutex.Unlock()
+	if !leading {
+		return nil
+	}
+	e.target.Activate(false)
+	lease, ver, err := e.backend.Load(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to release the lease")
+	}
+	if lease.Holder != e.id {
+		return nil
+	}
+	lease.Expiry = e.clock.Now()
+	return errors.Wrap(e.backend.Store(ctx, lease, ver), "failed to release the lease")
+}
+
+// Fence returns the fencing token of the lease to sign in the round starting at the time. It returns
+// ErrNotLeader if the node does not hold the lease, and ErrTakeoverRound if the round started before the node
+// took over the lease
+func (e *Elector) Fence(roundStart time.Time) (uint64, error) {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	if !e.leading || !e.clock.Now().Before(e.validUntil) {
+		return 0, ErrNotLeader
+	}
+	if roundStart.Before(e.since) {
+		return 0, errors.Wrapf(ErrTakeoverRound, "round started at %s, lease taken over at %s", roundStart, e.since)
+	}
+	return e.lease.Token, nil
+}
+
+// Status returns the status of the election
+func (e *Elector) Status() Status {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return Status{
+		ID:     e.id,
+		Active: e.leading,
+		Holder: e.lease.Holder,
+		Token:  e.lease.Token,
+		Expiry: e.lease.Expiry,
+	}
+}
+
+// ServeHTTP writes the status of the election, the status code is 503 if the node stands by
+func (e *Elector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	status := e.Status()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Active {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(&status); err != nil {
+		log.L().Warn("Failed to send http response.", zap.Error(err))
+	}
+}
+
+func (e *Elector) elect() {
+	// the target is activated out of the lock, since it may sign with the fencing token meanwhile
+	if leading, changed := e.renew(); changed {
+		e.target.Activate(leading)
+	}
+}
+
+// renew renews or takes over the lease, and returns whether the node holds it and whether that has changed
+func (e *Elector) renew() (bool, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.RenewInterval)
+	defer cancel()
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	now := e.clock.Now()
+	lease, ver, err := e.backend.Load(ctx)
+	if err != nil {
+		log.L().Error("Failed to load the lease.", zap.Error(err))
+		return e.checkValidity(now)
+	}
+	next := Lease{
+		Holder: e.id,
+		Token:  lease.Token,
+		Expiry: now.Add(e.cfg.LeaseTTL),
+	}
+	switch {
+	case lease.Holder == e.id && !lease.expired(now) && e.leading && lease.Token == e.lease.Token:
+		// renew the lease
+	case lease.expired(now):
+		// take over the lease
+		next.Token++
+	default:
+		// another node holds the lease, or this one was restarted while holding it
+		e.lease = lease
+		return false, e.stepDown()
+	}
+	if err := e.backend.Store(ctx, next, ver); err != nil {
+		log.L().Error("Failed to store the lease.", zap.Error(err))
+		return e.checkValidity(now)
+	}
+	e.lease = next
+	e.validUntil = now.Add(e.cfg.LeaseTTL - e.cfg.RenewInterval)
+	if e.leading {
+		return true, false
+	}
+	log.L().Info("Acquired the lease, set the node to active mode.", zap.Uint64("token", next.Token))
+	e.leading = true
+	e.since = now
+	return true, true
+}
+
+// checkValidity steps down if the lease has not been renewed in time
+func (e *Elector) checkValidity(now time.Time) (bool, bool) {
+	if e.leading && !now.Before(e.validUntil) {
+		return false, e.stepDown()
+	}
+	return e.leading, false
+}
+
+// stepDown returns true if the node was leading
+func (e *Elector) stepDown() bool {
+	if !e.leading {
+		return false
+	}
+	log.L().Info("Lost the lease, set the node to stand-by mode.", zap.Uint64("token", e.lease.Token))
+	e.leading = false
+	return true
+}
@@ -0,0 +1,413 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/require"
+)
+
+type target struct {
+	mutex  sync.Mutex
+	active bool
+	fence  func(time.Time) (uint64, error)
+}
+
+func (t *target) Activate(active bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.active = active
+}
+
+func (t *target) Active() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.active
+}
+
+func (t *target) SetSigningFence(fence func(time.Time) (uint64, error)) {
+	t.fence = fence
+}
+
+// memKV is an in-process key-value store over HTTP supporting the conditional requests
+type memKV struct {
+	mutex sync.Mutex
+	value []byte
+	rev   int
+	down  bool
+}
+
+func (kv *memKV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+	if kv.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	etag := strconv.Quote(strconv.Itoa(kv.rev))
+	switch r.Method {
+	case http.MethodGet:
+		if kv.value == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write(kv.value)
+	case http.MethodPut:
+		if (r.Header.Get("If-None-Match") == "*" && kv.value != nil) ||
+			(r.Header.Get("If-Match") != "" && r.Header.Get("If-Match") != etag) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		kv.value = data
+		kv.rev++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (kv *memKV) setDown(down bool) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+	kv.down = down
+}
+
+func newElector(t *testing.T, id string, backend Backend, ck clock.Clock) (*Elector, *target) {
+	cfg := DefaultConfig
+	cfg.Enabled = true
+	cfg.ID = id
+	cfg.Backend = HTTPBackend
+	cfg.URL = "http://localhost"
+	tgt := &target{}
+	e, err := NewElector(cfg, backend, tgt, WithClock(ck))
+	require.NoError(t, err)
+	return e, tgt
+}
+
+func TestBackend(t *testing.T) {
+	kv := &memKV{}
+	srv := httptest.NewServer(kv)
+	defer srv.Close()
+	for name, backend := range map[string]Backend{
+		"file": NewFileBackend(filepath.Join(t.TempDir(), "lease"), time.Minute),
+		"http": NewHTTPBackend(srv.URL, time.Second),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+			lease, ver, err := backend.Load(ctx)
+			require.NoError(err)
+			require.Empty(ver)
+			require.Equal(Lease{}, lease)
+
+			l1 := Lease{Holder: "a", Token: 1, Expiry: time.Unix(100, 0).UTC()}
+			require.NoError(backend.Store(ctx, l1, ""))
+			require.ErrorIs(backend.Store(ctx, l1, ""), ErrConflict)
+			lease, ver, err = backend.Load(ctx)
+			require.NoError(err)
+			require.NotEmpty(ver)
+			require.Equal(l1, lease)
+
+			// only one of the concurrent updates of the same version succeeds
+			l2 := Lease{Holder: "b", Token: 2, Expiry: time.Unix(200, 0).UTC()}
+			require.NoError(backend.Store(ctx, l2, ver))
+			require.ErrorIs(backend.Store(ctx, l1, ver), ErrConflict)
+			lease, _, err = backend.Load(ctx)
+			require.NoError(err)
+			require.Equal(l2, lease)
+		})
+	}
+}
+
+func TestElector(t *testing.T) {
+	t.Run("failover", func(t *testing.T) {
+		require := require.New(t)
+		kv := &memKV{}
+		srv := httptest.NewServer(kv)
+		defer srv.Close()
+		ck := clock.NewMock()
+		a, ta := newElector(t, "a", NewHTTPBackend(srv.URL, time.Second), ck)
+		b, tb := newElector(t, "b", NewHTTPBackend(srv.URL, time.Second), ck)
+
+		a.elect()
+		b.elect()
+		require.True(ta.Active())
+		require.False(tb.Active())
+		token, err := ta.fence(ck.Now())
+		require.NoError(err)
+		require.Equal(uint64(1), token)
+		_, err = tb.fence(ck.Now())
+		require.ErrorIs(err, ErrNotLeader)
+		require.Equal("a", b.Status().Holder)
+
+		// the lease is renewed, and the standby keeps following
+		for i := 0; i < 10; i++ {
+			ck.Add(a.cfg.RenewInterval)
+			a.elect()
+			b.elect()
+		}
+		require.True(ta.Active())
+		require.False(tb.Active())
+
+		// the store is unreachable, the leader steps down before the lease expires
+		kv.setDown(true)
+		ck.Add(a.cfg.LeaseTTL - a.cfg.RenewInterval)
+		_, err = ta.fence(ck.Now())
+		require.ErrorIs(err, ErrNotLeader)
+		a.elect()
+		require.False(ta.Active())
+
+		// the standby takes over after the lease expires, with a higher token
+		kv.setDown(false)
+		b.elect()
+		require.False(tb.Active())
+		ck.Add(a.cfg.RenewInterval)
+		b.elect()
+		a.elect()
+		require.True(tb.Active())
+		require.False(ta.Active())
+		token, err = tb.fence(ck.Now())
+		require.NoError(err)
+		require.Equal(uint64(2), token)
+		status := a.Status()
+		require.Equal("b", status.Holder)
+		require.Equal(uint64(2), status.Token)
+		require.False(status.Active)
+		require.True(status.Expiry.Equal(ck.Now().Add(a.cfg.LeaseTTL)))
+	})
+	t.Run("takeover mid-round", func(t *testing.T) {
+		require := require.New(t)
+		kv := &memKV{}
+		srv := httptest.NewServer(kv)
+		defer srv.Close()
+		ck := clock.NewMock()
+		a, ta := newElector(t, "a", NewHTTPBackend(srv.URL, time.Second), ck)
+		b, tb := newElector(t, "b", NewHTTPBackend(srv.URL, time.Second), ck)
+		a.elect()
+		b.elect()
+		require.True(ta.Active())
+
+		// the leader signs in the round, and fails to renew the lease meanwhile
+		ck.Add(a.cfg.RenewInterval)
+		roundStart := ck.Now()
+		_, err := ta.fence(roundStart)
+		require.NoError(err)
+		kv.setDown(true)
+		ck.Add(a.cfg.LeaseTTL - 2*a.cfg.RenewInterval)
+		_, err = ta.fence(roundStart)
+		require.ErrorIs(err, ErrNotLeader)
+		a.elect()
+		require.False(ta.Active())
+
+		// the standby takes over before the round ends, but does not sign in it
+		kv.setDown(false)
+		ck.Add(a.cfg.RenewInterval)
+		b.elect()
+		require.True(tb.Active())
+		_, err = tb.fence(roundStart)
+		require.ErrorIs(err, ErrTakeoverRound)
+		token, err := tb.fence(ck.Now())
+		require.NoError(err)
+		require.Equal(uint64(2), token)
+
+		// the rounds keep being signed in after the lease is renewed
+		ck.Add(a.cfg.RenewInterval)
+		b.elect()
+		roundStart = ck.Now()
+		ck.Add(a.cfg.RenewInterval)
+		b.elect()
+		_, err = tb.fence(roundStart)
+		require.NoError(err)
+	})
+	t.Run("release", func(t *testing.T) {
+		require := require.New(t)
+		ck := clock.NewMock()
+		backend := NewFileBackend(filepath.Join(t.TempDir(), "lease"), time.Minute)
+		a, ta := newElector(t, "a", backend, ck)
+		b, tb := newElector(t, "b", backend, ck)
+		ctx := context.Background()
+		require.NoError(a.Start(ctx))
+		require.NoError(b.Start(ctx))
+		require.True(ta.Active())
+		require.False(tb.Active())
+
+		rec := httptest.NewRecorder()
+		a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ha", nil))
+		require.Equal(http.StatusOK, rec.Code)
+		rec = httptest.NewRecorder()
+		b.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ha", nil))
+		require.Equal(http.StatusServiceUnavailable, rec.Code)
+
+		// the lease is released on stop, and taken over right away
+		require.NoError(a.Stop(ctx))
+		require.False(ta.Active())
+		b.elect()
+		require.True(tb.Active())
+		require.NoError(b.Stop(ctx))
+		lease, _, err := backend.Load(ctx)
+		require.NoError(err)
+		require.Equal(uint64(2), lease.Token)
+		require.True(lease.expired(ck.Now()))
+	})
+	t.Run("restart", func(t *testing.T) {
+		require := require.New(t)
+		ck := clock.NewMock()
+		backend := NewFileBackend(filepath.Join(t.TempDir(), "lease"), time.Minute)
+		a, ta := newElector(t, "a", backend, ck)
+		a.elect()
+		require.True(ta.Active())
+
+		// the node restarted while holding the lease waits for it to expire, and takes it over with a new token
+		a, ta = newElector(t, "a", backend, ck)
+		a.elect()
+		require.False(ta.Active())
+		ck.Add(a.cfg.LeaseTTL)
+		a.elect()
+		require.True(ta.Active())
+		token, err := ta.fence(ck.Now())
+		require.NoError(err)
+		require.Equal(uint64(2), token)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		require := require.New(t)
+		cfg := DefaultConfig
+		cfg.Enabled = true
+		cfg.Path = "lease"
+		cfg.LeaseTTL = cfg.RenewInterval
+		_, err := NewElector(cfg, nil, &target{})
+		require.Error(err)
+		cfg = DefaultConfig
+		cfg.Enabled = true
+		cfg.Backend = "etcd"
+		_, err = NewElector(cfg, nil, &target{})
+		require.Error(err)
+	})
+}
+
+func TestFileBackendStaleLock(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "lease")
+	backend := NewFileBackend(path, 0)
+	fb := backend.(*fileBackend)
+	nonce, err := fb.lock()
+	require.NoError(err)
+	// the lock left by a crashed node is removed, so that the next update succeeds
+	err = backend.Store(context.Background(), Lease{Holder: "a"}, "")
+	require.True(errors.Is(err, ErrConflict))
+	require.False(fb.holdsLock(nonce))
+	require.NoError(backend.Store(context.Background(), Lease{Holder: "a"}, ""))
+
+	// the lock taken over is not removed by its former owner
+	nonce, err = fb.lock()
+	require.NoError(err)
+	fb.unlock("former")
+	require.True(fb.holdsLock(nonce))
+	fb.unlock(nonce)
+	require.NoFileExists(fb.lockPath())
+
+	// the lease is not updated with the context done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, ver, err := backend.Load(context.Background())
+	require.NoError(err)
+	require.ErrorIs(backend.Store(ctx, Lease{Holder: "b"}, ver), context.Canceled)
+	lease, _, err := backend.Load(context.Background())
+	require.NoError(err)
+	require.Equal("a", lease.Holder)
+}
+
+// tokenTarget records the node activated with each fencing token
+type tokenTarget struct {
+	target
+	id      string
+	ck      clock.Clock
+	mutex   *sync.Mutex
+	holders map[uint64]map[string]struct{}
+}
+
+func (t *tokenTarget) Activate(active bool) {
+	t.target.Activate(active)
+	if !active {
+		return
+	}
+	token, err := t.fence(t.ck.Now())
+	if err != nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.holders[token] == nil {
+		t.holders[token] = map[string]struct{}{}
+	}
+	t.holders[token][t.id] = struct{}{}
+}
+
+func TestFileBackendConcurrentElectors(t *testing.T) {
+	require := require.New(t)
+	var (
+		path    = filepath.Join(t.TempDir(), "lease")
+		ck      = clock.NewMock()
+		mutex   sync.Mutex
+		holders = map[uint64]map[string]struct{}{}
+		wg      sync.WaitGroup
+	)
+	electors := make([]*Elector, 2)
+	for i := range electors {
+		cfg := DefaultConfig
+		cfg.Enabled = true
+		cfg.ID = strconv.Itoa(i)
+		cfg.Path = path
+		tgt := &tokenTarget{id: cfg.ID, ck: ck, mutex: &mutex, holders: holders}
+		// each node has its own backend on the shared file
+		e, err := NewElector(cfg, NewFileBackend(path, time.Minute), tgt, WithClock(ck))
+		require.NoError(err)
+		electors[i] = e
+	}
+	for round := 0; round < 50; round++ {
+		// the lease expires every round, so that the nodes race to take it over
+		ck.Add(DefaultConfig.LeaseTTL)
+		for _, e := range electors {
+			wg.Add(1)
+			go func(e *Elector) {
+				defer wg.Done()
+				for i := 0; i < 5; i++ {
+					e.elect()
+				}
+			}(e)
+		}
+		wg.Wait()
+		active := 0
+		for _, e := range electors {
+			if e.Status().Active {
+				active++
+			}
+		}
+		require.LessOrEqual(active, 1)
+	}
+	require.NotEmpty(holders)
+	// a fencing token is never granted to two nodes
+	for token, ids := range holders {
+		require.Len(ids, 1, "token %d", token)
+	}
+	require.NoFileExists(path + ".lock")
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// fileBackend stores the lease in a file on a shared filesystem. The file is updated under a lock file created
+// exclusively, which works on the filesystems supporting O_EXCL, including NFSv3 and later. The lock file holds
+// a nonce of its owner, so that a node only updates the lease and removes the lock while it owns the lock
+type fileBackend struct {
+	path string
+	// staleLock is the age a lock file is considered left by a crashed node and removed
+	staleLock time.Duration
+}
+
+// NewFileBackend creates a lease backend storing the lease in the file
+func NewFileBackend(path string, staleLock time.Duration) Backend {
+	return &fileBackend{
+		path:      path,
+		staleLock: staleLock,
+	}
+}
+
+func (b *fileBackend) Load(ctx context.Context) (Lease, string, error) {
+	data, err := os.ReadFile(b.path)
+	if os.IsNotExist(err) {
+		return Lease{}, "", nil
+	}
+	if err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to read the lease file")
+	}
+	var lease Lease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to parse the lease file")
+	}
+	return lease, version(data), nil
+}
+
+func (b *fileBackend) Store(ctx context.Context, lease Lease, ver string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	nonce, err := b.lock()
+	if err != nil {
+		return err
+	}
+	defer b.unlock(nonce)
+
+	data, err := os.ReadFile(b.path)
+	switch {
+	case os.IsNotExist(err):
+		if ver != "" {
+			return ErrConflict
+		}
+	case err != nil:
+		return errors.Wrap(err, "failed to read the lease file")
+	case version(data) != ver:
+		return ErrConflict
+	}
+	data, err = json.Marshal(lease)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize the lease")
+	}
+	tmp := b.path + "." + nonce + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		os.Remove(tmp)
+		return errors.Wrap(err, "failed to write the lease file")
+	}
+	// the lease is not updated if the context is done or the lock has been taken over meanwhile
+	if err := ctx.Err(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if !b.holdsLock(nonce) {
+		os.Remove(tmp)
+		return ErrConflict
+	}
+	return errors.Wrap(os.Rename(tmp, b.path), "failed to write the lease file")
+}
+
+func (b *fileBackend) lockPath() string {
+	return b.path + ".lock"
+}
+
+// lock creates the lock file with a unique nonce of the owner, and returns the nonce
+func (b *fileBackend) lock() (string, error) {
+	nonce, err := newNonce()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(b.lockPath(), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err == nil {
+		_, err = f.WriteString(nonce)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			b.unlock(nonce)
+			return "", errors.Wrap(err, "failed to write the lock file")
+		}
+		return nonce, nil
+	}
+	if !os.IsExist(err) {
+		return "", errors.Wrap(err, "failed to create the lock file")
+	}
+	// the lock is held by another node, unless it is left by a crashed one
+	if info, err := os.Stat(b.lockPath()); err == nil && time.Since(info.ModTime()) > b.staleLock {
+		b.breakLock(nonce)
+	}
+	return "", ErrConflict
+}
+
+// breakLock moves the stale lock file aside by an atomic rename, so that only one node takes it over. If
+// the lock moved turns out to be a fresh one, created after the stale one was broken by another node, it is
+// linked back unless a new lock has been created since
+func (b *fileBackend) breakLock(nonce string) {
+	stale := b.lockPath() + "." + nonce + ".stale"
+	if err := os.Rename(b.lockPath(), stale); err != nil {
+		return
+	}
+	defer os.Remove(stale)
+	if info, err := os.Stat(stale); err == nil && time.Since(info.ModTime()) <= b.staleLock {
+		os.Link(stale, b.lockPath())
+	}
+}
+
+// holdsLock checks if the lock file is the one created by the owner of the nonce
+func (b *fileBackend) holdsLock(nonce string) bool {
+	data, err := os.ReadFile(b.lockPath())
+	return err == nil && string(data) == nonce
+}
+
+// unlock removes the lock file, only if it is still the one created by the owner of the nonce
+func (b *fileBackend) unlock(nonce string) {
+	if b.holdsLock(nonce) {
+		os.Remove(b.lockPath())
+	}
+}
+
+func newNonce() (string, error) {
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", errors.Wrap(err, "failed to generate the lock nonce")
+	}
+	return hex.EncodeToString(nonce[:]), nil
+}
+
+func version(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
@@ -14,21 +14,46 @@ import (
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
 )
 
-// Controller controls the node high availability status
-type Controller struct {
-	c consensus.Consensus
+type (
+	// Controller controls the node high availability status
+	Controller struct {
+		c       consensus.Consensus
+		elector *Elector
+	}
+
+	// Option is the option of the controller
+	Option func(*Controller)
+)
+
+// WithElector sets the elector, the node is then activated by the lease election instead of the admin request
+func WithElector(e *Elector) Option {
+	return func(ha *Controller) {
+		ha.elector = e
+	}
 }
 
 // New constructs a HA controller instance
-func New(c consensus.Consensus) *Controller {
-	return &Controller{
+func New(c consensus.Consensus, opts ...Option) *Controller {
+	ha := &Controller{
 		c: c,
 	}
+	for _, opt := range opts {
+		opt(ha)
+	}
+	return ha
 }
 
 // Handle handles admin request
 func (ha *Controller) Handle(w http.ResponseWriter, r *http.Request) {
 	val := strings.ToLower(r.URL.Query().Get("activate"))
+	if ha.elector != nil {
+		if val != "" {
+			http.Error(w, "the node is activated by the lease election", http.StatusConflict)
+			return
+		}
+		ha.elector.ServeHTTP(w, r)
+		return
+	}
 	switch val {
 	case "true":
 		log.S().Info("Set the node to active mode")
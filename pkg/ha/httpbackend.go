@@ -0,0 +1,97 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// httpBackend stores the lease in a key-value store over HTTP, the store should support the conditional requests:
+//   - GET returns the value with its ETag, or 404 if the key does not exist
+//   - PUT with If-Match stores the value only if the ETag matches, and PUT with "If-None-Match: *" only if the key
+//     does not exist, otherwise it returns 412
+type httpBackend struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPBackend creates a lease backend storing the lease at the URL
+func NewHTTPBackend(url string, timeout time.Duration) Backend {
+	return &httpBackend{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (b *httpBackend) Load(ctx context.Context) (Lease, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.url, nil)
+	if err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to create the request")
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to load the lease")
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return Lease{}, "", nil
+	default:
+		return Lease{}, "", errors.Errorf("failed to load the lease, status %s", resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to read the lease")
+	}
+	var lease Lease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		return Lease{}, "", errors.Wrap(err, "failed to parse the lease")
+	}
+	ver := resp.Header.Get("ETag")
+	if ver == "" {
+		return Lease{}, "", errors.New("the store returns no ETag")
+	}
+	return lease, ver, nil
+}
+
+func (b *httpBackend) Store(ctx context.Context, lease Lease, ver string) error {
+	data, err := json.Marshal(lease)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize the lease")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, b.url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "failed to create the request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if ver == "" {
+		req.Header.Set("If-None-Match", "*")
+	} else {
+		req.Header.Set("If-Match", ver)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to store the lease")
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return ErrConflict
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return errors.Errorf("failed to store the lease, status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// FileBackend stores the lease in a file on a filesystem shared by the nodes
+	FileBackend = "file"
+	// HTTPBackend stores the lease in a key-value store over HTTP
+	HTTPBackend = "http"
+)
+
+var (
+	// ErrConflict indicates that the lease has been updated by another node since it was loaded
+	ErrConflict = errors.New("lease has been updated by another node")
+	// ErrNotLeader indicates that the node does not hold the lease
+	ErrNotLeader = errors.New("node does not hold the lease")
+	// ErrTakeoverRound indicates that the round started before the node took over the lease, the previous holder
+	// may have signed in it
+	ErrTakeoverRound = errors.New("round started before the lease was taken over")
+
+	// DefaultConfig is the default config of the lease election
+	DefaultConfig = Config{
+		Enabled:       false,
+		Backend:       FileBackend,
+		LeaseTTL:      10 * time.Second,
+		RenewInterval: 2 * time.Second,
+	}
+)
+
+type (
+	// Config is the config of the lease election between the nodes sharing a block producer identity
+	Config struct {
+		// Enabled is true if the node is activated by the lease election, instead of the manual switch
+		Enabled bool `yaml:"enabled"`
+		// ID is the unique ID of the node in the election, the hostname if empty
+		ID string `yaml:"id"`
+		// Backend is the kind of store of the lease, either "file" or "http"
+		Backend string `yaml:"backend"`
+		// Path is the path of the lease file of the file backend
+		Path string `yaml:"path"`
+		// URL is the URL of the lease key of the http backend
+		URL string `yaml:"url"`
+		// LeaseTTL is how long the lease is held without renewal, a standby node takes over after it expires
+		LeaseTTL time.Duration `yaml:"leaseTTL"`
+		// RenewInterval is the interval the lease is renewed or checked at
+		RenewInterval time.Duration `yaml:"renewInterval"`
+	}
+
+	// Lease is the lease to produce blocks with the shared identity
+	Lease struct {
+		// Holder is the ID of the node holding the lease
+		Holder string `json:"holder"`
+		// Token is the fencing token, which increases every time the lease changes hands
+		Token uint64 `json:"token"`
+		// Expiry is the time the lease expires if not renewed
+		Expiry time.Time `json:"expiry"`
+	}
+
+	// Backend stores the lease. The version returned by Load is opaque, Store succeeds only if the lease is
+	// still of the version, and returns ErrConflict otherwise. An empty version means there is no lease yet
+	Backend interface {
+		Load(context.Context) (Lease, string, error)
+		Store(context.Context, Lease, string) error
+	}
+)
+
+// Validate validates the config
+func (cfg Config) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.RenewInterval <= 0 || cfg.LeaseTTL < 2*cfg.RenewInterval {
+		return errors.Errorf("lease TTL %s should be at least twice the renew interval %s", cfg.LeaseTTL, cfg.RenewInterval)
+	}
+	switch cfg.Backend {
+	case FileBackend:
+		if cfg.Path == "" {
+			return errors.New("lease file path is empty")
+		}
+	case HTTPBackend:
+		if cfg.URL == "" {
+			return errors.New("lease URL is empty")
+		}
+	default:
+		return errors.Errorf("unknown lease backend %s", cfg.Backend)
+	}
+	return nil
+}
+
+// NewBackend creates the lease backend of the config
+func NewBackend(cfg Config) (Backend, error) {
+	switch cfg.Backend {
+	case FileBackend:
+		return NewFileBackend(cfg.Path, cfg.LeaseTTL), nil
+	case HTTPBackend:
+		return NewHTTPBackend(cfg.URL, cfg.RenewInterval), nil
+	default:
+		return nil, errors.Errorf("unknown lease backend %s", cfg.Backend)
+	}
+}
+
+func (cfg Config) nodeID() (string, error) {
+	if cfg.ID != "" {
+		return cfg.ID, nil
+	}
+	return os.Hostname()
+}
+
+func (l Lease) expired(now time.Time) bool {
+	return l.Holder == "" || !now.Before(l.Expiry)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// errors
+var (
+	ErrNotExist = errors.New("object does not exist")
+	ErrRequest  = errors.New("object store request failed")
+)
+
+type (
+	// Store is the interface of an object storage
+	Store interface {
+		// Put uploads an object of the given size from the reader
+		Put(ctx context.Context, key string, r io.ReaderAt, size int64) error
+		// GetRange reads [offset, offset+length) of the object
+		GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+		// Size returns the size of the object
+		Size(ctx context.Context, key string) (int64, error)
+		// Delete deletes the object
+		Delete(ctx context.Context, key string) error
+	}
+
+	// Config is the config of an S3-compatible object storage
+	Config struct {
+		// Endpoint is the URL of the storage service, e.g. https://s3.us-east-1.amazonaws.com
+		Endpoint        string        `yaml:"endpoint"`
+		Region          string        `yaml:"region"`
+		Bucket          string        `yaml:"bucket"`
+		AccessKeyID     string        `yaml:"accessKeyID"`
+		SecretAccessKey string        `yaml:"secretAccessKey"`
+		Timeout         time.Duration `yaml:"timeout"`
+		// PartSizeMB is the part size used by multipart upload
+		PartSizeMB int64 `yaml:"partSizeMB"`
+	}
+)
+
+// DefaultConfig is the default config of object storage
+var DefaultConfig = Config{
+	Region:     "us-east-1",
+	Timeout:    5 * time.Minute,
+	PartSizeMB: 64,
+}
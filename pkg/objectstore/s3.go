@@ -0,0 +1,266 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	_signAlgorithm   = "AWS4-HMAC-SHA256"
+	_unsignedPayload = "UNSIGNED-PAYLOAD"
+	_amzDateFormat   = "20060102T150405Z"
+	_shortDateFormat = "20060102"
+)
+
+type (
+	// s3Store is a Store talking to an S3-compatible service with path-style
+	// requests, signed by AWS signature version 4
+	s3Store struct {
+		endpoint *url.URL
+		cfg      Config
+		partSize int64
+		client   *http.Client
+		now      func() time.Time
+	}
+
+	initiateMultipartUploadResult struct {
+		UploadID string `xml:"UploadId"`
+	}
+
+	completedPart struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	}
+
+	completeMultipartUpload struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}
+)
+
+// NewS3Store creates a Store backed by an S3-compatible service
+func NewS3Store(cfg Config) (Store, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("object store endpoint and bucket must be set")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid object store endpoint %s", cfg.Endpoint)
+	}
+	partSize := cfg.PartSizeMB << 20
+	if partSize <= 0 {
+		partSize = DefaultConfig.PartSizeMB << 20
+	}
+	return &s3Store{
+		endpoint: u,
+		cfg:      cfg,
+		partSize: partSize,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.ReaderAt, size int64) error {
+	if size <= s.partSize {
+		resp, err := s.do(ctx, http.MethodPut, key, nil, nil, io.NewSectionReader(r, 0, size), size)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	return s.putMultipart(ctx, key, r, size)
+}
+
+func (s *s3Store) putMultipart(ctx context.Context, key string, r io.ReaderAt, size int64) (err error) {
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	var initiated initiateMultipartUploadResult
+	err = xml.NewDecoder(resp.Body).Decode(&initiated)
+	resp.Body.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to decode multipart upload result")
+	}
+	uploadID := url.Values{"uploadId": {initiated.UploadID}}
+	defer func() {
+		if err == nil {
+			return
+		}
+		// abort the upload to release the uploaded parts
+		if resp, e := s.do(context.Background(), http.MethodDelete, key, uploadID, nil, nil, 0); e == nil {
+			resp.Body.Close()
+		}
+	}()
+	var complete completeMultipartUpload
+	for offset, n := int64(0), 1; offset < size; offset, n = offset+s.partSize, n+1 {
+		length := s.partSize
+		if offset+length > size {
+			length = size - offset
+		}
+		query := url.Values{"uploadId": {initiated.UploadID}, "partNumber": {strconv.Itoa(n)}}
+		resp, err = s.do(ctx, http.MethodPut, key, query, nil, io.NewSectionReader(r, offset, length), length)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		complete.Parts = append(complete.Parts, completedPart{PartNumber: n, ETag: resp.Header.Get("ETag")})
+	}
+	body, err := xml.Marshal(&complete)
+	if err != nil {
+		return err
+	}
+	resp, err = s.do(ctx, http.MethodPost, key, uploadID, nil, bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *s3Store) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	resp, err := s.do(ctx, http.MethodGet, key, nil, header, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) Size(ctx context.Context, key string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, nil, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// do sends a signed request, and returns the response if the status is 2xx
+func (s *s3Store) do(ctx context.Context, method, key string, query url.Values, header http.Header, body io.Reader, size int64) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.cfg.Bucket + "/" + strings.TrimPrefix(key, "/")
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	s.sign(req, query)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(ErrRequest, err.Error())
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrNotExist, "key = %s", key)
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, errors.Wrapf(ErrRequest, "%s %s returns %d: %s", method, key, resp.StatusCode, msg)
+}
+
+// sign adds the AWS signature version 4 to the request
+func (s *s3Store) sign(req *http.Request, query url.Values) {
+	var (
+		now       = s.now().UTC()
+		amzDate   = now.Format(_amzDateFormat)
+		shortDate = now.Format(_shortDateFormat)
+		scope     = strings.Join([]string{shortDate, s.cfg.Region, "s3", "aws4_request"}, "/")
+		signed    = "host;x-amz-content-sha256;x-amz-date"
+	)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", _unsignedPayload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(query),
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + _unsignedPayload + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signed,
+		_unsignedPayload,
+	}, "\n")
+	h := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{_signAlgorithm, amzDate, scope, hex.EncodeToString(h[:])}, "\n")
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), shortDate)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		_signAlgorithm, s.cfg.AccessKeyID, scope, signed, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, uriEncode(k, true)+"="+uriEncode(query.Get(k), true))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode encodes every byte except the unreserved characters, and '/' if
+// encodeSlash is false
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
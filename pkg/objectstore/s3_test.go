@@ -0,0 +1,70 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Store(t *testing.T) {
+	r := require.New(t)
+	fake, cfg := NewFakeS3()
+	defer fake.Close()
+
+	_, err := NewS3Store(Config{})
+	r.Error(err)
+	store, err := NewS3Store(cfg)
+	r.NoError(err)
+	// use 1MB part to exercise multipart upload
+	store.(*s3Store).partSize = 1 << 20
+
+	ctx := context.Background()
+	for _, size := range []int{10, 1<<20 + 100, 3 << 20} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i * 7)
+		}
+		key := "dir/chain-00000001.db"
+		r.NoError(store.Put(ctx, key, bytes.NewReader(data), int64(size)))
+		stored, ok := fake.Object(key)
+		r.True(ok)
+		r.Equal(data, stored)
+		n, err := store.Size(ctx, key)
+		r.NoError(err)
+		r.EqualValues(size, n)
+		// ranged read
+		rc, err := store.GetRange(ctx, key, 3, 5)
+		r.NoError(err)
+		b, err := io.ReadAll(rc)
+		r.NoError(err)
+		r.NoError(rc.Close())
+		r.Equal(data[3:8], b)
+	}
+	// two multipart uploads, each initiated and completed by POST
+	r.Equal(4, fake.Requests[http.MethodPost])
+
+	// delete
+	r.NoError(store.Delete(ctx, "dir/chain-00000001.db"))
+	_, err = store.Size(ctx, "dir/chain-00000001.db")
+	r.Equal(ErrNotExist, errors.Cause(err))
+	_, err = store.GetRange(ctx, "dir/chain-00000001.db", 0, 1)
+	r.Equal(ErrNotExist, errors.Cause(err))
+
+	// wrong credential is rejected
+	cfg.SecretAccessKey = "wrong"
+	store, err = NewS3Store(cfg)
+	r.NoError(err)
+	err = store.Put(ctx, "key", bytes.NewReader([]byte{1}), 1)
+	r.Equal(ErrRequest, errors.Cause(err))
+	r.Contains(err.Error(), "403")
+}
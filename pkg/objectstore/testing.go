@@ -0,0 +1,158 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package objectstore
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// FakeS3 is an in-memory S3 stand-in for testing, supporting single and
+	// multipart upload, ranged get, head and delete
+	FakeS3 struct {
+		*httptest.Server
+		cfg     Config
+		mutex   sync.Mutex
+		objects map[string][]byte
+		uploads map[string]map[int][]byte
+		nextID  int
+		// Requests counts the requests received, per method
+		Requests map[string]int
+	}
+)
+
+// NewFakeS3 starts a FakeS3 server, and returns it with a Config to access it
+func NewFakeS3() (*FakeS3, Config) {
+	f := &FakeS3{
+		objects:  make(map[string][]byte),
+		uploads:  make(map[string]map[int][]byte),
+		Requests: make(map[string]int),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	f.cfg = Config{
+		Endpoint:        f.Server.URL,
+		Region:          DefaultConfig.Region,
+		Bucket:          "test-bucket",
+		AccessKeyID:     "test-access-key",
+		SecretAccessKey: "test-secret-key",
+		Timeout:         10 * time.Second,
+		PartSizeMB:      DefaultConfig.PartSizeMB,
+	}
+	return f, f.cfg
+}
+
+// Object returns the object stored at key
+func (f *FakeS3) Object(key string) ([]byte, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	v, ok := f.objects[key]
+	return v, ok
+}
+
+func (f *FakeS3) serve(w http.ResponseWriter, req *http.Request) {
+	if !f.verify(req) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.Requests[req.Method]++
+	prefix := "/" + f.cfg.Bucket + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	var (
+		key      = strings.TrimPrefix(req.URL.Path, prefix)
+		query    = req.URL.Query()
+		uploadID = query.Get("uploadId")
+	)
+	switch req.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if uploadID == "" {
+			f.objects[key] = data
+			return
+		}
+		parts, ok := f.uploads[uploadID]
+		if !ok {
+			http.Error(w, "NoSuchUpload", http.StatusNotFound)
+			return
+		}
+		n, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[n] = data
+		w.Header().Set("ETag", fmt.Sprintf("\"%d-%d\"", n, len(data)))
+	case http.MethodPost:
+		if _, ok := query["uploads"]; ok {
+			f.nextID++
+			id := strconv.Itoa(f.nextID)
+			f.uploads[id] = make(map[int][]byte)
+			fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", id)
+			return
+		}
+		parts, ok := f.uploads[uploadID]
+		if !ok {
+			http.Error(w, "NoSuchUpload", http.StatusNotFound)
+			return
+		}
+		var complete completeMultipartUpload
+		if err := xml.NewDecoder(req.Body).Decode(&complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sort.Slice(complete.Parts, func(i, j int) bool { return complete.Parts[i].PartNumber < complete.Parts[j].PartNumber })
+		var buf bytes.Buffer
+		for _, p := range complete.Parts {
+			buf.Write(parts[p.PartNumber])
+		}
+		f.objects[key] = buf.Bytes()
+		delete(f.uploads, uploadID)
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		http.ServeContent(w, req, key, time.Time{}, bytes.NewReader(data))
+	case http.MethodDelete:
+		if uploadID != "" {
+			delete(f.uploads, uploadID)
+		} else {
+			delete(f.objects, key)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// verify checks the request is signed with the server's credentials
+func (f *FakeS3) verify(req *http.Request) bool {
+	now, err := time.Parse(_amzDateFormat, req.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+	got := req.Header.Get("Authorization")
+	s := &s3Store{cfg: f.cfg, now: func() time.Time { return now }}
+	clone := req.Clone(req.Context())
+	clone.URL.Host = req.Host
+	s.sign(clone, req.URL.Query())
+	return got == clone.Header.Get("Authorization")
+}
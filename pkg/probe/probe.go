@@ -22,6 +22,7 @@ import (
 type Server struct {
 	lifecycle.Readiness
 	server           http.Server
+	mux              *http.ServeMux
 	readinessHandler http.Handler
 }
 
@@ -54,10 +55,16 @@ func New(port int, opts ...Option) *Server {
 	mux.HandleFunc("/health", readiness)
 	mux.Handle("/metrics", promhttp.Handler())
 
+	s.mux = mux
 	s.server = httputil.NewServer(fmt.Sprintf(":%d", port), mux)
 	return s
 }
 
+// Handle registers an additional handler for the pattern, such as the status of a component
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
 // Start starts the probe server and starts returning success status on liveness endpoint.
 func (s *Server) Start(_ context.Context) error {
 	go func() {
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/signer/signerpb"
+)
+
+type (
+	// Config is the config of the remote signer
+	Config struct {
+		// Endpoint is the gRPC endpoint of the signer
+		Endpoint string `yaml:"endpoint"`
+		// Timeout is the timeout of a request to the signer
+		Timeout time.Duration `yaml:"timeout"`
+		// CACert is the path of the CA certificate the signer's certificate is verified against
+		CACert string `yaml:"caCert"`
+		// Cert is the path of the client certificate presented to the signer
+		Cert string `yaml:"cert"`
+		// Key is the path of the key of the client certificate
+		Key string `yaml:"key"`
+		// ServerName is the name the signer's certificate is verified for, the host of the endpoint if empty
+		ServerName string `yaml:"serverName"`
+	}
+
+	remoteSigner struct {
+		client  signerpb.SignerServiceClient
+		pk      crypto.PublicKey
+		timeout time.Duration
+	}
+)
+
+// DefaultConfig is the default config of the remote signer
+var DefaultConfig = Config{
+	Timeout: 2 * time.Second,
+}
+
+// TLSConfig loads the mutual TLS config of the connection to the signer
+func (cfg Config) TLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.Cert, cfg.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the client certificate")
+	}
+	ca, err := os.ReadFile(cfg.CACert)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CA certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to parse the CA certificate")
+	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   cfg.ServerName,
+	}, nil
+}
+
+// Dial connects to the remote signer over mutual TLS, and returns a signer for each key held by it
+func Dial(cfg Config) ([]Signer, error) {
+	tlsCfg, err := cfg.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to the signer %s", cfg.Endpoint)
+	}
+	signers, err := newRemoteSigners(signerpb.NewSignerServiceClient(conn), cfg.Timeout)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return signers, nil
+}
+
+func newRemoteSigners(client signerpb.SignerServiceClient, timeout time.Duration) ([]Signer, error) {
+	if timeout <= 0 {
+		timeout = DefaultConfig.Timeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, err := client.PublicKeys(ctx, &signerpb.PublicKeysRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the public keys from the signer")
+	}
+	if len(resp.PublicKeys) == 0 {
+		return nil, errors.New("the signer holds no key")
+	}
+	signers := make([]Signer, 0, len(resp.PublicKeys))
+	for _, b := range resp.PublicKeys {
+		pk, err := crypto.BytesToPublicKey(b)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid public key from the signer")
+		}
+		signers = append(signers, &remoteSigner{
+			client:  client,
+			pk:      pk,
+			timeout: timeout,
+		})
+	}
+	return signers, nil
+}
+
+func (s *remoteSigner) PublicKey() crypto.PublicKey {
+	return s.pk
+}
+
+func (s *remoteSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.Sign(ctx, &signerpb.SignRequest{
+		PublicKey: s.pk.Bytes(),
+		Hash:      hash,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign with %s", s.pk.Address())
+	}
+	// the signature is verified, so that a faulty signer is caught before the message is broadcast
+	if !s.pk.Verify(hash, resp.Signature) {
+		return nil, errors.Wrapf(ErrInvalidSignature, "signature of %s", s.pk.Address())
+	}
+	return resp.Signature, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/signer/signerpb"
+)
+
+// Server serves the signing requests with the signers, it is the reference of the external signer process
+type Server struct {
+	signers map[string]Signer
+	keys    [][]byte
+}
+
+// NewServer creates a signer server
+func NewServer(signers ...Signer) *Server {
+	s := &Server{
+		signers: make(map[string]Signer, len(signers)),
+		keys:    make([][]byte, 0, len(signers)),
+	}
+	for _, signer := range signers {
+		pk := signer.PublicKey().Bytes()
+		s.signers[hex.EncodeToString(pk)] = signer
+		s.keys = append(s.keys, pk)
+	}
+	return s
+}
+
+// ServerTLSConfig loads the mutual TLS config of the signer, the client certificates are verified against the
+// CA certificate
+func (cfg Config) ServerTLSConfig() (*tls.Config, error) {
+	tlsCfg, err := cfg.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg.ClientCAs = tlsCfg.RootCAs
+	tlsCfg.RootCAs = nil
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsCfg, nil
+}
+
+// PublicKeys returns the public keys of the signers
+func (s *Server) PublicKeys(context.Context, *signerpb.PublicKeysRequest) (*signerpb.PublicKeysResponse, error) {
+	return &signerpb.PublicKeysResponse{
+		PublicKeys: s.keys,
+	}, nil
+}
+
+// Sign signs the hash with the signer of the public key
+func (s *Server) Sign(ctx context.Context, request *signerpb.SignRequest) (*signerpb.SignResponse, error) {
+	signer, ok := s.signers[hex.EncodeToString(request.PublicKey)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "key not found")
+	}
+	if len(request.Hash) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "invalid hash")
+	}
+	sig, err := signer.Sign(ctx, request.Hash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &signerpb.SignResponse{
+		Signature: sig,
+	}, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// Package signer signs the consensus messages and system actions with the block producer keys, which may be held
+// by an external signer process so that they never end up in the node memory.
+package signer
+
+import (
+	"context"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidSignature indicates that the signature does not match the hash and the public key
+var ErrInvalidSignature = errors.New("invalid signature")
+
+type (
+	// Signer signs the hashes with a key it holds
+	Signer interface {
+		// PublicKey returns the public key of the key
+		PublicKey() crypto.PublicKey
+		// Sign signs the hash
+		Sign(context.Context, []byte) ([]byte, error)
+	}
+
+	localSigner struct {
+		sk crypto.PrivateKey
+	}
+
+	// signerKey is a private key signing through a signer, the key itself is not accessible
+	signerKey struct {
+		signer Signer
+	}
+)
+
+// NewLocal creates a signer holding the key in process
+func NewLocal(sk crypto.PrivateKey) Signer {
+	return &localSigner{
+		sk: sk,
+	}
+}
+
+func (s *localSigner) PublicKey() crypto.PublicKey {
+	return s.sk.PublicKey()
+}
+
+func (s *localSigner) Sign(_ context.Context, hash []byte) ([]byte, error) {
+	return s.sk.Sign(hash)
+}
+
+// PrivateKey returns a private key signing through the signer, which can be used wherever the block producer
+// key is used. The key bytes are not accessible, and it cannot be zeroed
+func PrivateKey(s Signer) crypto.PrivateKey {
+	if local, ok := s.(*localSigner); ok {
+		return local.sk
+	}
+	return &signerKey{
+		signer: s,
+	}
+}
+
+// IsRemote returns true if the private key signs through a signer not holding the key in process
+func IsRemote(sk crypto.PrivateKey) bool {
+	_, ok := sk.(*signerKey)
+	return ok
+}
+
+func (k *signerKey) Bytes() []byte {
+	return nil
+}
+
+func (k *signerKey) HexString() string {
+	return ""
+}
+
+func (k *signerKey) EcdsaPrivateKey() interface{} {
+	return nil
+}
+
+func (k *signerKey) PublicKey() crypto.PublicKey {
+	return k.signer.PublicKey()
+}
+
+func (k *signerKey) Sign(hash []byte) ([]byte, error) {
+	return k.signer.Sign(context.Background(), hash)
+}
+
+func (k *signerKey) Zero() {}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/iotexproject/iotex-core/v2/pkg/signer/signerpb"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+)
+
+type slowSigner struct {
+	Signer
+	delay time.Duration
+}
+
+func (s *slowSigner) Sign(ctx context.Context, h []byte) ([]byte, error) {
+	select {
+	case <-time.After(s.delay):
+		return s.Signer.Sign(ctx, h)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type badSigner struct {
+	Signer
+}
+
+func (s *badSigner) Sign(ctx context.Context, h []byte) ([]byte, error) {
+	return identityset.PrivateKey(0).Sign(h)
+}
+
+func TestPrivateKey(t *testing.T) {
+	require := require.New(t)
+	sk := identityset.PrivateKey(1)
+	h := hash.Hash256b([]byte("test"))
+
+	// the local key is used as is
+	require.Equal(sk, PrivateKey(NewLocal(sk)))
+	require.False(IsRemote(PrivateKey(NewLocal(sk))))
+
+	key := PrivateKey(&slowSigner{Signer: NewLocal(sk)})
+	require.True(IsRemote(key))
+	require.Nil(key.Bytes())
+	require.Empty(key.HexString())
+	require.Nil(key.EcdsaPrivateKey())
+	require.Equal(sk.PublicKey().Address(), key.PublicKey().Address())
+	sig, err := key.Sign(h[:])
+	require.NoError(err)
+	require.True(sk.PublicKey().Verify(h[:], sig))
+}
+
+func TestRemoteSigner(t *testing.T) {
+	require := require.New(t)
+	sk1, sk2 := identityset.PrivateKey(1), identityset.PrivateKey(2)
+	client := dialInsecure(t, serve(t, NewServer(
+		NewLocal(sk1),
+		&slowSigner{Signer: NewLocal(sk2), delay: time.Second},
+	)))
+
+	signers, err := newRemoteSigners(client, 100*time.Millisecond)
+	require.NoError(err)
+	require.Len(signers, 2)
+	require.Equal(sk1.PublicKey().Address(), signers[0].PublicKey().Address())
+	h := hash.Hash256b([]byte("test"))
+	sig, err := signers[0].Sign(context.Background(), h[:])
+	require.NoError(err)
+	require.True(sk1.PublicKey().Verify(h[:], sig))
+
+	// the request times out
+	_, err = signers[1].Sign(context.Background(), h[:])
+	require.Error(err)
+
+	// the signature not matching the key is rejected
+	client = dialInsecure(t, serve(t, NewServer(&badSigner{Signer: NewLocal(sk1)})))
+	signers, err = newRemoteSigners(client, time.Second)
+	require.NoError(err)
+	_, err = signers[0].Sign(context.Background(), h[:])
+	require.ErrorIs(err, ErrInvalidSignature)
+}
+
+func TestMutualTLS(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	ca, caKey := newCert(t, dir, "ca", nil, nil)
+	newCert(t, dir, "server", ca, caKey)
+	newCert(t, dir, "client", ca, caKey)
+	serverCfg := Config{
+		CACert: filepath.Join(dir, "ca.pem"),
+		Cert:   filepath.Join(dir, "server.pem"),
+		Key:    filepath.Join(dir, "server.key"),
+	}
+	tlsCfg, err := serverCfg.ServerTLSConfig()
+	require.NoError(err)
+	sk := identityset.PrivateKey(1)
+	endpoint := serve(t, NewServer(NewLocal(sk)), grpc.Creds(credentials.NewTLS(tlsCfg)))
+
+	cfg := Config{
+		Endpoint:   endpoint,
+		Timeout:    time.Second,
+		CACert:     filepath.Join(dir, "ca.pem"),
+		Cert:       filepath.Join(dir, "client.pem"),
+		Key:        filepath.Join(dir, "client.key"),
+		ServerName: "localhost",
+	}
+	signers, err := Dial(cfg)
+	require.NoError(err)
+	require.Len(signers, 1)
+	h := hash.Hash256b([]byte("test"))
+	sig, err := PrivateKey(signers[0]).Sign(h[:])
+	require.NoError(err)
+	require.True(sk.PublicKey().Verify(h[:], sig))
+
+	// the client without a certificate signed by the CA is rejected
+	other, otherKey := newCert(t, dir, "other", nil, nil)
+	newCert(t, dir, "stranger", other, otherKey)
+	cfg.Cert, cfg.Key = filepath.Join(dir, "stranger.pem"), filepath.Join(dir, "stranger.key")
+	_, err = Dial(cfg)
+	require.Error(err)
+}
+
+func serve(t *testing.T, s *Server, opts ...grpc.ServerOption) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	gs := grpc.NewServer(opts...)
+	signerpb.RegisterSignerServiceServer(gs, s)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+	return lis.Addr().String()
+}
+
+func dialInsecure(t *testing.T, endpoint string) signerpb.SignerServiceClient {
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return signerpb.NewSignerServiceClient(conn)
+}
+
+// newCert creates a certificate signed by the parent, or a self-signed CA certificate if the parent is nil
+func newCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return cert, key
+}
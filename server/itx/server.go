@@ -24,6 +24,7 @@ import (
 	"github.com/iotexproject/iotex-core/v2/api"
 	"github.com/iotexproject/iotex-core/v2/chainservice"
 	"github.com/iotexproject/iotex-core/v2/config"
+	"github.com/iotexproject/iotex-core/v2/consensus"
 	"github.com/iotexproject/iotex-core/v2/dispatcher"
 	"github.com/iotexproject/iotex-core/v2/p2p"
 	"github.com/iotexproject/iotex-core/v2/pkg/ha"
@@ -44,6 +45,7 @@ type Server struct {
 	dispatcher           dispatcher.Dispatcher
 	nodeStats            *nodestats.NodeStats
 	pauseMgr             *PauseMgr
+	elector              *ha.Elector
 	initializedSubChains map[uint32]bool
 	mutex                sync.RWMutex
 	subModuleCancel      context.CancelFunc
@@ -122,7 +124,9 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 	}
 	nodeStats := nodestats.NewNodeStats(rpcStats, cs.BlockSync(), p2pAgent)
 	pauseMgr := NewPauseMgr(cs.Blockchain(), cs)
-	apiServer, err := cs.NewAPIServer(cfg.API, cfg.Chain.EnableArchiveMode)
+	// the states below the tip are readable in archive mode, or reconstructed from the state diffs
+	archive := cfg.Chain.EnableArchiveMode || (len(cfg.Chain.StateDiffDBPath) > 0 && cfg.Chain.HistoryStateDepth > 0)
+	apiServer, err := cs.NewAPIServer(cfg.API, archive)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create api server")
 	}
@@ -135,6 +139,16 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 	// TODO: explorer dependency deleted here at #1085, need to revive by migrating to api
 	chains[cs.ChainID()] = cs
 	dispatcher.AddSubscriber(cs.ChainID(), cs)
+	var elector *ha.Elector
+	if cfg.System.HA.Enabled {
+		backend, err := ha.NewBackend(cfg.System.HA)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create lease backend")
+		}
+		if elector, err = ha.NewElector(cfg.System.HA, backend, cs.Consensus()); err != nil {
+			return nil, errors.Wrap(err, "failed to create lease elector")
+		}
+	}
 	svr := Server{
 		cfg:                  cfg,
 		p2pAgent:             p2pAgent,
@@ -144,6 +158,7 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 		apiServers:           apiServers,
 		nodeStats:            nodeStats,
 		pauseMgr:             pauseMgr,
+		elector:              elector,
 		initializedSubChains: map[uint32]bool{},
 	}
 	// Setup sub-chain starter
@@ -174,12 +189,22 @@ func (s *Server) Start(ctx context.Context) error {
 	if err := s.nodeStats.Start(cctx); err != nil {
 		return errors.Wrap(err, "error when starting node stats")
 	}
+	if s.elector != nil {
+		if err := s.elector.Start(cctx); err != nil {
+			return errors.Wrap(err, "error when starting lease elector")
+		}
+	}
 	return nil
 }
 
 // Stop stops the server
 func (s *Server) Stop(ctx context.Context) error {
 	defer s.subModuleCancel()
+	if s.elector != nil {
+		if err := s.elector.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping lease elector")
+		}
+	}
 	if err := s.nodeStats.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping node stats")
 	}
@@ -277,6 +302,9 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		log.L().Info("Waiting for server to be ready.", zap.Duration("duration", cfg.API.ReadyDuration))
 		time.Sleep(cfg.API.ReadyDuration)
 	}
+	if svr.elector != nil {
+		probeSvr.Handle("/ha", svr.elector)
+	}
 	if err := probeSvr.TurnOn(); err != nil {
 		log.L().Panic("Failed to turn on probe server.", zap.Error(err))
 	}
@@ -298,8 +326,15 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 	if cfg.System.HTTPAdminPort > 0 {
 		mux := http.NewServeMux()
 		log.RegisterLevelConfigMux(mux)
-		haCtl := ha.New(svr.rootChainService.Consensus())
+		var haOpts []ha.Option
+		if svr.elector != nil {
+			haOpts = append(haOpts, ha.WithElector(svr.elector))
+		}
+		haCtl := ha.New(svr.rootChainService.Consensus(), haOpts...)
 		mux.Handle("/ha", http.HandlerFunc(haCtl.Handle))
+		if cs, ok := svr.rootChainService.Consensus().(*consensus.IotxConsensus); ok {
+			mux.Handle("/consensus/round", http.HandlerFunc(cs.HandleRoundState))
+		}
 		mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
 		mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
 		mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
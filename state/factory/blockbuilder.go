@@ -0,0 +1,268 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/actpool"
+	"github.com/iotexproject/iotex-core/v2/actpool/actioniterator"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+)
+
+const (
+	// GasPriceBlockBuilder picks the action with the highest gas price first
+	GasPriceBlockBuilder = "gasPrice"
+	// EffectiveTipBlockBuilder picks the action with the highest effective tip
+	// after base fee first
+	EffectiveTipBlockBuilder = "effectiveTip"
+	// FCFSBlockBuilder picks the action arriving at the actpool first
+	FCFSBlockBuilder = "fcfs"
+)
+
+// reasons of skipping an action when building a block
+const (
+	_skipGasLimit     = "gasLimit"
+	_skipReservedGas  = "reservedGas"
+	_skipBlobLimit    = "blobLimit"
+	_skipUnfold       = "unfold"
+	_skipNonceTooLow  = "nonceTooLow"
+	_skipInvalidState = "invalidState"
+	_skipInvalid      = "invalid"
+	_skipGasExhausted = "gasExhausted"
+	_skipRejected     = "rejected"
+)
+
+var (
+	_skipReasons = []string{
+		_skipGasLimit,
+		_skipReservedGas,
+		_skipBlobLimit,
+		_skipUnfold,
+		_skipNonceTooLow,
+		_skipInvalidState,
+		_skipInvalid,
+		_skipGasExhausted,
+		_skipRejected,
+	}
+
+	_mintSkippedActions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_mint_skipped_actions",
+			Help: "Number of actions skipped when minting the last block",
+		},
+		[]string{"builder", "reason"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(_mintSkippedActions)
+}
+
+type (
+	// BlockBuilder is the strategy to pick pending actions into a new block
+	BlockBuilder interface {
+		// Name returns the name of the strategy
+		Name() string
+		// ActionIterator returns an iterator of the pending actions, in the order
+		// to be picked into the block
+		ActionIterator(context.Context, map[string][]*action.SealedEnvelope) actioniterator.ActionIterator
+		// GasLimit returns the gas the action can use, out of the gas left in the block
+		GasLimit(context.Context, *action.SealedEnvelope, uint64) uint64
+	}
+
+	gasPriceBuilder struct{}
+
+	effectiveTipBuilder struct{}
+
+	// fcfsBuilder records the arrival time of actions added into the actpool
+	fcfsBuilder struct {
+		arrival cache.LRUCache
+	}
+
+	reservedGasBuilder struct {
+		BlockBuilder
+		reserved uint64
+	}
+
+	blockBuilderContextKey struct{}
+)
+
+// NewBlockBuilder creates a block builder of the strategy. If reservedGas is
+// positive, the gas is reserved for staking and system staking contract actions.
+func NewBlockBuilder(strategy string, reservedGas uint64, ap actpool.ActPool) (BlockBuilder, error) {
+	var b BlockBuilder
+	switch strategy {
+	case "", GasPriceBlockBuilder:
+		b = &gasPriceBuilder{}
+	case EffectiveTipBlockBuilder:
+		b = &effectiveTipBuilder{}
+	case FCFSBlockBuilder:
+		if ap == nil {
+			return nil, errors.New("fcfs block builder requires actpool")
+		}
+		fb := &fcfsBuilder{
+			arrival: cache.NewThreadSafeLruCache(int(ap.GetCapacity())),
+		}
+		ap.AddSubscriber(fb)
+		b = fb
+	default:
+		return nil, errors.Errorf("unknown block builder %s", strategy)
+	}
+	if reservedGas > 0 {
+		b = &reservedGasBuilder{BlockBuilder: b, reserved: reservedGas}
+	}
+	return b, nil
+}
+
+func withBlockBuilder(ctx context.Context, b BlockBuilder) context.Context {
+	return context.WithValue(ctx, blockBuilderContextKey{}, b)
+}
+
+// getBlockBuilder returns the block builder in context, or picks actions by gas price
+func getBlockBuilder(ctx context.Context) BlockBuilder {
+	if b, ok := ctx.Value(blockBuilderContextKey{}).(BlockBuilder); ok && b != nil {
+		return b
+	}
+	return &gasPriceBuilder{}
+}
+
+func (*gasPriceBuilder) Name() string {
+	return GasPriceBlockBuilder
+}
+
+func (*gasPriceBuilder) ActionIterator(_ context.Context, acts map[string][]*action.SealedEnvelope) actioniterator.ActionIterator {
+	return actioniterator.NewActionIterator(acts)
+}
+
+func (*gasPriceBuilder) GasLimit(_ context.Context, _ *action.SealedEnvelope, gasLeft uint64) uint64 {
+	return gasLeft
+}
+
+func (*effectiveTipBuilder) Name() string {
+	return EffectiveTipBlockBuilder
+}
+
+func (*effectiveTipBuilder) ActionIterator(ctx context.Context, acts map[string][]*action.SealedEnvelope) actioniterator.ActionIterator {
+	var (
+		baseFee = protocol.MustGetBlockCtx(ctx).BaseFee
+		tips    = make(map[*action.SealedEnvelope]*big.Int)
+	)
+	for _, accActs := range acts {
+		for _, act := range accActs {
+			tip, err := action.EffectiveGasTip(act, baseFee)
+			if err != nil {
+				// the fee cap is below base fee, the action cannot be packed
+				tip = big.NewInt(-1)
+			}
+			tips[act] = tip
+		}
+	}
+	return actioniterator.NewActionIteratorWithOrder(acts, func(a, b *action.SealedEnvelope) bool {
+		return tips[a].Cmp(tips[b]) > 0
+	})
+}
+
+func (*effectiveTipBuilder) GasLimit(_ context.Context, _ *action.SealedEnvelope, gasLeft uint64) uint64 {
+	return gasLeft
+}
+
+func (*fcfsBuilder) Name() string {
+	return FCFSBlockBuilder
+}
+
+func (fb *fcfsBuilder) ActionIterator(_ context.Context, acts map[string][]*action.SealedEnvelope) actioniterator.ActionIterator {
+	arrival := make(map[*action.SealedEnvelope]time.Time)
+	for _, accActs := range acts {
+		for _, act := range accActs {
+			// actions not seen, like those loaded at startup, come first
+			var t time.Time
+			if h, err := act.Hash(); err == nil {
+				if v, ok := fb.arrival.Get(h); ok {
+					t = v.(time.Time)
+				}
+			}
+			arrival[act] = t
+		}
+	}
+	return actioniterator.NewActionIteratorWithOrder(acts, func(a, b *action.SealedEnvelope) bool {
+		return arrival[a].Before(arrival[b])
+	})
+}
+
+func (*fcfsBuilder) GasLimit(_ context.Context, _ *action.SealedEnvelope, gasLeft uint64) uint64 {
+	return gasLeft
+}
+
+// OnAdded records the arrival time of the action
+func (fb *fcfsBuilder) OnAdded(_ context.Context, act *action.SealedEnvelope) {
+	if h, err := act.Hash(); err == nil {
+		fb.arrival.Add(h, time.Now())
+	}
+}
+
+// OnRemoved removes the arrival time of the action
+func (fb *fcfsBuilder) OnRemoved(act *action.SealedEnvelope) {
+	if h, err := act.Hash(); err == nil {
+		fb.arrival.Remove(h)
+	}
+}
+
+func (rb *reservedGasBuilder) Name() string {
+	return rb.BlockBuilder.Name() + "+reserved"
+}
+
+// GasLimit leaves the reserved gas to staking and system staking contract actions only
+func (rb *reservedGasBuilder) GasLimit(ctx context.Context, act *action.SealedEnvelope, gasLeft uint64) uint64 {
+	gasLeft = rb.BlockBuilder.GasLimit(ctx, act, gasLeft)
+	if isStakingAction(ctx, act) {
+		return gasLeft
+	}
+	if gasLeft <= rb.reserved {
+		return 0
+	}
+	return gasLeft - rb.reserved
+}
+
+func isNativeStakingAction(act *action.SealedEnvelope) bool {
+	switch act.Action().(type) {
+	case *action.CreateStake, *action.Unstake, *action.WithdrawStake, *action.ChangeCandidate,
+		*action.TransferStake, *action.DepositToStake, *action.Restake, *action.CandidateRegister,
+		*action.CandidateUpdate, *action.CandidateActivate, *action.CandidateEndorsement,
+		*action.CandidateTransferOwnership, *action.MigrateStake:
+		return true
+	}
+	return false
+}
+
+func isStakingAction(ctx context.Context, act *action.SealedEnvelope) bool {
+	if isNativeStakingAction(act) {
+		return true
+	}
+	switch act.Action().(type) {
+	case *action.Execution:
+		g, ok := genesis.ExtractGenesisContext(ctx)
+		if !ok {
+			return false
+		}
+		contract, _ := act.Destination()
+		switch contract {
+		case "":
+			return false
+		case g.SystemStakingContractAddress, g.SystemStakingContractV2Address, g.SystemStakingContractV3Address:
+			return true
+		}
+	}
+	return false
+}
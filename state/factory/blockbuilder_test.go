@@ -0,0 +1,148 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/actpool/actioniterator"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/test/mock/mock_actpool"
+)
+
+func dynamicFeeTransfer(t *testing.T, sender int, nonce uint64, feeCap, tipCap int64) *action.SealedEnvelope {
+	elp := (&action.EnvelopeBuilder{}).SetTxType(action.DynamicFeeTxType).SetNonce(nonce).SetGasLimit(21000).
+		SetDynamicGas(big.NewInt(feeCap), big.NewInt(tipCap)).
+		SetAction(action.NewTransfer(big.NewInt(1), identityset.Address(0).String(), nil)).Build()
+	selp, err := action.Sign(elp, identityset.PrivateKey(sender))
+	require.NoError(t, err)
+	return selp
+}
+
+func pickAll(ai actioniterator.ActionIterator) []*action.SealedEnvelope {
+	var acts []*action.SealedEnvelope
+	for {
+		act, ok := ai.Next()
+		if !ok {
+			return acts
+		}
+		acts = append(acts, act)
+	}
+}
+
+func TestNewBlockBuilder(t *testing.T) {
+	r := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	for _, v := range []struct {
+		strategy string
+		reserved uint64
+		name     string
+	}{
+		{"", 0, GasPriceBlockBuilder},
+		{GasPriceBlockBuilder, 0, GasPriceBlockBuilder},
+		{EffectiveTipBlockBuilder, 0, EffectiveTipBlockBuilder},
+		{EffectiveTipBlockBuilder, 100, EffectiveTipBlockBuilder + "+reserved"},
+	} {
+		b, err := NewBlockBuilder(v.strategy, v.reserved, nil)
+		r.NoError(err)
+		r.Equal(v.name, b.Name())
+	}
+	_, err := NewBlockBuilder("random", 0, nil)
+	r.ErrorContains(err, "unknown block builder")
+	_, err = NewBlockBuilder(FCFSBlockBuilder, 0, nil)
+	r.Error(err)
+
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().GetCapacity().Return(uint64(100)).Times(1)
+	ap.EXPECT().AddSubscriber(gomock.Any()).Times(1)
+	b, err := NewBlockBuilder(FCFSBlockBuilder, 0, ap)
+	r.NoError(err)
+	r.Equal(FCFSBlockBuilder, b.Name())
+
+	// gas price is the default
+	r.Equal(GasPriceBlockBuilder, getBlockBuilder(context.Background()).Name())
+	r.Equal(b, getBlockBuilder(withBlockBuilder(context.Background(), b)))
+}
+
+func TestBlockBuilderOrder(t *testing.T) {
+	r := require.New(t)
+
+	var (
+		// effective tip 10 at base fee 30
+		a = dynamicFeeTransfer(t, 1, 1, 100, 10)
+		// effective tip 30 at base fee 30
+		b = dynamicFeeTransfer(t, 2, 1, 60, 50)
+		// fee cap below base fee
+		c = dynamicFeeTransfer(t, 3, 1, 20, 20)
+	)
+	pending := func() map[string][]*action.SealedEnvelope {
+		acts := make(map[string][]*action.SealedEnvelope)
+		for _, act := range []*action.SealedEnvelope{a, b, c} {
+			acts[act.SenderAddress().String()] = []*action.SealedEnvelope{act}
+		}
+		return acts
+	}
+	ctx := protocol.WithBlockCtx(context.Background(), protocol.BlockCtx{BaseFee: big.NewInt(30)})
+
+	gp := &gasPriceBuilder{}
+	r.Equal([]*action.SealedEnvelope{a, b, c}, pickAll(gp.ActionIterator(ctx, pending())))
+	et := &effectiveTipBuilder{}
+	r.Equal([]*action.SealedEnvelope{b, a, c}, pickAll(et.ActionIterator(ctx, pending())))
+
+	fb := &fcfsBuilder{arrival: cache.NewThreadSafeLruCache(10)}
+	fb.OnAdded(ctx, c)
+	fb.OnAdded(ctx, a)
+	fb.OnAdded(ctx, b)
+	r.Equal([]*action.SealedEnvelope{c, a, b}, pickAll(fb.ActionIterator(ctx, pending())))
+	// action not seen comes first
+	fb.OnRemoved(b)
+	r.Equal([]*action.SealedEnvelope{b, c, a}, pickAll(fb.ActionIterator(ctx, pending())))
+}
+
+func TestReservedGasBuilder(t *testing.T) {
+	r := require.New(t)
+
+	g := genesis.TestDefault()
+	g.SystemStakingContractV2Address = identityset.Address(10).String()
+	ctx := genesis.WithGenesisContext(context.Background(), g)
+
+	tsf, err := action.SignedTransfer(identityset.Address(0).String(), identityset.PrivateKey(1), 1, big.NewInt(1), nil, 21000, big.NewInt(1))
+	r.NoError(err)
+	exec, err := action.SignedExecution(identityset.Address(11).String(), identityset.PrivateKey(1), 2, big.NewInt(0), 100000, big.NewInt(1), nil)
+	r.NoError(err)
+	stakeExec, err := action.SignedExecution(g.SystemStakingContractV2Address, identityset.PrivateKey(1), 3, big.NewInt(0), 100000, big.NewInt(1), nil)
+	r.NoError(err)
+	stake, err := action.SignedCreateStake(4, "c1", "100", 1, false, nil, 100000, big.NewInt(1), identityset.PrivateKey(1))
+	r.NoError(err)
+
+	b, err := NewBlockBuilder(GasPriceBlockBuilder, 50000, nil)
+	r.NoError(err)
+	for _, v := range []struct {
+		act     *action.SealedEnvelope
+		gasLeft uint64
+		limit   uint64
+	}{
+		{tsf, 200000, 150000},
+		{tsf, 50000, 0},
+		{tsf, 10000, 0},
+		{exec, 200000, 150000},
+		{stakeExec, 200000, 200000},
+		{stake, 200000, 200000},
+		{stake, 10000, 10000},
+	} {
+		r.Equal(v.limit, b.GasLimit(ctx, v.act, v.gasLeft))
+	}
+}
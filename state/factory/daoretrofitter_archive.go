@@ -0,0 +1,52 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+// daoRTFArchive keeps the state of every height in a versioned DB, where the
+// state of a block is written at version = block height
+type daoRTFArchive struct {
+	dao db.KvVersioned
+}
+
+func newDaoRetrofitterArchive(dao db.KvVersioned) *daoRTFArchive {
+	return &daoRTFArchive{
+		dao: dao,
+	}
+}
+
+func (rtf *daoRTFArchive) Start(ctx context.Context) error {
+	return rtf.dao.Start(ctx)
+}
+
+func (rtf *daoRTFArchive) Stop(ctx context.Context) error {
+	return rtf.dao.Stop(ctx)
+}
+
+func (rtf *daoRTFArchive) atHeight(h uint64) db.KVStore {
+	return rtf.dao.SetVersion(h)
+}
+
+func (rtf *daoRTFArchive) getHeight() (uint64, error) {
+	height, err := rtf.dao.SetVersion(math.MaxUint64).Get(AccountKVNamespace, []byte(CurrentHeightKey))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get factory's height from underlying DB")
+	}
+	return byteutil.BytesToUint64(height), nil
+}
+
+func (rtf *daoRTFArchive) putHeight(h uint64) error {
+	return rtf.dao.SetVersion(h).Put(AccountKVNamespace, []byte(CurrentHeightKey), byteutil.Uint64ToBytes(h))
+}
@@ -74,6 +74,7 @@ type (
 		Register(protocol.Protocol) error
 		Validate(context.Context, *block.Block) error
 		Mint(context.Context, actpool.ActPool, crypto.PrivateKey) (*block.Block, error)
+		MintPending(context.Context, actpool.ActPool, crypto.PublicKey) (*block.Block, protocol.StateForker, error)
 		PutBlock(context.Context, *block.Block) error
 		WorkingSet(context.Context) (protocol.StateManager, error)
 		WorkingSetAtHeight(context.Context, uint64, ...*action.SealedEnvelope) (protocol.StateManager, error)
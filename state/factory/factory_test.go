@@ -26,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/v2/action"
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
@@ -334,6 +335,14 @@ func TestHistoryState(t *testing.T) {
 	sf, err = NewStateDB(cfg, db2, SkipBlockValidationStateDBOption())
 	r.NoError(err)
 	testHistoryState(sf, t, true, cfg.Chain.EnableArchiveMode)
+
+	// using stateDB in archive mode
+	cfg.Chain.EnableArchiveMode = true
+	dbCfg := db.DefaultConfig
+	dbCfg.DbPath = t.TempDir()
+	sf, err = NewStateDB(cfg, db.NewKVStoreWithVersion(dbCfg, db.VersionAllOption()), SkipBlockValidationStateDBOption())
+	r.NoError(err)
+	testHistoryState(sf, t, false, cfg.Chain.EnableArchiveMode)
 	defer func() {
 		testutil.CleanupPath(file2)
 		testutil.CleanupPath(file4)
@@ -1091,6 +1100,91 @@ func testNewBlockBuilder(factory Factory, t *testing.T) {
 	require.NoError(factory.PutBlock(ctx, blk))
 }
 
+func TestSTXMintPending(t *testing.T) {
+	require := require.New(t)
+	testStateDBPath, err := testutil.PathOfTempFile(_stateDBPath)
+	require.NoError(err)
+
+	cfg := DefaultConfig
+	cfg.Chain.TrieDBPath = testStateDBPath
+	cfg.Genesis.InitBalanceMap[identityset.Address(28).String()] = "100"
+	cfg.Genesis.InitBalanceMap[identityset.Address(29).String()] = "200"
+	// check the balance of the sender before running the action
+	cfg.Genesis.OkhotskBlockHeight = 1
+	registry := protocol.NewRegistry()
+	db2, err := db.CreateKVStoreWithCache(db.DefaultConfig, cfg.Chain.TrieDBPath, cfg.Chain.StateDBCacheSize)
+	require.NoError(err)
+	sdb, err := NewStateDB(cfg, db2, RegistryStateDBOption(registry))
+	require.NoError(err)
+
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	ctx := protocol.WithBlockCtx(
+		genesis.WithGenesisContext(context.Background(), cfg.Genesis),
+		protocol.BlockCtx{},
+	)
+	require.NoError(sdb.Start(ctx))
+	defer func() {
+		require.NoError(sdb.Stop(ctx))
+		testutil.CleanupPath(testStateDBPath)
+	}()
+
+	tsf, err := action.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(28), 1, big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	// the sender has no balance to pay for the transfer
+	invalid, err := action.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(30), 1, big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	ctrl := gomock.NewController(t)
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().PendingActionMap().Return(map[string][]*action.SealedEnvelope{
+		identityset.Address(28).String(): {tsf},
+		identityset.Address(30).String(): {invalid},
+	}).Times(1)
+	// the invalid action is skipped, but not deleted from the actpool
+	ap.EXPECT().DeleteAction(gomock.Any()).Times(0)
+	ctx = protocol.WithBlockCtx(context.Background(),
+		protocol.BlockCtx{
+			BlockHeight: 1,
+			Producer:    identityset.Address(27),
+			GasLimit:    1000000,
+		})
+	ctx = protocol.WithBlockchainCtx(
+		genesis.WithGenesisContext(ctx, cfg.Genesis),
+		protocol.BlockchainCtx{},
+	)
+	ctx = protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+	blk, states, err := sdb.MintPending(ctx, ap, identityset.PrivateKey(27).PublicKey())
+	require.NoError(err)
+	require.Nil(blk.PublicKey())
+	require.Equal(uint64(1), blk.Height())
+	require.Equal([]*action.SealedEnvelope{tsf}, blk.Actions)
+	require.Len(blk.Receipts, 1)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), blk.Receipts[0].Status)
+
+	// the pending state has the transfer, the committed state does not
+	recipient, err := accountutil.AccountState(ctx, states, identityset.Address(29))
+	require.NoError(err)
+	require.Equal(big.NewInt(210), recipient.Balance)
+	recipient, err = accountutil.AccountState(ctx, sdb, identityset.Address(29))
+	require.NoError(err)
+	require.Equal(big.NewInt(200), recipient.Balance)
+	height, err := sdb.Height()
+	require.NoError(err)
+	require.Zero(height)
+
+	// the changes made to a working set on top of the pending state are discarded
+	ws, err := states.WorkingSet(ctx)
+	require.NoError(err)
+	recipient, err = accountutil.AccountState(ctx, ws, identityset.Address(29))
+	require.NoError(err)
+	require.Equal(big.NewInt(210), recipient.Balance)
+	require.NoError(recipient.AddBalance(big.NewInt(5)))
+	require.NoError(accountutil.StoreAccount(ws, identityset.Address(29), recipient))
+	recipient, err = accountutil.AccountState(ctx, states, identityset.Address(29))
+	require.NoError(err)
+	require.Equal(big.NewInt(210), recipient.Balance)
+}
+
 func TestSTXSimulateExecution(t *testing.T) {
 	require := require.New(t)
 	testStateDBPath, err := testutil.PathOfTempFile(_stateDBPath)
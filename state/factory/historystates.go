@@ -0,0 +1,225 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+type (
+	// StateDiffReader reads the state changes recorded for the committed blocks
+	StateDiffReader interface {
+		// Height returns the latest height of which the state changes are recorded
+		Height() (uint64, error)
+		// StateChanges returns the state changes of the block at the height
+		StateChanges(uint64) ([]*StateChange, error)
+		// Retention returns the number of the latest blocks of which the state changes are kept
+		Retention() uint64
+	}
+
+	// historyStates reconstructs the states at a height below the tip, by reverting the state changes of the
+	// blocks after the height
+	historyStates struct {
+		reader StateDiffReader
+		depth  uint64
+		views  cache.LRUCache
+	}
+
+	// historyView keeps the states at the height, which are changed by the blocks in (height, tip], the view
+	// expires once the tip is more than depth blocks above the height, so at most depth blocks are reverted
+	historyView struct {
+		mutex  sync.RWMutex
+		reader StateDiffReader
+		depth  uint64
+		height uint64
+		tip    uint64
+		// states are the values at the height, nil means the state did not exist
+		states map[string]map[string][]byte
+	}
+
+	// historyKVStore serves the reads of the states at the height of the view, the states not changed
+	// after the height are read from the underlying store
+	historyKVStore struct {
+		db.KVStore
+		view *historyView
+	}
+)
+
+func newHistoryStates(reader StateDiffReader, depth uint64, size int) *historyStates {
+	return &historyStates{
+		reader: reader,
+		depth:  depth,
+		views:  cache.NewThreadSafeLruCache(size),
+	}
+}
+
+// kvStore returns the kv store of the states at the height
+func (hs *historyStates) kvStore(kvStore db.KVStore, height, tip uint64) (db.KVStore, error) {
+	if height > tip {
+		return nil, errors.Wrapf(ErrNotSupported, "cannot read state at height %d, current height is %d", height, tip)
+	}
+	if tip-height > hs.depth {
+		// drop the expired view
+		hs.views.Remove(height)
+		return nil, errors.Wrapf(ErrNotSupported, "cannot read state at height %d, which is more than %d blocks below current height %d", height, hs.depth, tip)
+	}
+	var view *historyView
+	if v, ok := hs.views.Get(height); ok {
+		view = v.(*historyView)
+	} else {
+		view = &historyView{
+			reader: hs.reader,
+			depth:  hs.depth,
+			height: height,
+			tip:    height,
+			states: make(map[string]map[string][]byte),
+		}
+	}
+	if err := view.catchUp(); err != nil {
+		hs.views.Remove(height)
+		return nil, err
+	}
+	hs.views.Add(height, view)
+	return &historyKVStore{
+		KVStore: kvStore,
+		view:    view,
+	}, nil
+}
+
+// catchUp reverts the state changes of the blocks committed since the last call
+func (view *historyView) catchUp() error {
+	tip, err := view.reader.Height()
+	if err != nil {
+		return err
+	}
+	view.mutex.RLock()
+	synced := view.tip >= tip
+	view.mutex.RUnlock()
+	if synced {
+		return nil
+	}
+	if tip-view.height > view.depth {
+		return errors.Wrapf(ErrNotSupported, "cannot read state at height %d, which is more than %d blocks below current height %d", view.height, view.depth, tip)
+	}
+	view.mutex.Lock()
+	defer view.mutex.Unlock()
+	for h := view.tip + 1; h <= tip; h++ {
+		changes, err := view.reader.StateChanges(h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to revert state changes at height %d", h)
+		}
+		for _, c := range changes {
+			states, ok := view.states[c.Namespace]
+			if !ok {
+				states = make(map[string][]byte)
+				view.states[c.Namespace] = states
+			}
+			// only the earliest change after the height holds the value at the height
+			if _, ok := states[string(c.Key)]; !ok {
+				states[string(c.Key)] = c.Previous
+			}
+		}
+		view.tip = h
+	}
+	return nil
+}
+
+func (view *historyView) get(ns string, key []byte) ([]byte, bool) {
+	view.mutex.RLock()
+	defer view.mutex.RUnlock()
+	value, ok := view.states[ns][string(key)]
+	return value, ok
+}
+
+func (store *historyKVStore) Get(ns string, key []byte) ([]byte, error) {
+	if ns == AccountKVNamespace && bytes.Equal(key, []byte(CurrentHeightKey)) {
+		return byteutil.Uint64ToBytes(store.view.height), nil
+	}
+	value, err := store.KVStore.Get(ns, key)
+	if err != nil && errors.Cause(err) != db.ErrNotExist && errors.Cause(err) != db.ErrBucketNotExist {
+		return nil, err
+	}
+	// the diff of a block is recorded before the block is committed to the underlying store, so catching up
+	// after the read covers the block which the value is read from
+	if err := store.view.catchUp(); err != nil {
+		return nil, err
+	}
+	prev, ok := store.view.get(ns, key)
+	switch {
+	case !ok:
+		return value, err
+	case prev == nil:
+		return nil, errors.Wrapf(db.ErrNotExist, "state of ns = %s and key = %x does not exist at height %d", ns, key, store.view.height)
+	default:
+		return prev, nil
+	}
+}
+
+func (store *historyKVStore) Filter(ns string, cond db.Condition, minKey, maxKey []byte) ([][]byte, [][]byte, error) {
+	if err := store.view.catchUp(); err != nil {
+		return nil, nil, err
+	}
+	// the values of the reverted states in the underlying store are not at the height
+	fk, fv, err := store.KVStore.Filter(ns, func(k, v []byte) bool {
+		if _, ok := store.view.get(ns, k); ok {
+			return false
+		}
+		return cond(k, v)
+	}, minKey, maxKey)
+	if err != nil && errors.Cause(err) != db.ErrNotExist && errors.Cause(err) != db.ErrBucketNotExist {
+		return nil, nil, err
+	}
+	if err := store.view.catchUp(); err != nil {
+		return nil, nil, err
+	}
+	store.view.mutex.RLock()
+	defer store.view.mutex.RUnlock()
+	states := store.view.states[ns]
+	keys := make([][]byte, 0, len(fk))
+	values := make([][]byte, 0, len(fv))
+	for i, k := range fk {
+		if _, ok := states[string(k)]; !ok {
+			keys = append(keys, k)
+			values = append(values, fv[i])
+		}
+	}
+	reverted := make([]string, 0, len(states))
+	for k, v := range states {
+		key := []byte(k)
+		if v == nil {
+			continue
+		}
+		if len(minKey) > 0 && bytes.Compare(key, minKey) < 0 {
+			continue
+		}
+		if len(maxKey) > 0 && bytes.Compare(key, maxKey) > 0 {
+			continue
+		}
+		reverted = append(reverted, k)
+	}
+	sort.Strings(reverted)
+	for _, k := range reverted {
+		if v := states[k]; cond([]byte(k), v) {
+			keys = append(keys, []byte(k))
+			values = append(values, v)
+		}
+	}
+	if len(keys) == 0 {
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Wrapf(db.ErrNotExist, "no state of ns = %s exists at height %d", ns, store.view.height)
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+type testDiffReader struct {
+	changes   [][]*StateChange
+	retention uint64
+}
+
+func (r *testDiffReader) Height() (uint64, error) {
+	return uint64(len(r.changes)), nil
+}
+
+func (r *testDiffReader) StateChanges(height uint64) ([]*StateChange, error) {
+	if height == 0 || height > uint64(len(r.changes)) {
+		return nil, errors.Errorf("height %d is not recorded", height)
+	}
+	return r.changes[height-1], nil
+}
+
+func (r *testDiffReader) Retention() uint64 {
+	return r.retention
+}
+
+func TestHistoryStates(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	path, err := testutil.PathOfTempFile("history")
+	r.NoError(err)
+	defer testutil.CleanupPath(path)
+	cfg := db.DefaultConfig
+	cfg.DbPath = path
+	kv := db.NewBoltDB(cfg)
+	r.NoError(kv.Start(ctx))
+	defer kv.Stop(ctx)
+
+	// apply the changes to the store, and record them in the reader
+	reader := &testDiffReader{}
+	commit := func(changes ...*StateChange) {
+		for _, c := range changes {
+			if c.Current == nil {
+				r.NoError(kv.Delete(c.Namespace, c.Key))
+			} else {
+				r.NoError(kv.Put(c.Namespace, c.Key, c.Current))
+			}
+		}
+		reader.changes = append(reader.changes, changes)
+	}
+	commit(
+		&StateChange{Namespace: "ns", Key: []byte("a"), Current: []byte("a1")},
+		&StateChange{Namespace: "ns", Key: []byte("b"), Current: []byte("b1")},
+	)
+	commit(
+		&StateChange{Namespace: "ns", Key: []byte("a"), Previous: []byte("a1"), Current: []byte("a2")},
+		&StateChange{Namespace: "ns", Key: []byte("c"), Current: []byte("c2")},
+	)
+	commit(
+		&StateChange{Namespace: "ns", Key: []byte("a"), Previous: []byte("a2"), Current: []byte("a3")},
+		&StateChange{Namespace: "ns", Key: []byte("b"), Previous: []byte("b1")},
+	)
+
+	hs := newHistoryStates(reader, 2, 4)
+	_, err = hs.kvStore(kv, 0, 3)
+	r.Equal(ErrNotSupported, errors.Cause(err))
+	_, err = hs.kvStore(kv, 4, 3)
+	r.Equal(ErrNotSupported, errors.Cause(err))
+
+	for _, e := range []struct {
+		height uint64
+		states map[string]string
+	}{
+		{3, map[string]string{"a": "a3", "c": "c2"}},
+		{2, map[string]string{"a": "a2", "b": "b1", "c": "c2"}},
+		{1, map[string]string{"a": "a1", "b": "b1"}},
+	} {
+		store, err := hs.kvStore(kv, e.height, 3)
+		r.NoError(err)
+		for _, k := range []string{"a", "b", "c"} {
+			v, err := store.Get("ns", []byte(k))
+			if expected, ok := e.states[k]; ok {
+				r.NoError(err)
+				r.Equal(expected, string(v))
+			} else {
+				r.Equal(db.ErrNotExist, errors.Cause(err))
+			}
+		}
+		keys, values, err := store.Filter("ns", func(k, v []byte) bool { return true }, nil, nil)
+		r.NoError(err)
+		r.Len(keys, len(e.states))
+		for i, k := range keys {
+			r.Equal(e.states[string(k)], string(values[i]))
+		}
+		v, err := store.Get(AccountKVNamespace, []byte(CurrentHeightKey))
+		r.NoError(err)
+		r.Equal(e.height, byteutil.BytesToUint64(v))
+	}
+
+	// the cached view catches up with the new blocks
+	store, err := hs.kvStore(kv, 2, 3)
+	r.NoError(err)
+	expired, err := hs.kvStore(kv, 1, 3)
+	r.NoError(err)
+	commit(&StateChange{Namespace: "ns", Key: []byte("d"), Current: []byte("d4")})
+	_, err = store.Get("ns", []byte("d"))
+	r.Equal(db.ErrNotExist, errors.Cause(err))
+	keys, _, err := store.Filter("ns", func(k, v []byte) bool { return true }, []byte("b"), nil)
+	r.NoError(err)
+	r.ElementsMatch([][]byte{[]byte("b"), []byte("c")}, keys)
+	_, err = hs.kvStore(kv, 2, 4)
+	r.NoError(err)
+
+	// the view more than depth blocks below the tip expires, instead of reverting the new blocks
+	_, err = expired.Get("ns", []byte("d"))
+	r.Equal(ErrNotSupported, errors.Cause(err))
+	_, _, err = expired.Filter("ns", func(k, v []byte) bool { return true }, nil, nil)
+	r.Equal(ErrNotSupported, errors.Cause(err))
+	_, err = hs.kvStore(kv, 1, 4)
+	r.Equal(ErrNotSupported, errors.Cause(err))
+	_, ok := hs.views.Get(uint64(1))
+	r.False(ok)
+}
+
+func TestHistoryStateDepth(t *testing.T) {
+	r := require.New(t)
+	cfg := DefaultConfig
+	cfg.Chain.HistoryStateDepth = 3
+	_, err := NewStateDB(cfg, db.NewMemKVStore(), HistoryStateDBOption(&testDiffReader{retention: 2}))
+	r.ErrorContains(err, "greater than the state diff retention")
+	cfg.Chain.HistoryStateDepth = 2
+	_, err = NewStateDB(cfg, db.NewMemKVStore(), HistoryStateDBOption(&testDiffReader{retention: 2}))
+	r.NoError(err)
+}
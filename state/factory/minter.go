@@ -44,11 +44,19 @@ func WithTimeoutOption(timeout time.Duration) MintOption {
 	}
 }
 
+// WithBlockBuilderOption sets the strategy to pick actions into new blocks
+func WithBlockBuilderOption(builder BlockBuilder) MintOption {
+	return func(m *Minter) {
+		m.builder = builder
+	}
+}
+
 // Minter is a wrapper of Factory to mint blocks
 type Minter struct {
 	f             Factory
 	ap            actpool.ActPool
 	timeout       time.Duration
+	builder       BlockBuilder
 	blockPreparer *blockPreparer
 	mu            sync.Mutex
 }
@@ -100,6 +108,9 @@ func (m *Minter) mint(ctx context.Context, pk crypto.PrivateKey) (*block.Block,
 		defer cancel()
 	}
 
+	if m.builder != nil {
+		ctx = withBlockBuilder(ctx, m.builder)
+	}
 	blk, err := m.f.Mint(ctx, m.ap, pk)
 	duration := time.Since(startTime).Seconds()
 	if err != nil {
@@ -0,0 +1,360 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/state"
+)
+
+var (
+	_parallelExecMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_parallel_execution",
+			Help: "Number of actions committed from speculative execution or executed again",
+		},
+		[]string{"type"},
+	)
+
+	errNotSpeculative = errors.New("action is not executed speculatively")
+)
+
+func init() {
+	prometheus.MustRegister(_parallelExecMtc)
+}
+
+type (
+	stateKey struct {
+		ns  string
+		key string
+	}
+
+	readValue struct {
+		value []byte
+		exist bool
+	}
+
+	// overlayStore is a working set store on top of the working set store of the block. It keeps
+	// the writes of an action in its own buffer, and records the states read from the block.
+	overlayStore struct {
+		base   workingSetStore
+		writes batch.CachedBatch
+		reads  map[stateKey]readValue
+		// unsafe is set if the action reads a range of states, or fails to read a state, in which
+		// case the reads cannot be validated
+		unsafe bool
+	}
+
+	// speculation is the result of executing an action on an overlay store
+	speculation struct {
+		receipt *action.Receipt
+		store   *overlayStore
+		err     error
+	}
+
+	staleRead struct {
+		read    readValue
+		current readValue
+		merged  bool
+	}
+)
+
+func newOverlayStore(base workingSetStore) *overlayStore {
+	return &overlayStore{
+		base:   base,
+		writes: batch.NewCachedBatch(),
+		reads:  make(map[stateKey]readValue),
+	}
+}
+
+func (store *overlayStore) Start(context.Context) error {
+	return nil
+}
+
+func (store *overlayStore) Stop(context.Context) error {
+	return nil
+}
+
+func (store *overlayStore) Put(ns string, key []byte, value []byte) error {
+	store.writes.Put(ns, key, value, fmt.Sprintf("failed to put %x in %s", key, ns))
+	return nil
+}
+
+func (store *overlayStore) Delete(ns string, key []byte) error {
+	store.writes.Delete(ns, key, fmt.Sprintf("failed to delete %x in %s", key, ns))
+	return nil
+}
+
+func (store *overlayStore) WriteBatch(bat batch.KVStoreBatch) error {
+	for i := 0; i < bat.Size(); i++ {
+		wi, err := bat.Entry(i)
+		if err != nil {
+			return err
+		}
+		switch wi.WriteType() {
+		case batch.Put:
+			store.writes.Put(wi.Namespace(), wi.Key(), wi.Value(), wi.Error())
+		case batch.Delete:
+			store.writes.Delete(wi.Namespace(), wi.Key(), wi.Error())
+		}
+	}
+	return nil
+}
+
+func (store *overlayStore) Get(ns string, key []byte) ([]byte, error) {
+	value, err := store.writes.Get(ns, key)
+	switch errors.Cause(err) {
+	case nil:
+		return value, nil
+	case batch.ErrAlreadyDeleted:
+		return nil, errors.Wrapf(state.ErrStateNotExist, "failed to get state of ns = %x and key = %x", ns, key)
+	}
+	k := stateKey{ns: ns, key: string(key)}
+	if r, ok := store.reads[k]; ok {
+		if !r.exist {
+			return nil, errors.Wrapf(state.ErrStateNotExist, "failed to get state of ns = %x and key = %x", ns, key)
+		}
+		return r.value, nil
+	}
+	value, err = store.base.Get(ns, key)
+	switch errors.Cause(err) {
+	case nil:
+		store.reads[k] = readValue{value: value, exist: true}
+	case state.ErrStateNotExist:
+		store.reads[k] = readValue{}
+	default:
+		store.unsafe = true
+	}
+	return value, err
+}
+
+func (store *overlayStore) Filter(ns string, cond db.Condition, start, limit []byte) ([][]byte, [][]byte, error) {
+	store.unsafe = true
+	return store.base.Filter(ns, cond, start, limit)
+}
+
+func (store *overlayStore) States(ns string, keys [][]byte) ([][]byte, [][]byte, error) {
+	store.unsafe = true
+	return store.base.States(ns, keys)
+}
+
+func (store *overlayStore) Commit(context.Context) error {
+	return errors.Wrap(ErrNotSupported, "cannot commit overlay store")
+}
+
+func (store *overlayStore) Digest() hash.Hash256 {
+	return hash.ZeroHash256
+}
+
+func (store *overlayStore) Finalize(context.Context) error {
+	return errors.Wrap(ErrNotSupported, "cannot finalize overlay store")
+}
+
+func (store *overlayStore) FinalizeTx(context.Context) error {
+	return nil
+}
+
+func (store *overlayStore) Snapshot() int {
+	return store.writes.Snapshot()
+}
+
+func (store *overlayStore) RevertSnapshot(snapshot int) error {
+	return store.writes.RevertSnapshot(snapshot)
+}
+
+func (store *overlayStore) ResetSnapshots() {
+	store.writes.ResetSnapshots()
+}
+
+func (store *overlayStore) Close() {}
+
+// speculate executes the user actions in parallel, each on an overlay of the working set at the
+// start of the block. It returns nil if the actions are to be executed sequentially.
+func (ws *workingSet) speculate(ctx context.Context, acts []*action.SealedEnvelope) []*speculation {
+	if ws.workers < 2 || len(acts) < 2 {
+		return nil
+	}
+	if _, ok := ws.store.(*stateDBWorkingSetStore); !ok {
+		return nil
+	}
+	var (
+		results = make([]*speculation, len(acts))
+		next    atomic.Int64
+		wg      sync.WaitGroup
+	)
+	for w := 0; w < min(ws.workers, len(acts)); w++ {
+		// views are changed by the actions, each worker works on its own copy
+		views := ws.views.Clone()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(acts) {
+					return
+				}
+				results[i] = ws.speculateAction(ctx, views, acts[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func (ws *workingSet) speculateAction(ctx context.Context, views *protocol.Views, act *action.SealedEnvelope) (spec *speculation) {
+	spec = &speculation{store: newOverlayStore(ws.store)}
+	if isNativeStakingAction(act) {
+		// staking actions read and update the candidates in views
+		spec.err = errNotSpeculative
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			spec.err = errors.Errorf("speculative execution panicked: %v", r)
+		}
+	}()
+	overlay := newWorkingSet(ws.height, views, spec.store, ws.workingSetStoreFactory)
+	spec.receipt, spec.err = overlay.runUserAction(ctx, act)
+	return
+}
+
+// commitSpeculation applies the writes of the speculative execution to the working set, if the
+// states it read have not been changed by the actions committed before. It returns nil receipt if
+// the action has to be executed again.
+func (ws *workingSet) commitSpeculation(ctx context.Context, act *action.SealedEnvelope, spec *speculation) (*action.Receipt, error) {
+	if spec.err != nil || spec.store.unsafe {
+		_parallelExecMtc.WithLabelValues("reexecuted").Inc()
+		return nil, nil
+	}
+	// the gas left in block only matters if it is less than the gas limit of the action
+	intrinsicGas, err := act.IntrinsicGas()
+	if err != nil {
+		return nil, err
+	}
+	if gasLeft := protocol.MustGetBlockCtx(ctx).GasLimit; gasLeft < act.Gas() || gasLeft < intrinsicGas {
+		_parallelExecMtc.WithLabelValues("reexecuted").Inc()
+		return nil, nil
+	}
+	reg := protocol.MustGetRegistry(ctx)
+	writes, ok, err := ws.validateSpeculation(reg, spec.store)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		_parallelExecMtc.WithLabelValues("reexecuted").Inc()
+		return nil, nil
+	}
+	actionCtx, err := withActionCtx(ctx, act)
+	if err != nil {
+		return nil, err
+	}
+	defer ws.finalizeTx(actionCtx)
+	for _, wi := range writes {
+		switch wi.WriteType() {
+		case batch.Put:
+			err = ws.store.Put(wi.Namespace(), wi.Key(), wi.Value())
+		case batch.Delete:
+			err = ws.store.Delete(wi.Namespace(), wi.Key())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	// post action handlers only update the views
+	for _, p := range reg.All() {
+		if pp, ok := p.(protocol.PostActionHandler); ok {
+			if err := pp.HandleReceipt(actionCtx, act.Envelope, ws, spec.receipt); err != nil {
+				return nil, errors.Wrapf(err, "error when handle action %x receipt", protocol.MustGetActionCtx(actionCtx).ActionHash)
+			}
+		}
+	}
+	_parallelExecMtc.WithLabelValues("committed").Inc()
+	return spec.receipt, nil
+}
+
+// validateSpeculation compares the states read by the speculative execution with the current
+// states, and returns the writes to apply. A stale state is only allowed if all writes to it can
+// be merged onto the current value.
+func (ws *workingSet) validateSpeculation(reg *protocol.Registry, store *overlayStore) ([]*batch.WriteInfo, bool, error) {
+	stale := make(map[stateKey]*staleRead)
+	for k, r := range store.reads {
+		var current readValue
+		value, err := ws.store.Get(k.ns, []byte(k.key))
+		switch errors.Cause(err) {
+		case nil:
+			current = readValue{value: value, exist: true}
+		case state.ErrStateNotExist:
+		default:
+			return nil, false, nil
+		}
+		if r.exist == current.exist && bytes.Equal(r.value, current.value) {
+			continue
+		}
+		if !r.exist || !current.exist {
+			// only the update of an existing state can be merged
+			return nil, false, nil
+		}
+		stale[k] = &staleRead{read: r, current: current}
+	}
+	writes := make([]*batch.WriteInfo, 0, store.writes.Size())
+	for i := 0; i < store.writes.Size(); i++ {
+		wi, err := store.writes.Entry(i)
+		if err != nil {
+			return nil, false, err
+		}
+		s, ok := stale[stateKey{ns: wi.Namespace(), key: string(wi.Key())}]
+		if !ok {
+			writes = append(writes, wi)
+			continue
+		}
+		if wi.WriteType() != batch.Put {
+			return nil, false, nil
+		}
+		value, ok, err := mergeState(reg, wi.Namespace(), wi.Key(), s.current.value, s.read.value, wi.Value())
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		s.merged = true
+		writes = append(writes, batch.NewWriteInfo(batch.Put, wi.Namespace(), wi.Key(), value, wi.Error()))
+	}
+	for _, s := range stale {
+		if !s.merged {
+			// the stale state affects the execution without being written
+			return nil, false, nil
+		}
+	}
+	return writes, true, nil
+}
+
+func mergeState(reg *protocol.Registry, ns string, key, current, read, written []byte) ([]byte, bool, error) {
+	for _, p := range reg.All() {
+		sm, ok := p.(protocol.StateMerger)
+		if !ok {
+			continue
+		}
+		value, ok, err := sm.MergeState(ns, key, current, read, written)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			return value, true, nil
+		}
+	}
+	return nil, false, nil
+}
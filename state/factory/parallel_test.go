@@ -0,0 +1,262 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/account"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/unit"
+	"github.com/iotexproject/iotex-core/v2/state"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+// _counterContract deploys a contract which increments the value in slot 0 on every call
+const _counterContract = "600a600c600039600a6000f3600054600101600055" + "00"
+
+func TestOverlayStore(t *testing.T) {
+	r := require.New(t)
+	ws := newStateDBWorkingSet(t)
+	r.NoError(ws.store.Put("ns", []byte("a"), []byte("1")))
+	r.NoError(ws.store.Put("ns", []byte("b"), []byte("2")))
+
+	store := newOverlayStore(ws.store)
+	v, err := store.Get("ns", []byte("a"))
+	r.NoError(err)
+	r.Equal([]byte("1"), v)
+	_, err = store.Get("ns", []byte("c"))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+
+	// writes are kept in the overlay
+	r.NoError(store.Put("ns", []byte("a"), []byte("3")))
+	r.NoError(store.Delete("ns", []byte("b")))
+	v, err = store.Get("ns", []byte("a"))
+	r.NoError(err)
+	r.Equal([]byte("3"), v)
+	_, err = store.Get("ns", []byte("b"))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	v, err = ws.store.Get("ns", []byte("a"))
+	r.NoError(err)
+	r.Equal([]byte("1"), v)
+
+	// reverted writes are dropped
+	sn := store.Snapshot()
+	r.NoError(store.Put("ns", []byte("c"), []byte("4")))
+	r.NoError(store.RevertSnapshot(sn))
+	_, err = store.Get("ns", []byte("c"))
+	r.Equal(state.ErrStateNotExist, errors.Cause(err))
+	r.Equal(2, store.writes.Size())
+
+	// only reads from the base store are recorded
+	r.Equal(map[stateKey]readValue{
+		{ns: "ns", key: "a"}: {value: []byte("1"), exist: true},
+		{ns: "ns", key: "c"}: {},
+	}, store.reads)
+	r.False(store.unsafe)
+	_, _, err = store.States("ns", [][]byte{[]byte("a")})
+	r.NoError(err)
+	r.True(store.unsafe)
+}
+
+func TestParallelExecution(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	g.VanuatuBlockHeight = 1
+	testutil.NormalizeGenesisHeights(&g.Blockchain)
+
+	newStateDB := func(workers int) (Factory, *protocol.Registry) {
+		cfg := DefaultConfig
+		cfg.Genesis = g
+		cfg.Chain.ExecutionWorkers = workers
+		registry := protocol.NewRegistry()
+		r.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+		r.NoError(rewarding.NewProtocol(g.Rewarding).Register(registry))
+		r.NoError(execution.NewProtocol(nil, rewarding.DepositGas, nil).Register(registry))
+		sdb, err := NewStateDB(cfg, db.NewMemKVStore(), RegistryStateDBOption(registry))
+		r.NoError(err)
+		ctx := protocol.WithRegistry(genesis.WithGenesisContext(context.Background(), g), registry)
+		r.NoError(sdb.Start(protocol.WithBlockCtx(ctx, protocol.BlockCtx{})))
+		t.Cleanup(func() {
+			r.NoError(sdb.Stop(ctx))
+		})
+		return sdb, registry
+	}
+	var (
+		seq, seqReg = newStateDB(0)
+		par, parReg = newStateDB(4)
+		rng         = rand.New(rand.NewSource(7))
+		nonces      = make(map[int]uint64)
+		gasPrice    = big.NewInt(2 * unit.Qev)
+		contract    string
+		prevHash    = hash.ZeroHash256
+		blks        []*block.Block
+	)
+	newAction := func(sender int, build func(nonce uint64) (*action.SealedEnvelope, error)) *action.SealedEnvelope {
+		selp, err := build(nonces[sender])
+		r.NoError(err)
+		nonces[sender]++
+		return selp
+	}
+	transfer := func(sender int, recipient string) *action.SealedEnvelope {
+		return newAction(sender, func(nonce uint64) (*action.SealedEnvelope, error) {
+			return action.SignedTransfer(recipient, identityset.PrivateKey(sender), nonce, big.NewInt(int64(1+rng.Intn(100))), nil, 100000, gasPrice, action.WithChainID(1))
+		})
+	}
+	call := func(sender int, data []byte) *action.SealedEnvelope {
+		return newAction(sender, func(nonce uint64) (*action.SealedEnvelope, error) {
+			return action.SignedExecution(contract, identityset.PrivateKey(sender), nonce, big.NewInt(0), 200000, gasPrice, data, action.WithChainID(1))
+		})
+	}
+	committed := counterValue(t, _parallelExecMtc.WithLabelValues("committed"))
+	reexecuted := counterValue(t, _parallelExecMtc.WithLabelValues("reexecuted"))
+	for height := uint64(1); height <= 5; height++ {
+		var acts []*action.SealedEnvelope
+		if height == 1 {
+			code, err := hex.DecodeString(_counterContract)
+			r.NoError(err)
+			acts = append(acts, call(0, code))
+		} else {
+			// transfers among a small set of accounts, to a hot account, and calls to the
+			// counter contract, so that some of the actions conflict
+			for i := 0; i < 60; i++ {
+				sender := rng.Intn(16)
+				switch rng.Intn(4) {
+				case 0:
+					acts = append(acts, call(sender, nil))
+				case 1:
+					acts = append(acts, transfer(sender, identityset.Address(25).String()))
+				default:
+					acts = append(acts, transfer(sender, identityset.Address((sender+1+rng.Intn(19))%20).String()))
+				}
+			}
+		}
+		ctx := newTestBlockCtx(g, height, prevHash)
+		var (
+			digests  []hash.Hash256
+			receipts [][]*action.Receipt
+			wss      []*workingSet
+			blkActs  []*action.SealedEnvelope
+		)
+		for i, sdb := range []Factory{seq, par} {
+			sdbCtx := protocol.WithRegistry(ctx, []*protocol.Registry{seqReg, parReg}[i])
+			ws, err := sdb.(workingSetCreator).newWorkingSet(sdbCtx, height)
+			r.NoError(err)
+			sysActs, err := ws.generateSignedSystemActions(sdbCtx, func(elp action.Envelope) (*action.SealedEnvelope, error) {
+				return action.Sign(elp, identityset.PrivateKey(27))
+			})
+			r.NoError(err)
+			if i == 0 {
+				blkActs = append(acts, sysActs...)
+			}
+			r.NoError(ws.Process(sdbCtx, append(acts, sysActs...)), "height %d workers %d", height, ws.workers)
+			digest, err := ws.digest()
+			r.NoError(err)
+			digests = append(digests, digest)
+			receipts = append(receipts, ws.receipts)
+			wss = append(wss, ws)
+		}
+		r.Equal(digests[0], digests[1])
+		r.Equal(receipts[0], receipts[1])
+		for _, receipt := range receipts[0] {
+			r.EqualValues(1, receipt.Status)
+		}
+		if height == 1 {
+			contract = receipts[0][0].ContractAddress
+		}
+
+		// record the block the way the serial working set would mint it
+		blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(blkActs...).Build()).
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimestamp(time.Unix(g.Timestamp+int64(height)*5, 0)).
+			SetDeltaStateDigest(digests[0]).
+			SetReceipts(receipts[0]).
+			SetReceiptRoot(block.CalculateReceiptRoot(receipts[0])).
+			SetGasUsed(calculateGasUsed(receipts[0])).
+			SetBaseFee(big.NewInt(unit.Qev)).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		for i, sdb := range []Factory{seq, par} {
+			sdb.(*stateDB).addWorkingSetIfNotExist(blk.HashBlock(), wss[i])
+			r.NoError(sdb.PutBlock(ctx, &blk))
+		}
+		blks = append(blks, &blk)
+		prevHash = blk.HashBlock()
+	}
+	// the counter is called by actions executed both speculatively and again
+	r.Greater(counterValue(t, _parallelExecMtc.WithLabelValues("committed")), committed)
+	r.Greater(counterValue(t, _parallelExecMtc.WithLabelValues("reexecuted")), reexecuted)
+
+	// replaying the recorded blocks validates the state digest and receipt root of the serial
+	// execution, and yields the same receipts, whether the blocks are executed serially or not
+	for _, workers := range []int{0, 4} {
+		sdb, _ := newStateDB(workers)
+		for i, recorded := range blks {
+			ctx := newTestBlockCtx(g, recorded.Height(), recorded.PrevHash())
+			if i > 0 {
+				bcCtx := protocol.MustGetBlockchainCtx(ctx)
+				bcCtx.Tip.BaseFee = blks[i-1].BaseFee()
+				bcCtx.Tip.GasUsed = blks[i-1].GasUsed()
+				ctx = protocol.WithBlockchainCtx(ctx, bcCtx)
+			}
+			blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(recorded.Actions...).Build()).
+				SetHeight(recorded.Height()).
+				SetPrevBlockHash(recorded.PrevHash()).
+				SetTimestamp(recorded.Timestamp()).
+				SetDeltaStateDigest(recorded.DeltaStateDigest()).
+				SetReceiptRoot(recorded.ReceiptRoot()).
+				SetGasUsed(recorded.GasUsed()).
+				SetBaseFee(recorded.BaseFee()).
+				SignAndBuild(identityset.PrivateKey(27))
+			r.NoError(err)
+			r.Equal(recorded.HashBlock(), blk.HashBlock())
+			r.NoError(sdb.PutBlock(ctx, &blk), "height %d workers %d", blk.Height(), workers)
+			r.Equal(recorded.Receipts, blk.Receipts)
+		}
+		height, err := sdb.Height()
+		r.NoError(err)
+		r.EqualValues(len(blks), height)
+	}
+}
+
+func newTestBlockCtx(g genesis.Genesis, height uint64, prevHash hash.Hash256) context.Context {
+	return protocol.WithFeatureCtx(protocol.WithBlockchainCtx(
+		protocol.WithBlockCtx(genesis.WithGenesisContext(context.Background(), g), protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: time.Unix(g.Timestamp+int64(height)*5, 0),
+			Producer:       identityset.Address(27),
+			GasLimit:       g.BlockGasLimitByHeight(height),
+			BaseFee:        big.NewInt(unit.Qev),
+		}),
+		protocol.BlockchainCtx{
+			ChainID: 1,
+			Tip:     protocol.TipInfo{Height: height - 1, Hash: prevHash},
+			GetBlockHash: func(uint64) (hash.Hash256, error) {
+				return hash.ZeroHash256, nil
+			},
+			GetBlockTime: func(uint64) (time.Time, error) {
+				return time.Time{}, nil
+			},
+		},
+	))
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+)
+
+var _prefetchMtc = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "iotex_state_prefetch",
+		Help: "Number of states prefetched, and the hits and misses of reading the prefetched states",
+	},
+	[]string{"type"},
+)
+
+func init() {
+	prometheus.MustRegister(_prefetchMtc)
+}
+
+type (
+	// Prefetcher warms up the states of a block before the block is validated
+	Prefetcher interface {
+		Prefetch(context.Context, *block.Block)
+	}
+
+	// prefetchedStates are the states read from the parent of a block, before the block is validated
+	prefetchedStates struct {
+		lock   sync.RWMutex
+		height uint64
+		values map[stateKey]readValue
+		// closed is set once the store is written, after which the prefetched states are stale
+		closed bool
+		// done is closed when prefetching finishes
+		done chan struct{}
+	}
+
+	// prefetchedKVStore serves the reads from the prefetched states before reading the underlying store
+	prefetchedKVStore struct {
+		db.KVStore
+		states *prefetchedStates
+	}
+
+	prefetchTask struct {
+		ctx context.Context
+		blk *block.Block
+	}
+
+	prefetcher struct {
+		lock sync.Mutex
+		// pending are the blocks waiting for the working set of the parent, keyed by the parent hash
+		pending map[hash.Hash256]*prefetchTask
+		// states are the prefetched states keyed by block hash
+		states cache.LRUCache
+	}
+)
+
+func newPrefetchedStates(height uint64) *prefetchedStates {
+	return &prefetchedStates{
+		height: height,
+		values: make(map[stateKey]readValue),
+		done:   make(chan struct{}),
+	}
+}
+
+func (ps *prefetchedStates) get(ns string, key []byte) (readValue, bool) {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	if ps.closed {
+		return readValue{}, false
+	}
+	v, ok := ps.values[stateKey{ns: ns, key: string(key)}]
+	return v, ok
+}
+
+// add adds the states read, it returns false if the prefetched states are already stale
+func (ps *prefetchedStates) add(reads map[stateKey]readValue) bool {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	if ps.closed {
+		return false
+	}
+	added := 0
+	for k, v := range reads {
+		if _, ok := ps.values[k]; ok {
+			continue
+		}
+		ps.values[k] = v
+		added++
+	}
+	_prefetchMtc.WithLabelValues("prefetched").Add(float64(added))
+	return true
+}
+
+func (ps *prefetchedStates) close() {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	ps.closed = true
+	ps.values = nil
+}
+
+func newPrefetchedKVStore(kvstore db.KVStore, states *prefetchedStates) db.KVStore {
+	return &prefetchedKVStore{
+		KVStore: kvstore,
+		states:  states,
+	}
+}
+
+func (store *prefetchedKVStore) Get(ns string, key []byte) ([]byte, error) {
+	v, ok := store.states.get(ns, key)
+	if !ok {
+		_prefetchMtc.WithLabelValues("miss").Inc()
+		return store.KVStore.Get(ns, key)
+	}
+	_prefetchMtc.WithLabelValues("hit").Inc()
+	if !v.exist {
+		return nil, errors.Wrapf(db.ErrNotExist, "failed to get key %x in %s", key, ns)
+	}
+	return v.value, nil
+}
+
+func (store *prefetchedKVStore) Put(ns string, key []byte, value []byte) error {
+	store.states.close()
+	return store.KVStore.Put(ns, key, value)
+}
+
+func (store *prefetchedKVStore) Delete(ns string, key []byte) error {
+	store.states.close()
+	return store.KVStore.Delete(ns, key)
+}
+
+func (store *prefetchedKVStore) WriteBatch(b batch.KVStoreBatch) error {
+	store.states.close()
+	return store.KVStore.WriteBatch(b)
+}
+
+func newPrefetcher(size int) *prefetcher {
+	return &prefetcher{
+		pending: make(map[hash.Hash256]*prefetchTask),
+		states:  cache.NewThreadSafeLruCache(size),
+	}
+}
+
+// wait keeps the block until the working set of its parent is ready
+func (p *prefetcher) wait(ctx context.Context, blk *block.Block, tipHeight uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for h, task := range p.pending {
+		if task.blk.Height() <= tipHeight+1 {
+			delete(p.pending, h)
+		}
+	}
+	p.pending[blk.PrevHash()] = &prefetchTask{ctx: ctx, blk: blk}
+}
+
+// ready returns the block waiting for the working set of the given parent
+func (p *prefetcher) ready(parent hash.Hash256) *prefetchTask {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	task, ok := p.pending[parent]
+	if !ok {
+		return nil
+	}
+	delete(p.pending, parent)
+	return task
+}
+
+// start returns the states to prefetch the block into, or false if the block has been prefetched
+func (p *prefetcher) start(blk *block.Block) (*prefetchedStates, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	key := blk.HashBlock()
+	if _, ok := p.states.Get(key); ok {
+		return nil, false
+	}
+	states := newPrefetchedStates(blk.Height())
+	p.states.Add(key, states)
+	return states, true
+}
+
+// take returns the states prefetched for the block at the height
+func (p *prefetcher) take(key hash.Hash256, height uint64) *prefetchedStates {
+	if p == nil {
+		return nil
+	}
+	data, ok := p.states.Get(key)
+	if !ok {
+		return nil
+	}
+	p.states.Remove(key)
+	states, ok := data.(*prefetchedStates)
+	if !ok || states.height != height {
+		return nil
+	}
+	return states
+}
+
+// Prefetch reads the states which the actions of the block are likely to access, so that they are served from
+// the read buffer of the working set when the block is validated. The states are read from the working set of
+// the parent block once it is ready, which allows prefetching a block while its parent is being committed.
+func (sdb *stateDB) Prefetch(ctx context.Context, blk *block.Block) {
+	if sdb.prefetcher == nil {
+		return
+	}
+	if data, ok := sdb.workingsets.Get(blk.PrevHash()); ok {
+		if ws, ok := data.(*workingSet); ok {
+			sdb.startPrefetch(ctx, blk, ws.store)
+		}
+		return
+	}
+	sdb.mutex.RLock()
+	currHeight := sdb.currentChainHeight
+	sdb.mutex.RUnlock()
+	if blk.Height() != currHeight+1 {
+		sdb.prefetcher.wait(ctx, blk, currHeight)
+		return
+	}
+	// the parent has been committed, the block is only validated if it is linked to the tip of the chain
+	store, err := sdb.createWorkingSetStore(ctx, blk.Height(), &readOnlyKV{sdb.dao.atHeight(currHeight)})
+	if err != nil {
+		log.L().Debug("Failed to create store to prefetch states.", zap.Error(err))
+		return
+	}
+	sdb.startPrefetch(ctx, blk, store)
+}
+
+func (sdb *stateDB) startPrefetch(ctx context.Context, blk *block.Block, store workingSetStore) {
+	states, ok := sdb.prefetcher.start(blk)
+	if !ok {
+		return
+	}
+	go sdb.prefetch(ctx, blk, store, states)
+}
+
+func (sdb *stateDB) prefetch(ctx context.Context, blk *block.Block, base workingSetStore, states *prefetchedStates) {
+	defer func() {
+		close(states.done)
+		if r := recover(); r != nil {
+			log.L().Debug("Prefetching states panicked.", zap.Uint64("height", blk.Height()), zap.Any("error", r))
+		}
+	}()
+	ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+		Tip: protocol.TipInfo{
+			Height: blk.Height() - 1,
+			Hash:   blk.PrevHash(),
+		},
+	})
+	for _, act := range blk.Actions {
+		store := newOverlayStore(base)
+		if err := prefetchAction(ctx, newWorkingSet(blk.Height(), protocol.NewViews(), store, sdb), act); err != nil {
+			log.L().Debug("Failed to prefetch states.", zap.Uint64("height", blk.Height()), zap.Error(err))
+		}
+		if !states.add(store.reads) {
+			return
+		}
+	}
+}
+
+// prefetchAction reads the accounts of the sender and recipient, the code of the recipient contract, and the
+// storage slots in the access list of the action
+func prefetchAction(ctx context.Context, sm protocol.StateManager, act *action.SealedEnvelope) error {
+	if _, err := accountutil.LoadAccount(sm, act.SenderAddress()); err != nil {
+		return err
+	}
+	if dst, ok := act.Destination(); ok && dst != "" {
+		addr, err := address.FromString(dst)
+		if err != nil {
+			return err
+		}
+		recipient, err := accountutil.LoadAccount(sm, addr)
+		if err != nil {
+			return err
+		}
+		if recipient.IsContract() {
+			var code protocol.SerializableBytes
+			if _, err := sm.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(recipient.CodeHash)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, tuple := range act.AccessList() {
+		contract, err := address.FromBytes(tuple.Address.Bytes())
+		if err != nil {
+			return err
+		}
+		for _, key := range tuple.StorageKeys {
+			if _, err := evm.ReadContractStorage(ctx, sm, contract, key[:]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
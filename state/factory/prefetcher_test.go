@@ -0,0 +1,241 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/v2/action"
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/unit"
+	"github.com/iotexproject/iotex-core/v2/test/identityset"
+	"github.com/iotexproject/iotex-core/v2/testutil"
+)
+
+func TestPrefetchedKVStore(t *testing.T) {
+	r := require.New(t)
+	kv := db.NewMemKVStore()
+	r.NoError(kv.Start(context.Background()))
+	r.NoError(kv.Put("ns", []byte("a"), []byte("1")))
+	r.NoError(kv.Put("ns", []byte("c"), []byte("3")))
+
+	states := newPrefetchedStates(1)
+	r.True(states.add(map[stateKey]readValue{
+		{ns: "ns", key: "a"}: {value: []byte("1"), exist: true},
+		{ns: "ns", key: "b"}: {},
+	}))
+	store := newPrefetchedKVStore(kv, states)
+	hit := counterValue(t, _prefetchMtc.WithLabelValues("hit"))
+	miss := counterValue(t, _prefetchMtc.WithLabelValues("miss"))
+	v, err := store.Get("ns", []byte("a"))
+	r.NoError(err)
+	r.Equal([]byte("1"), v)
+	_, err = store.Get("ns", []byte("b"))
+	r.Equal(db.ErrNotExist, errors.Cause(err))
+	v, err = store.Get("ns", []byte("c"))
+	r.NoError(err)
+	r.Equal([]byte("3"), v)
+	r.Equal(hit+2, counterValue(t, _prefetchMtc.WithLabelValues("hit")))
+	r.Equal(miss+1, counterValue(t, _prefetchMtc.WithLabelValues("miss")))
+
+	// the prefetched states are dropped once the store is written
+	b := batch.NewBatch()
+	b.Put("ns", []byte("a"), []byte("2"), "failed to put")
+	r.NoError(store.WriteBatch(b))
+	v, err = store.Get("ns", []byte("a"))
+	r.NoError(err)
+	r.Equal([]byte("2"), v)
+	r.False(states.add(map[stateKey]readValue{{ns: "ns", key: "d"}: {}}))
+}
+
+func TestStateDBPrefetch(t *testing.T) {
+	r := require.New(t)
+	g := genesis.TestDefault()
+	g.VanuatuBlockHeight = 1
+	testutil.NormalizeGenesisHeights(&g.Blockchain)
+
+	newStateDB := func(prefetch bool) *stateDB {
+		cfg := DefaultConfig
+		cfg.Genesis = g
+		cfg.Chain.EnableStatePrefetch = prefetch
+		registry := protocol.NewRegistry()
+		r.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+		r.NoError(rewarding.NewProtocol(g.Rewarding).Register(registry))
+		r.NoError(execution.NewProtocol(nil, rewarding.DepositGas, nil).Register(registry))
+		sdb, err := NewStateDB(cfg, db.NewMemKVStore(), RegistryStateDBOption(registry), SkipBlockValidationStateDBOption())
+		r.NoError(err)
+		ctx := protocol.WithRegistry(genesis.WithGenesisContext(context.Background(), g), registry)
+		r.NoError(sdb.Start(protocol.WithBlockCtx(ctx, protocol.BlockCtx{})))
+		t.Cleanup(func() {
+			r.NoError(sdb.Stop(ctx))
+		})
+		return sdb.(*stateDB)
+	}
+	var (
+		sdb      = newStateDB(true)
+		ref      = newStateDB(false)
+		gasPrice = big.NewInt(2 * unit.Qev)
+		nonces   = make(map[int]uint64)
+		contract string
+		blks     []*block.Block
+	)
+	newBlock := func(height uint64, prevHash hash.Hash256, acts ...*action.SealedEnvelope) *block.Block {
+		grant, err := action.Sign((&action.EnvelopeBuilder{}).SetNonce(0).SetGasPrice(big.NewInt(0)).
+			SetAction(action.NewGrantReward(action.BlockReward, height)).Build(), identityset.PrivateKey(27))
+		r.NoError(err)
+		acts = append(acts, grant)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(time.Unix(g.Timestamp+int64(height)*5, 0)).
+			AddActions(acts...).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		return &blk
+	}
+	sign := func(sender int, build func(uint64) (*action.SealedEnvelope, error)) *action.SealedEnvelope {
+		selp, err := build(nonces[sender])
+		r.NoError(err)
+		nonces[sender]++
+		return selp
+	}
+	transfer := func(sender, recipient int) *action.SealedEnvelope {
+		return sign(sender, func(nonce uint64) (*action.SealedEnvelope, error) {
+			return action.SignedTransfer(identityset.Address(recipient).String(), identityset.PrivateKey(sender), nonce, big.NewInt(10), nil, 100000, gasPrice, action.WithChainID(1))
+		})
+	}
+	call := func(sender int) *action.SealedEnvelope {
+		return sign(sender, func(nonce uint64) (*action.SealedEnvelope, error) {
+			addr, err := address.FromString(contract)
+			if err != nil {
+				return nil, err
+			}
+			return action.SignedExecution(contract, identityset.PrivateKey(sender), nonce, big.NewInt(0), 200000, gasPrice, nil, action.WithChainID(1), func(b *action.EnvelopeBuilder) {
+				b.SetTxType(action.AccessListTxType).SetAccessList(types.AccessList{
+					{Address: common.BytesToAddress(addr.Bytes()), StorageKeys: []common.Hash{{}}},
+				})
+			})
+		})
+	}
+	putBlock := func(blk *block.Block) {
+		for _, f := range []*stateDB{sdb, ref} {
+			r.NoError(f.PutBlock(newTestBlockCtx(g, blk.Height(), blk.PrevHash()), blk))
+		}
+	}
+
+	// deploy the counter contract
+	code, err := hex.DecodeString(_counterContract)
+	r.NoError(err)
+	blks = append(blks, newBlock(1, hash.ZeroHash256, sign(0, func(nonce uint64) (*action.SealedEnvelope, error) {
+		return action.SignedExecution("", identityset.PrivateKey(0), nonce, big.NewInt(0), 200000, gasPrice, code, action.WithChainID(1))
+	}), transfer(1, 2)))
+	putBlock(blks[0])
+	contract = blks[0].Receipts[0].ContractAddress
+	contractAddr, err := address.FromString(contract)
+	r.NoError(err)
+
+	// the parent of the block has been committed
+	ctx := genesis.WithGenesisContext(context.Background(), g)
+	blks = append(blks, newBlock(2, blks[0].HashBlock(), call(1), transfer(2, 3), call(3)))
+	sdb.Prefetch(ctx, blks[1])
+	data, ok := sdb.prefetcher.states.Get(blks[1].HashBlock())
+	r.True(ok)
+	states := data.(*prefetchedStates)
+	<-states.done
+	for _, addr := range []address.Address{
+		identityset.Address(1),
+		identityset.Address(2),
+		identityset.Address(3),
+		contractAddr,
+	} {
+		h := hash.BytesToHash160(addr.Bytes())
+		_, ok := states.get(AccountKVNamespace, h[:])
+		r.True(ok)
+	}
+
+	// the block waits for the working set of its parent
+	blks = append(blks, newBlock(3, blks[1].HashBlock(), call(2), transfer(3, 1)))
+	sdb.Prefetch(ctx, blks[2])
+	_, ok = sdb.prefetcher.states.Get(blks[2].HashBlock())
+	r.False(ok)
+
+	// validate the block with the prefetched states
+	hit := counterValue(t, _prefetchMtc.WithLabelValues("hit"))
+	wsCtx := protocol.WithRegistry(newTestBlockCtx(g, 2, blks[0].HashBlock()), sdb.registry)
+	ws, isExist, err := sdb.getFromWorkingSets(wsCtx, blks[1].HashBlock())
+	r.NoError(err)
+	r.False(isExist)
+	r.NoError(ws.Process(wsCtx, blks[1].RunnableActions().Actions()))
+	r.Greater(counterValue(t, _prefetchMtc.WithLabelValues("hit")), hit)
+
+	// the child block is prefetched once the working set is ready, before it is committed
+	r.Nil(sdb.addWorkingSetIfNotExist(blks[1].HashBlock(), ws))
+	data, ok = sdb.prefetcher.states.Get(blks[2].HashBlock())
+	r.True(ok)
+	states = data.(*prefetchedStates)
+	putBlock(blks[1])
+	<-states.done
+	// the storage slot in the access list is read from the contract trie
+	contractStates := 0
+	for k := range states.values {
+		if k.ns == evm.ContractKVNameSpace {
+			contractStates++
+		}
+	}
+	r.NotZero(contractStates)
+	hit = counterValue(t, _prefetchMtc.WithLabelValues("hit"))
+	putBlock(blks[2])
+	r.Greater(counterValue(t, _prefetchMtc.WithLabelValues("hit")), hit)
+
+	// the states are identical to the ones processed without prefetching
+	for _, blk := range blks {
+		for _, receipt := range blk.Receipts {
+			r.EqualValues(1, receipt.Status)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		a, err := accountutil.AccountState(ctx, sdb, identityset.Address(i))
+		r.NoError(err)
+		b, err := accountutil.AccountState(ctx, ref, identityset.Address(i))
+		r.NoError(err)
+		r.Equal(a, b)
+	}
+	for _, f := range []*stateDB{sdb, ref} {
+		sm, err := f.WorkingSet(ctx)
+		r.NoError(err)
+		counter, err := evm.ReadContractStorage(newTestBlockCtx(g, 4, blks[2].HashBlock()), sm, contractAddr, make([]byte, 32))
+		r.NoError(err)
+		r.EqualValues(3, new(big.Int).SetBytes(counter).Uint64())
+	}
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	m := dto.Metric{}
+	require.NoError(t, c.Write(&m))
+	return m.GetCounter().GetValue()
+}
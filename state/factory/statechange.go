@@ -0,0 +1,113 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/state"
+)
+
+type (
+	// StateChange is a change of state made by a block
+	StateChange struct {
+		Namespace string
+		Key       []byte
+		// Previous is nil if the state does not exist before the block
+		Previous []byte
+		// Current is nil if the state is deleted by the block
+		Current []byte
+	}
+
+	// StateDiffHandler handles the state changes of a block before they are committed
+	StateDiffHandler interface {
+		HandleStateChanges(context.Context, uint64, []*StateChange) error
+	}
+
+	stateChangesReader interface {
+		stateChanges() ([]*StateChange, error)
+	}
+)
+
+// stateChanges returns the net changes in the buffer, in the order the states are first written
+func (store *stateDBWorkingSetStore) stateChanges() ([]*StateChange, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if store.committed {
+		return nil, errors.New("working set store already committed")
+	}
+	var (
+		kvb     = store.flusher.KVStoreWithBuffer()
+		base    = store.flusher.BaseKVStore()
+		keys    []stateKey
+		entries = make(map[stateKey]*batch.WriteInfo)
+	)
+	for i := 0; i < kvb.Size(); i++ {
+		wi, err := kvb.Entry(i)
+		if err != nil {
+			return nil, err
+		}
+		k := stateKey{ns: wi.Namespace(), key: string(wi.Key())}
+		if k.ns == AccountKVNamespace && k.key == CurrentHeightKey {
+			continue
+		}
+		if _, ok := entries[k]; !ok {
+			keys = append(keys, k)
+		}
+		entries[k] = wi
+	}
+	changes := make([]*StateChange, 0, len(keys))
+	for _, k := range keys {
+		prev, err := base.Get(k.ns, []byte(k.key))
+		switch errors.Cause(err) {
+		case nil:
+		case db.ErrNotExist, state.ErrStateNotExist:
+			prev = nil
+		default:
+			return nil, errors.Wrapf(err, "failed to get previous state of %x in %s", k.key, k.ns)
+		}
+		var curr []byte
+		if wi := entries[k]; wi.WriteType() == batch.Put {
+			curr = wi.Value()
+		}
+		if (prev == nil) == (curr == nil) && bytes.Equal(prev, curr) {
+			continue
+		}
+		changes = append(changes, &StateChange{
+			Namespace: k.ns,
+			Key:       []byte(k.key),
+			Previous:  prev,
+			Current:   curr,
+		})
+	}
+	return changes, nil
+}
+
+func (store *workingSetStoreWithSecondary) stateChanges() ([]*StateChange, error) {
+	reader, ok := store.writer.(stateChangesReader)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "state changes are not supported by the working set store")
+	}
+	return reader.stateChanges()
+}
+
+// handleStateChanges passes the state changes of the working set to the handler
+func (ws *workingSet) handleStateChanges(ctx context.Context, handler StateDiffHandler) error {
+	reader, ok := ws.store.(stateChangesReader)
+	if !ok {
+		return errors.Wrap(ErrNotSupported, "state changes are not supported by the working set store")
+	}
+	changes, err := reader.stateChanges()
+	if err != nil {
+		return err
+	}
+	return handler.HandleStateChanges(ctx, ws.height, changes)
+}
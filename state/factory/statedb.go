@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/go-pkgs/cache"
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
 
 	"github.com/iotexproject/iotex-core/v2/action"
 	"github.com/iotexproject/iotex-core/v2/action/protocol"
@@ -55,6 +56,9 @@ type (
 		skipBlockValidationOnPut bool
 		ps                       *patchStore
 		erigonDB                 *erigonDB
+		prefetcher               *prefetcher
+		stateDiffHandler         StateDiffHandler
+		history                  *historyStates
 	}
 )
 
@@ -85,6 +89,31 @@ func SkipBlockValidationStateDBOption() StateDBOption {
 	}
 }
 
+// StateDiffStateDBOption sets the handler of the state changes of each committed block
+func StateDiffStateDBOption(handler StateDiffHandler) StateDBOption {
+	return func(sdb *stateDB, cfg *Config) error {
+		sdb.stateDiffHandler = handler
+		return nil
+	}
+}
+
+// HistoryStateDBOption enables reading the states below the tip by reverting the recorded state diffs
+func HistoryStateDBOption(reader StateDiffReader) StateDBOption {
+	return func(sdb *stateDB, cfg *Config) error {
+		if reader == nil {
+			return errors.New("empty state diff reader")
+		}
+		if cfg.Chain.HistoryStateDepth == 0 {
+			return nil
+		}
+		if retention := reader.Retention(); cfg.Chain.HistoryStateDepth > retention {
+			return errors.Errorf("history state depth %d is greater than the state diff retention %d", cfg.Chain.HistoryStateDepth, retention)
+		}
+		sdb.history = newHistoryStates(reader, cfg.Chain.HistoryStateDepth, cfg.Chain.HistoryStateCacheSize)
+		return nil
+	}
+}
+
 // DisableWorkingSetCacheOption disable workingset cache
 func DisableWorkingSetCacheOption() StateDBOption {
 	return func(sdb *stateDB, cfg *Config) error {
@@ -108,7 +137,11 @@ func NewStateDB(cfg Config, dao db.KVStore, opts ...StateDBOption) (Factory, err
 			return nil, err
 		}
 	}
-	sdb.dao = newDaoRetrofitter(dao)
+	if kv, ok := dao.(db.KvVersioned); ok && cfg.Chain.EnableArchiveMode {
+		sdb.dao = newDaoRetrofitterArchive(kv)
+	} else {
+		sdb.dao = newDaoRetrofitter(dao)
+	}
 	timerFactory, err := prometheustimer.New(
 		"iotex_statefactory_perf",
 		"Performance of state factory module",
@@ -122,6 +155,9 @@ func NewStateDB(cfg Config, dao db.KVStore, opts ...StateDBOption) (Factory, err
 	if len(cfg.Chain.HistoryIndexPath) > 0 {
 		sdb.erigonDB = newErigonDB(cfg.Chain.HistoryIndexPath)
 	}
+	if cfg.Chain.EnableStatePrefetch {
+		sdb.prefetcher = newPrefetcher(int(cfg.Chain.WorkingSetCacheSize))
+	}
 
 	return &sdb, nil
 }
@@ -193,8 +229,26 @@ func (sdb *stateDB) newReadOnlyWorkingSet(ctx context.Context, height uint64) (*
 	return sdb.newWorkingSetWithKVStore(ctx, height, &readOnlyKV{sdb.dao.atHeight(height)})
 }
 
+func (sdb *stateDB) newHistoryWorkingSet(ctx context.Context, height uint64) (*workingSet, error) {
+	sdb.mutex.RLock()
+	currHeight := sdb.currentChainHeight
+	sdb.mutex.RUnlock()
+	if height >= currHeight {
+		return sdb.newReadOnlyWorkingSet(ctx, height)
+	}
+	kvStore, err := sdb.history.kvStore(sdb.dao.atHeight(height), height, currHeight)
+	if err != nil {
+		return nil, err
+	}
+	return sdb.newWorkingSetWithKVStore(ctx, height, &readOnlyKV{kvStore})
+}
+
 func (sdb *stateDB) newWorkingSet(ctx context.Context, height uint64) (*workingSet, error) {
-	ws, err := sdb.newWorkingSetWithKVStore(ctx, height, sdb.dao.atHeight(height))
+	return sdb.newWorkingSetOnKVStore(ctx, height, sdb.dao.atHeight(height))
+}
+
+func (sdb *stateDB) newWorkingSetOnKVStore(ctx context.Context, height uint64, kvstore db.KVStore) (*workingSet, error) {
+	ws, err := sdb.newWorkingSetWithKVStore(ctx, height, kvstore)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new working set")
 	}
@@ -224,7 +278,10 @@ func (sdb *stateDB) newWorkingSetWithKVStore(ctx context.Context, height uint64,
 	if err := views.Commit(ctx, sdb); err != nil {
 		return nil, err
 	}
-	return newWorkingSet(height, views, store, sdb), nil
+	ws := newWorkingSet(height, views, store, sdb)
+	ws.workers = sdb.cfg.Chain.ExecutionWorkers
+	ws.stateDiffHandler = sdb.stateDiffHandler
+	return ws, nil
 }
 
 func (sdb *stateDB) CreateWorkingSetStore(ctx context.Context, height uint64, kvstore db.KVStore) (workingSetStore, error) {
@@ -287,28 +344,8 @@ func (sdb *stateDB) Mint(
 	ap actpool.ActPool,
 	pk crypto.PrivateKey,
 ) (*block.Block, error) {
-	bcCtx := protocol.MustGetBlockchainCtx(ctx)
-	expectedBlockHeight := bcCtx.Tip.Height + 1
 	ctx = protocol.WithRegistry(ctx, sdb.registry)
-	var (
-		ws  *workingSet
-		err error
-	)
-	sdb.mutex.RLock()
-	currHeight := sdb.currentChainHeight
-	sdb.mutex.RUnlock()
-	switch {
-	case currHeight+1 < expectedBlockHeight:
-		parent, ok := sdb.workingsets.Get(bcCtx.Tip.Hash)
-		if !ok {
-			return nil, errors.Wrapf(ErrNotSupported, "failed to create block at height %d, current height is %d", expectedBlockHeight, sdb.currentChainHeight)
-		}
-		ws, err = parent.(*workingSet).NewWorkingSet(ctx)
-	case currHeight+1 > expectedBlockHeight:
-		return nil, errors.Wrapf(ErrNotSupported, "cannot create block at height %d, current height is %d", expectedBlockHeight, sdb.currentChainHeight)
-	default:
-		ws, err = sdb.newWorkingSet(ctx, currHeight+1)
-	}
+	ws, err := sdb.newBlockWorkingSet(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +359,7 @@ func (sdb *stateDB) Mint(
 
 	blk, err := blkBuilder.SignAndBuild(pk)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create block builder at new block height %d", expectedBlockHeight)
+		return nil, errors.Wrapf(err, "failed to create block builder at new block height %d", ws.height)
 	}
 	blkHash := blk.HashBlock()
 	if existed := sdb.addWorkingSetIfNotExist(blkHash, ws); existed != nil {
@@ -331,6 +368,69 @@ func (sdb *stateDB) Mint(
 	return &blk, nil
 }
 
+// MintPending runs the actions in the actpool on top of the tip as Mint does, and returns the unsigned block
+// and the state after it. The system actions are sealed with the producer's public key without a signature.
+// The working set is not cached, as the pending block is never committed
+func (sdb *stateDB) MintPending(
+	ctx context.Context,
+	ap actpool.ActPool,
+	producer crypto.PublicKey,
+) (*block.Block, protocol.StateForker, error) {
+	ctx = protocol.WithRegistry(ctx, sdb.registry)
+	ws, err := sdb.newBlockWorkingSet(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sign := func(elp action.Envelope) (*action.SealedEnvelope, error) {
+		return action.FakeSeal(elp, producer), nil
+	}
+	blkBuilder, err := ws.CreateBuilder(ctx, &readOnlyActPool{ap}, sign, sdb.cfg.Chain.AllowedBlockGasResidue)
+	if err != nil {
+		return nil, nil, err
+	}
+	blk := blkBuilder.Build()
+	return &blk, &pendingState{ws}, nil
+}
+
+// readOnlyActPool picks the actions for the pending block, the invalid actions are skipped but kept in the
+// actpool, which is left to the minter
+type readOnlyActPool struct {
+	actpool.ActPool
+}
+
+func (ap *readOnlyActPool) DeleteAction(address.Address) {}
+
+type pendingState struct {
+	*workingSet
+}
+
+// WorkingSet returns a working set on top of the pending state
+func (ps *pendingState) WorkingSet(ctx context.Context) (protocol.StateManager, error) {
+	return ps.NewWorkingSet(ctx)
+}
+
+// newBlockWorkingSet creates the working set to run the block on top of the tip, which is either the
+// committed state or the cached working set of a minted but not yet committed tip
+func (sdb *stateDB) newBlockWorkingSet(ctx context.Context) (*workingSet, error) {
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	expectedBlockHeight := bcCtx.Tip.Height + 1
+	sdb.mutex.RLock()
+	currHeight := sdb.currentChainHeight
+	sdb.mutex.RUnlock()
+	switch {
+	case currHeight+1 < expectedBlockHeight:
+		parent, ok := sdb.workingsets.Get(bcCtx.Tip.Hash)
+		if !ok {
+			return nil, errors.Wrapf(ErrNotSupported, "failed to create block at height %d, current height is %d", expectedBlockHeight, currHeight)
+		}
+		return parent.(*workingSet).NewWorkingSet(ctx)
+	case currHeight+1 > expectedBlockHeight:
+		return nil, errors.Wrapf(ErrNotSupported, "cannot create block at height %d, current height is %d", expectedBlockHeight, currHeight)
+	default:
+		return sdb.newWorkingSet(ctx, currHeight+1)
+	}
+}
+
 func (sdb *stateDB) WorkingSet(ctx context.Context) (protocol.StateManager, error) {
 	sdb.mutex.RLock()
 	height := sdb.currentChainHeight
@@ -339,7 +439,23 @@ func (sdb *stateDB) WorkingSet(ctx context.Context) (protocol.StateManager, erro
 }
 
 func (sdb *stateDB) WorkingSetAtHeight(ctx context.Context, height uint64, preacts ...*action.SealedEnvelope) (protocol.StateManager, error) {
-	ws, err := sdb.newReadOnlyWorkingSet(ctx, height)
+	var (
+		ws  *workingSet
+		err error
+	)
+	if _, ok := sdb.dao.(*daoRTFArchive); ok {
+		sdb.mutex.RLock()
+		currHeight := sdb.currentChainHeight
+		sdb.mutex.RUnlock()
+		if height > currHeight {
+			return nil, errors.Errorf("query height %d is higher than tip height %d", height, currHeight)
+		}
+		ws, err = sdb.newReadOnlyWorkingSet(ctx, height)
+	} else if sdb.erigonDB == nil && sdb.history != nil {
+		ws, err = sdb.newHistoryWorkingSet(ctx, height)
+	} else {
+		ws, err = sdb.newReadOnlyWorkingSet(ctx, height)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -544,19 +660,30 @@ func (sdb *stateDB) getFromWorkingSets(ctx context.Context, key hash.Hash256) (*
 	currHeight := sdb.currentChainHeight
 	sdb.mutex.RUnlock()
 	var (
-		tx  *workingSet
-		err error
+		tx      *workingSet
+		err     error
+		kvstore = sdb.dao.atHeight(currHeight + 1)
 	)
-	tx, err = sdb.newWorkingSet(ctx, currHeight+1)
+	if states := sdb.prefetcher.take(key, currHeight+1); states != nil {
+		kvstore = newPrefetchedKVStore(kvstore, states)
+	}
+	tx, err = sdb.newWorkingSetOnKVStore(ctx, currHeight+1, kvstore)
 	return tx, false, err
 }
 
 func (sdb *stateDB) addWorkingSetIfNotExist(key hash.Hash256, ws *workingSet) (existed *workingSet) {
 	sdb.mutex.Lock()
-	defer sdb.mutex.Unlock()
 	if existed, ok := sdb.workingsets.Get(key); ok {
+		sdb.mutex.Unlock()
 		return existed.(*workingSet)
 	}
 	sdb.workingsets.Add(key, ws)
+	sdb.mutex.Unlock()
+	if sdb.prefetcher != nil {
+		// start prefetching the child block waiting for this working set
+		if task := sdb.prefetcher.ready(key); task != nil {
+			sdb.startPrefetch(task.ctx, task.blk, ws.store)
+		}
+	}
 	return nil
 }
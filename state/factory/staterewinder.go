@@ -0,0 +1,129 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/db/batch"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
+)
+
+type (
+	// StateDiffRewinder is a StateDiffReader which can delete the state changes above a height
+	StateDiffRewinder interface {
+		StateDiffReader
+		// Rewind deletes the state changes of the blocks above the height
+		Rewind(context.Context, uint64) error
+	}
+
+	// StateDBRewinder rolls the state db back block by block, by reverting the state changes recorded for
+	// each block. It is used offline, while the state db is not opened by the state factory.
+	StateDBRewinder struct {
+		kvStore db.KVStore
+		diffs   StateDiffRewinder
+	}
+)
+
+// NewStateDBRewinder creates a rewinder of the state db in the kv store, the diffs must be started before it
+func NewStateDBRewinder(kvStore db.KVStore, diffs StateDiffRewinder) *StateDBRewinder {
+	return &StateDBRewinder{
+		kvStore: kvStore,
+		diffs:   diffs,
+	}
+}
+
+// Start starts the state db, the state changes of the blocks not committed to the state db are dropped
+func (sr *StateDBRewinder) Start(ctx context.Context) error {
+	if err := sr.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	height, err := sr.Height()
+	if err != nil {
+		return err
+	}
+	// the changes of a block are recorded before the block is committed to the state db
+	return sr.diffs.Rewind(ctx, height)
+}
+
+// Stop stops the state db
+func (sr *StateDBRewinder) Stop(ctx context.Context) error {
+	return sr.kvStore.Stop(ctx)
+}
+
+// Height returns the height of the state db
+func (sr *StateDBRewinder) Height() (uint64, error) {
+	h, err := sr.kvStore.Get(AccountKVNamespace, []byte(CurrentHeightKey))
+	switch errors.Cause(err) {
+	case nil:
+		return byteutil.BytesToUint64(h), nil
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// PutBlock is not supported, the state db can only be rolled back
+func (sr *StateDBRewinder) PutBlock(context.Context, *block.Block) error {
+	return errors.Wrap(ErrNotSupported, "state db rewinder cannot commit block")
+}
+
+// CheckRewind returns error if the state changes of any block above the target height are not recorded
+func (sr *StateDBRewinder) CheckRewind(target uint64) error {
+	height, err := sr.Height()
+	if err != nil {
+		return err
+	}
+	if height <= target {
+		return nil
+	}
+	recorded, err := sr.diffs.Height()
+	if err != nil {
+		return err
+	}
+	if recorded < height {
+		return errors.Errorf("state diff is recorded up to height %d, lower than state db height %d", recorded, height)
+	}
+	// the recorded heights are contiguous, so the lowest one covers the rest
+	if _, err := sr.diffs.StateChanges(target + 1); err != nil {
+		return errors.Wrapf(err, "state diff of height %d is not recorded", target+1)
+	}
+	return nil
+}
+
+// DeleteTipBlock reverts the state changes of the tip block
+func (sr *StateDBRewinder) DeleteTipBlock(ctx context.Context, blk *block.Block) error {
+	height, err := sr.Height()
+	if err != nil {
+		return err
+	}
+	if blk.Height() != height {
+		return errors.Errorf("cannot delete block %d, state db height is %d", blk.Height(), height)
+	}
+	changes, err := sr.diffs.StateChanges(height)
+	if err != nil {
+		return err
+	}
+	b := batch.NewBatch()
+	for _, c := range changes {
+		if c.Previous == nil {
+			b.Delete(c.Namespace, c.Key, "failed to delete state")
+		} else {
+			b.Put(c.Namespace, c.Key, c.Previous, "failed to put state")
+		}
+	}
+	b.Put(AccountKVNamespace, []byte(CurrentHeightKey), byteutil.Uint64ToBytes(height-1), "failed to put height")
+	if err := sr.kvStore.WriteBatch(b); err != nil {
+		return errors.Wrapf(err, "failed to revert state changes of block %d", height)
+	}
+	// an interrupted rewind leaves the diff of the block, which is dropped by Start
+	return sr.diffs.Rewind(ctx, height-1)
+}
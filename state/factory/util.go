@@ -9,8 +9,6 @@ import (
 	"context"
 
 	"github.com/iotexproject/go-pkgs/bloom"
-	"github.com/iotexproject/go-pkgs/crypto"
-	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/v2/action"
@@ -43,18 +41,6 @@ func appendActionIndex(accountNonceMap map[string][]uint64, srcAddr string, nonc
 	accountNonceMap[srcAddr] = append(accountNonceMap[srcAddr], nonce)
 }
 
-func calculateReceiptRoot(receipts []*action.Receipt) hash.Hash256 {
-	if len(receipts) == 0 {
-		return hash.ZeroHash256
-	}
-	h := make([]hash.Hash256, 0, len(receipts))
-	for _, receipt := range receipts {
-		h = append(h, receipt.Hash())
-	}
-	res := crypto.NewMerkleTree(h).HashTree()
-	return res
-}
-
 func calculateLogsBloom(ctx context.Context, receipts []*action.Receipt) bloom.BloomFilter {
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	g := genesis.MustExtractGenesisContext(ctx)
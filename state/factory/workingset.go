@@ -27,7 +27,6 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/v2/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/v2/actpool"
-	"github.com/iotexproject/iotex-core/v2/actpool/actioniterator"
 	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/v2/db"
@@ -74,6 +73,8 @@ type (
 		finalized              bool
 		txValidator            *protocol.GenericValidator
 		receipts               []*action.Receipt
+		workers                int
+		stateDiffHandler       StateDiffHandler
 	}
 )
 
@@ -315,6 +316,11 @@ func (ws *workingSet) Commit(ctx context.Context) error {
 	if err := protocolPreCommit(ctx, ws); err != nil {
 		return err
 	}
+	if ws.stateDiffHandler != nil {
+		if err := ws.handleStateChanges(ctx, ws.stateDiffHandler); err != nil {
+			return errors.Wrap(err, "failed to handle state changes")
+		}
+	}
 	if err := ws.store.Commit(ctx); err != nil {
 		return err
 	}
@@ -507,25 +513,22 @@ func (ws *workingSet) process(ctx context.Context, actions []*action.SealedEnvel
 		ctxWithBlockContext = ctx
 		blkCtx              = protocol.MustGetBlockCtx(ctx)
 		fCtx                = protocol.MustGetFeatureCtx(ctx)
+		speculations        = ws.speculate(ctx, userActions)
 	)
-	for _, act := range userActions {
-		if err := ws.txValidator.ValidateWithState(ctxWithBlockContext, act); err != nil {
-			return err
-		}
-		actionCtx, err := withActionCtx(ctxWithBlockContext, act)
-		if err != nil {
-			return err
-		}
-		for _, p := range reg.All() {
-			if validator, ok := p.(protocol.ActionValidator); ok {
-				if err := validator.Validate(actionCtx, act.Envelope, ws); err != nil {
-					return err
-				}
+	for i, act := range userActions {
+		var (
+			receipt *action.Receipt
+			err     error
+		)
+		if speculations != nil {
+			if receipt, err = ws.commitSpeculation(ctxWithBlockContext, act, speculations[i]); err != nil {
+				return err
 			}
 		}
-		receipt, err := ws.runAction(actionCtx, act)
-		if err != nil {
-			return errors.Wrap(err, "error when run action")
+		if receipt == nil {
+			if receipt, err = ws.runUserAction(ctxWithBlockContext, act); err != nil {
+				return err
+			}
 		}
 		receipts = append(receipts, receipt)
 		if !action.IsSystemAction(act) {
@@ -560,6 +563,29 @@ func (ws *workingSet) process(ctx context.Context, actions []*action.SealedEnvel
 	return ws.finalize(ctx)
 }
 
+// runUserAction validates the user action against the working set and runs it
+func (ws *workingSet) runUserAction(ctx context.Context, act *action.SealedEnvelope) (*action.Receipt, error) {
+	if err := ws.txValidator.ValidateWithState(ctx, act); err != nil {
+		return nil, err
+	}
+	actionCtx, err := withActionCtx(ctx, act)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range protocol.MustGetRegistry(ctx).All() {
+		if validator, ok := p.(protocol.ActionValidator); ok {
+			if err := validator.Validate(actionCtx, act.Envelope, ws); err != nil {
+				return nil, err
+			}
+		}
+	}
+	receipt, err := ws.runAction(actionCtx, act)
+	if err != nil {
+		return nil, errors.Wrap(err, "error when run action")
+	}
+	return receipt, nil
+}
+
 func (ws *workingSet) processLegacy(ctx context.Context, actions []*action.SealedEnvelope) error {
 	if err := ws.validate(ctx); err != nil {
 		return err
@@ -740,7 +766,17 @@ func (ws *workingSet) pickAndRunActions(
 		if dl, ok := ctx.Deadline(); ok {
 			deadline = &dl
 		}
-		actionIterator := actioniterator.NewActionIterator(ap.PendingActionMap())
+		var (
+			builder        = getBlockBuilder(ctx)
+			actionIterator = builder.ActionIterator(ctx, ap.PendingActionMap())
+			skipped        = make(map[string]int)
+		)
+		defer func() {
+			for _, reason := range _skipReasons {
+				_mintSkippedActions.WithLabelValues(builder.Name(), reason).Set(float64(skipped[reason]))
+			}
+			log.L().Debug("picked actions", zap.Uint64("height", ws.height), zap.String("builder", builder.Name()), zap.Int("actions", len(executedActions)), zap.Any("skipped", skipped))
+		}()
 		for {
 			if deadline != nil && time.Now().After(*deadline) {
 				duration := time.Since(blkCtx.BlockTimeStamp)
@@ -754,16 +790,24 @@ func (ws *workingSet) pickAndRunActions(
 				break
 			}
 			if nextAction.Gas() > blkCtx.GasLimit {
+				skipped[_skipGasLimit]++
+				actionIterator.PopAccount()
+				continue
+			}
+			if nextAction.Gas() > builder.GasLimit(ctx, nextAction, blkCtx.GasLimit) {
+				skipped[_skipReservedGas]++
 				actionIterator.PopAccount()
 				continue
 			}
 			if blobCnt+uint64(len(nextAction.BlobHashes())) > uint64(blobLimit) {
+				skipped[_skipBlobLimit]++
 				actionIterator.PopAccount()
 				continue
 			}
 			if container, ok := nextAction.Envelope.(action.TxContainer); ok {
 				if err := container.Unfold(nextAction, ctx, ws.checkContract); err != nil {
 					log.L().Debug("failed to unfold tx container", zap.Uint64("height", ws.height), zap.Error(err))
+					skipped[_skipUnfold]++
 					ap.DeleteAction(nextAction.SenderAddress())
 					actionIterator.PopAccount()
 					continue
@@ -772,8 +816,11 @@ func (ws *workingSet) pickAndRunActions(
 			if err := ws.txValidator.ValidateWithState(ctxWithBlockContext, nextAction); err != nil {
 				log.L().Debug("failed to ValidateWithState", zap.Uint64("height", ws.height), zap.Error(err))
 				if !errors.Is(err, action.ErrNonceTooLow) {
+					skipped[_skipInvalidState]++
 					ap.DeleteAction(nextAction.SenderAddress())
 					actionIterator.PopAccount()
+				} else {
+					skipped[_skipNonceTooLow]++
 				}
 				continue
 			}
@@ -793,6 +840,7 @@ func (ws *workingSet) pickAndRunActions(
 					return nil, errors.New("failed to get address")
 				}
 				log.L().Debug("failed to validate tx", zap.Uint64("height", ws.height), zap.Error(err))
+				skipped[_skipInvalid]++
 				ap.DeleteAction(caller)
 				actionIterator.PopAccount()
 				continue
@@ -802,10 +850,12 @@ func (ws *workingSet) pickAndRunActions(
 			case nil:
 				// do nothing
 			case action.ErrGasLimit:
+				skipped[_skipGasExhausted]++
 				actionIterator.PopAccount()
 				continue
 			case action.ErrChainID, errUnfoldTxContainer, errDeployerNotWhitelisted:
 				log.L().Debug("runAction() failed", zap.Uint64("height", ws.height), zap.Error(err))
+				skipped[_skipRejected]++
 				ap.DeleteAction(caller)
 				actionIterator.PopAccount()
 				continue
@@ -937,7 +987,7 @@ func (ws *workingSet) ValidateBlock(ctx context.Context, blk *block.Block) error
 	if !blk.VerifyDeltaStateDigest(digest) {
 		return errors.Wrapf(block.ErrDeltaStateMismatch, "digest in block '%x' vs digest in workingset '%x'", blk.DeltaStateDigest(), digest)
 	}
-	receiptRoot := calculateReceiptRoot(ws.receipts)
+	receiptRoot := block.CalculateReceiptRoot(ws.receipts)
 	if !blk.VerifyReceiptRoot(receiptRoot) {
 		return errors.Wrapf(block.ErrReceiptRootMismatch, "receipt root in block '%x' vs receipt root in workingset '%x'", blk.ReceiptRoot(), receiptRoot)
 	}
@@ -975,7 +1025,7 @@ func (ws *workingSet) CreateBuilder(
 		SetPrevBlockHash(bcCtx.Tip.Hash).
 		SetDeltaStateDigest(digest).
 		SetReceipts(ws.receipts).
-		SetReceiptRoot(calculateReceiptRoot(ws.receipts)).
+		SetReceiptRoot(block.CalculateReceiptRoot(ws.receipts)).
 		SetLogsBloom(calculateLogsBloom(ctx, ws.receipts))
 	if fCtx.EnableDynamicFeeTx {
 		blkBuilder.SetGasUsed(calculateGasUsed(ws.receipts))
@@ -1000,7 +1050,9 @@ func (ws *workingSet) NewWorkingSet(ctx context.Context) (*workingSet, error) {
 	if err := views.Commit(ctx, ws); err != nil {
 		return nil, err
 	}
-	return newWorkingSet(ws.height+1, views, store, ws.workingSetStoreFactory), nil
+	newWs := newWorkingSet(ws.height+1, views, store, ws.workingSetStoreFactory)
+	newWs.workers = ws.workers
+	return newWs, nil
 }
 
 func (ws *workingSet) Close() {
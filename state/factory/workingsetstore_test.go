@@ -106,3 +106,36 @@ func TestStateDBWorkingSetStore(t *testing.T) {
 func TestFactoryWorkingSetStore(t *testing.T) {
 	// TODO: add unit test for factory working set store
 }
+
+func TestStateDBWorkingSetStoreStateChanges(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	inMemStore := db.NewMemKVStore()
+	require.NoError(inMemStore.Start(ctx))
+	require.NoError(inMemStore.Put("ns", []byte("a"), []byte("1")))
+	require.NoError(inMemStore.Put("ns", []byte("b"), []byte("2")))
+	require.NoError(inMemStore.Put("ns", []byte("c"), []byte("3")))
+	flusher, err := db.NewKVStoreFlusher(inMemStore, batch.NewCachedBatch())
+	require.NoError(err)
+	store := newStateDBWorkingSetStore(flusher, true)
+	require.NoError(store.Put("ns", []byte("d"), []byte("4")))
+	require.NoError(store.Put("ns", []byte("a"), []byte("5")))
+	require.NoError(store.Delete("ns", []byte("b")))
+	require.NoError(store.Put("ns", []byte("a"), []byte("6")))
+	// writes which restore the previous value are not changes
+	require.NoError(store.Put("ns", []byte("c"), []byte("3")))
+	require.NoError(store.Put("ns", []byte("e"), []byte("7")))
+	require.NoError(store.Delete("ns", []byte("e")))
+	require.NoError(store.Finalize(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 1})))
+
+	changes, err := store.(*stateDBWorkingSetStore).stateChanges()
+	require.NoError(err)
+	require.Equal([]*StateChange{
+		{Namespace: "ns", Key: []byte("d"), Current: []byte("4")},
+		{Namespace: "ns", Key: []byte("a"), Previous: []byte("1"), Current: []byte("6")},
+		{Namespace: "ns", Key: []byte("b"), Previous: []byte("2")},
+	}, changes)
+	require.NoError(store.Commit(ctx))
+	_, err = store.(*stateDBWorkingSetStore).stateChanges()
+	require.Error(err)
+}
@@ -104,6 +104,29 @@ func (s *IndexerCommon) Commit(height uint64, delta batch.KVStoreBatch) error {
 	return nil
 }
 
+// Reset deletes all the data in the namespace of the indexer and the given
+// namespaces, and resets the height
+func (s *IndexerCommon) Reset(namespaces ...string) error {
+	b := batch.NewBatch()
+	for _, ns := range append([]string{s.ns}, namespaces...) {
+		keys, _, err := s.kvstore.Filter(ns, func(k, v []byte) bool { return true }, nil, nil)
+		if err != nil {
+			if errors.Is(err, db.ErrNotExist) || errors.Is(err, db.ErrBucketNotExist) {
+				continue
+			}
+			return err
+		}
+		for _, k := range keys {
+			b.Delete(ns, k, "failed to delete index data")
+		}
+	}
+	if err := s.kvstore.WriteBatch(b); err != nil {
+		return err
+	}
+	s.height = 0
+	return nil
+}
+
 // ExpectedHeight returns the expected height
 func (s *IndexerCommon) ExpectedHeight() uint64 {
 	if s.height < s.startHeight {
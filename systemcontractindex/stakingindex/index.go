@@ -281,6 +281,17 @@ func (s *Indexer) PutBlock(ctx context.Context, blk *block.Block) error {
 	return s.commit(handler, blk.Height())
 }
 
+// Reset deletes all the indexed data, so the index can be rebuilt from the chain db
+func (s *Indexer) Reset(context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.common.Reset(s.bucketNS); err != nil {
+		return err
+	}
+	s.cache = newCache(s.ns, s.bucketNS)
+	return nil
+}
+
 func (s *Indexer) handleReceipt(ctx context.Context, eh stakingEventHandler, receipt *action.Receipt) error {
 	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
 		return nil
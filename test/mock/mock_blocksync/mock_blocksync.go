@@ -51,17 +51,45 @@ func (mr *MockBlockSyncMockRecorder) BuildReport() *gomock.Call {
 }
 
 // ProcessBlock mocks base method.
-func (m *MockBlockSync) ProcessBlock(arg0 context.Context, arg1 string, arg2 *block.Block) error {
+func (m *MockBlockSync) ProcessBlock(arg0 context.Context, arg1 string, arg2 *block.Block, arg3 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessBlock", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ProcessBlock", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ProcessBlock indicates an expected call of ProcessBlock.
-func (mr *MockBlockSyncMockRecorder) ProcessBlock(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockBlockSyncMockRecorder) ProcessBlock(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlock", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlock), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlock", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlock), arg0, arg1, arg2, arg3)
+}
+
+// ProcessBlockHeader mocks base method.
+func (m *MockBlockSync) ProcessBlockHeader(arg0 context.Context, arg1 string, arg2 *block.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBlockHeader", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBlockHeader indicates an expected call of ProcessBlockHeader.
+func (mr *MockBlockSyncMockRecorder) ProcessBlockHeader(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockHeader", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockHeader), arg0, arg1, arg2)
+}
+
+// ProcessHeaderSyncRequest mocks base method.
+func (m *MockBlockSync) ProcessHeaderSyncRequest(arg0 context.Context, arg1 peer.AddrInfo, arg2, arg3 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessHeaderSyncRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessHeaderSyncRequest indicates an expected call of ProcessHeaderSyncRequest.
+func (mr *MockBlockSyncMockRecorder) ProcessHeaderSyncRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessHeaderSyncRequest", reflect.TypeOf((*MockBlockSync)(nil).ProcessHeaderSyncRequest), arg0, arg1, arg2, arg3)
 }
 
 // ProcessSyncRequest mocks base method.
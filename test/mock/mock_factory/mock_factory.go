@@ -71,6 +71,22 @@ func (mr *MockFactoryMockRecorder) Mint(arg0, arg1, arg2 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockFactory)(nil).Mint), arg0, arg1, arg2)
 }
 
+// MintPending mocks base method.
+func (m *MockFactory) MintPending(arg0 context.Context, arg1 actpool.ActPool, arg2 crypto.PublicKey) (*block.Block, protocol.StateForker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(protocol.StateForker)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MintPending indicates an expected call of MintPending.
+func (mr *MockFactoryMockRecorder) MintPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintPending", reflect.TypeOf((*MockFactory)(nil).MintPending), arg0, arg1, arg2)
+}
+
 // PutBlock mocks base method.
 func (m *MockFactory) PutBlock(arg0 context.Context, arg1 *block.Block) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,17 @@
+module github.com/iotexproject/iotex-proto
+
+go 1.21
+
+require (
+	github.com/golang/mock v1.6.0
+	google.golang.org/grpc v1.65.0
+	google.golang.org/protobuf v1.34.2
+)
+
+require golang.org/x/net v0.25.0 // indirect
+
+require (
+	golang.org/x/sys v0.20.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+	google.golang.org/genproto v0.0.0-20230127162408-596548ed4efa // indirect
+)
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/v2/blockindex"
+	"github.com/iotexproject/iotex-core/v2/config"
+	"github.com/iotexproject/iotex-core/v2/db"
+	iocommon "github.com/iotexproject/iotex-core/v2/tools/iomigrater/common"
+)
+
+// Multi-language support
+var (
+	verifyDbCmdShorts = map[string]string{
+		"english": "Sub-Command for verify integrity of IoTeX blockchain db file.",
+		"chinese": "校验IoTeX区块链 db 文件完整性的子命令",
+	}
+	verifyDbCmdLongs = map[string]string{
+		"english": "Sub-Command for verify every block in IoTeX blockchain db file, cross-check the block index and blob db, and optionally truncate the db file to the last good height.",
+		"chinese": "校验IoTeX区块链 db 文件中每个区块，交叉检查区块索引和blob数据库，并可选择将 db 文件截断到最后一个完好的高度的子命令",
+	}
+	verifyDbCmdUse = map[string]string{
+		"english": "verify",
+		"chinese": "verify",
+	}
+	verifyDbFlagFileUse = map[string]string{
+		"english": "The blockchain db file you want to verify.",
+		"chinese": "您要校验的区块链 db 文件。",
+	}
+	verifyDbFlagIndexFileUse = map[string]string{
+		"english": "The block index db file to cross-check, skipped if empty.",
+		"chinese": "用于交叉检查的区块索引 db 文件，为空时跳过。",
+	}
+	verifyDbFlagBlobFileUse = map[string]string{
+		"english": "The blob db file to cross-check, skipped if empty.",
+		"chinese": "用于交叉检查的blob db 文件，为空时跳过。",
+	}
+	verifyDbFlagRepairUse = map[string]string{
+		"english": "Truncate the blockchain db file to the last good height.",
+		"chinese": "将区块链 db 文件截断到最后一个完好的高度。",
+	}
+)
+
+var (
+	// VerifyDb Used to Sub command.
+	VerifyDb = &cobra.Command{
+		Use:   iocommon.TranslateInLang(verifyDbCmdUse),
+		Short: iocommon.TranslateInLang(verifyDbCmdShorts),
+		Long:  iocommon.TranslateInLang(verifyDbCmdLongs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return verifyDbFile()
+		},
+	}
+)
+
+var (
+	verifyFile      = ""
+	verifyIndexFile = ""
+	verifyBlobFile  = ""
+	verifyRepair    = false
+)
+
+func init() {
+	VerifyDb.PersistentFlags().StringVarP(&verifyFile, "file", "f", "", iocommon.TranslateInLang(verifyDbFlagFileUse))
+	VerifyDb.PersistentFlags().StringVarP(&verifyIndexFile, "index-file", "i", "", iocommon.TranslateInLang(verifyDbFlagIndexFileUse))
+	VerifyDb.PersistentFlags().StringVarP(&verifyBlobFile, "blob-file", "b", "", iocommon.TranslateInLang(verifyDbFlagBlobFileUse))
+	VerifyDb.PersistentFlags().BoolVarP(&verifyRepair, "repair", "r", false, iocommon.TranslateInLang(verifyDbFlagRepairUse))
+}
+
+func verifyDbFile() (err error) {
+	if verifyFile == "" {
+		return errors.New("--file is empty")
+	}
+	cfg, err := config.New([]string{}, []string{})
+	if err != nil {
+		return fmt.Errorf("failed to new config: %v", err)
+	}
+	block.LoadGenesisHash(&cfg.Genesis)
+
+	ctx := context.Background()
+	cfg.DB.DbPath = verifyFile
+	store, err := filedao.NewFileDAO(cfg.DB, block.NewDeserializer(cfg.Chain.EVMNetworkID))
+	if err != nil {
+		return err
+	}
+	if err := store.Start(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if e := store.Stop(ctx); e != nil && err == nil {
+			err = e
+		}
+	}()
+	tip, err := store.Height()
+	if err != nil {
+		return err
+	}
+
+	var (
+		checkers     []filedao.BlockChecker
+		indexer      blockindex.Indexer
+		indexerTip   uint64
+		dbCfg        = cfg.DB
+		blocksPerDay = uint64(24 * time.Hour / cfg.WakeUpgrade.BlockInterval)
+	)
+	if verifyIndexFile != "" {
+		dbCfg.DbPath = verifyIndexFile
+		indexer, err = blockindex.NewIndexer(db.NewBoltDB(dbCfg), block.GenesisHash())
+		if err != nil {
+			return err
+		}
+		if err := indexer.Start(ctx); err != nil {
+			return err
+		}
+		defer indexer.Stop(ctx)
+		if indexerTip, err = indexer.Height(); err != nil {
+			return err
+		}
+		checkers = append(checkers, indexChecker(indexer, indexerTip))
+	}
+	if verifyBlobFile != "" {
+		dbCfg.DbPath = verifyBlobFile
+		retention := blocksPerDay * uint64(cfg.Chain.BlobStoreRetentionDays)
+		blobStore := blockdao.NewBlobStore(db.NewBoltDB(dbCfg), retention)
+		if err := blobStore.Start(ctx); err != nil {
+			return err
+		}
+		defer blobStore.Stop(ctx)
+		checkers = append(checkers, blobChecker(blobStore, tip, retention))
+	}
+
+	report, err := filedao.VerifyFileDAO(store, checkers...)
+	if err != nil {
+		return err
+	}
+	for _, f := range report.Files {
+		if f.BadHeight == 0 {
+			fmt.Printf("%s: height %d ~ %d, ok\n", f.Name, f.Start, f.End)
+			continue
+		}
+		fmt.Printf("%s: height %d ~ %d, first bad height %d: %v\n", f.Name, f.Start, f.End, f.BadHeight, f.Err)
+	}
+	fmt.Printf("Tip height %d, last good height %d.\n", tip, report.LastGood)
+	if report.LastGood == tip {
+		return nil
+	}
+	if !verifyRepair {
+		return errors.Wrapf(filedao.ErrDataCorruption, "db %s is corrupted above height %d", verifyFile, report.LastGood)
+	}
+	if err := filedao.TruncateFileDAO(store, report.LastGood); err != nil {
+		return err
+	}
+	fmt.Printf("Truncated db %s to height %d.\n", verifyFile, report.LastGood)
+	if indexer != nil && indexerTip > report.LastGood {
+		fmt.Printf("Index db %s is at height %d, delete it to rebuild the index.\n", verifyIndexFile, indexerTip)
+	}
+	return nil
+}
+
+// indexChecker checks the height <-> hash mapping in the block index
+func indexChecker(indexer blockindex.Indexer, indexerTip uint64) filedao.BlockChecker {
+	return func(blk *block.Block) error {
+		height := blk.Height()
+		if height > indexerTip {
+			return nil
+		}
+		h := blk.HashBlock()
+		indexed, err := indexer.GetBlockHash(height)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get indexed hash at height %d", height)
+		}
+		if indexed != h {
+			return errors.Errorf("indexed hash at height %d = %x, block hash %x", height, indexed, h)
+		}
+		indexedHeight, err := indexer.GetBlockHeight(h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get indexed height of block %x", h)
+		}
+		if indexedHeight != height {
+			return errors.Errorf("indexed height of block %x = %d, expect %d", h, indexedHeight, height)
+		}
+		return nil
+	}
+}
+
+// blobChecker checks the blobs of the block are kept in the blob store, until
+// they expire
+func blobChecker(blobStore blockdao.BlobStore, tip, retention uint64) filedao.BlockChecker {
+	return func(blk *block.Block) error {
+		height := blk.Height()
+		if height+retention <= tip {
+			return nil
+		}
+		var expected []string
+		for _, act := range blk.Actions {
+			if act.BlobTxSidecar() == nil {
+				continue
+			}
+			h, err := act.Hash()
+			if err != nil {
+				return err
+			}
+			expected = append(expected, common.BytesToHash(h[:]).Hex())
+		}
+		if len(expected) == 0 {
+			return nil
+		}
+		_, hashes, err := blobStore.GetBlobsByHeight(height)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get blobs at height %d", height)
+		}
+		if len(hashes) != len(expected) {
+			return errors.Errorf("blob store has %d blobs at height %d, expect %d", len(hashes), height, len(expected))
+		}
+		for i := range expected {
+			if hashes[i] != expected[i] {
+				return errors.Errorf("blob hash at height %d = %s, expect %s", height, hashes[i], expected[i])
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that exports or imports the slashing protection records of the block producer keys, so that
+// they move with the keys from one node to another. The node must be stopped while the tool is running.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/config"
+	"github.com/iotexproject/iotex-core/v2/consensus/scheme/rolldpos/slashprotect"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+)
+
+var (
+	// _consensusDBPath is the path of consensus db
+	_consensusDBPath string
+	// _chainID is the chain ID of the records
+	_chainID uint
+	// _exportPath is the path of the file the records are exported to
+	_exportPath string
+	// _importPath is the path of the file the records are imported from
+	_importPath string
+	// _overwritePath is the path to the config file which overwrite default values
+	_overwritePath string
+	// _secretPath is the path to the  config file store secret values
+	_secretPath string
+)
+
+func init() {
+	flag.StringVar(&_consensusDBPath, "consensus-db-path", "", "Consensus DB path")
+	flag.UintVar(&_chainID, "chain-id", 0, "Chain ID")
+	flag.StringVar(&_exportPath, "export", "", "Path of the file the records are exported to")
+	flag.StringVar(&_importPath, "import", "", "Path of the file the records are imported from")
+	flag.StringVar(&_overwritePath, "config-path", "", "Config path")
+	flag.StringVar(&_secretPath, "secret-path", "", "Secret path")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "usage: slashprotect -config-path=[string] -export=[string] | -import=[string]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func readConfig() (string, uint32) {
+	if _consensusDBPath != "" && _chainID != 0 {
+		return _consensusDBPath, uint32(_chainID)
+	}
+	cfg, err := config.New([]string{_overwritePath, _secretPath}, []string{})
+	if err != nil {
+		log.S().Panic("failed to new config.", zap.Error(err))
+	}
+	path, chainID := cfg.Consensus.RollDPoS.ConsensusDBPath, cfg.Chain.ID
+	if _consensusDBPath != "" {
+		path = _consensusDBPath
+	}
+	if _chainID != 0 {
+		chainID = uint32(_chainID)
+	}
+	return path, chainID
+}
+
+func main() {
+	if (_exportPath == "") == (_importPath == "") {
+		flag.Usage()
+	}
+	path, chainID := readConfig()
+	cfg := db.DefaultConfig
+	cfg.DbPath = path
+	store := db.NewBoltDB(cfg)
+	if err := store.Start(context.Background()); err != nil {
+		log.S().Panic("failed to start db", zap.Error(err))
+	}
+	defer func() {
+		if err := store.Stop(context.Background()); err != nil {
+			log.S().Panic("failed to stop db", zap.Error(err))
+		}
+	}()
+	protection := slashprotect.New(store)
+	if err := protection.Load(); err != nil {
+		log.S().Panic("failed to load slashing protection records", zap.Error(err))
+	}
+	if _exportPath != "" {
+		f, err := os.Create(_exportPath)
+		if err != nil {
+			log.S().Panic("failed to create file", zap.Error(err))
+		}
+		defer f.Close()
+		if err := protection.Export(f, chainID); err != nil {
+			log.S().Panic("failed to export slashing protection records", zap.Error(err))
+		}
+		return
+	}
+	f, err := os.Open(_importPath)
+	if err != nil {
+		log.S().Panic("failed to open file", zap.Error(err))
+	}
+	defer f.Close()
+	if err := protection.Import(f, chainID); err != nil {
+		log.S().Panic("failed to import slashing protection records", zap.Error(err))
+	}
+}
@@ -0,0 +1,441 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/v2/state"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+)
+
+const (
+	_formatCSV   = "csv"
+	_formatJSONL = "jsonl"
+
+	// _pageSize is the number of buckets or candidates read from the staking protocol at a time
+	_pageSize = 1000
+)
+
+type (
+	// record is a row of the dump
+	record interface {
+		kind() string
+		fields() []string
+		values() []string
+	}
+
+	// recordWriter writes the records of a kind
+	recordWriter interface {
+		write(record) error
+		flush() error
+	}
+
+	csvWriter struct {
+		w      *csv.Writer
+		header bool
+	}
+
+	jsonlWriter struct {
+		enc *json.Encoder
+	}
+
+	accountRecord struct {
+		address  string
+		balance  *big.Int
+		nonce    uint64
+		codeHash []byte
+	}
+
+	bucketRecord struct {
+		*iotextypes.VoteBucket
+	}
+
+	candidateRecord struct {
+		*iotextypes.CandidateV2
+	}
+
+	totalRecord struct {
+		name  string
+		value string
+	}
+
+	// totals are the sums used to reconcile the dump
+	totals struct {
+		accounts       uint64
+		contracts      uint64
+		skipped        uint64
+		balance        *big.Int
+		fundTotal      *big.Int
+		fundAvailable  *big.Int
+		buckets        uint64
+		bucketStaked   *big.Int
+		candidates     uint64
+		totalStaked    *big.Int
+		stakingMatched bool
+	}
+
+	// dumper streams the states read from a state reader
+	dumper struct {
+		sr        protocol.StateReader
+		rewarding *rewarding.Protocol
+		staking   *staking.Protocol
+		newWriter func(kind string) (recordWriter, io.Closer, error)
+		writers   map[string]recordWriter
+		closers   []io.Closer
+	}
+)
+
+func (r *accountRecord) kind() string { return "accounts" }
+
+func (r *accountRecord) fields() []string {
+	return []string{"address", "balance", "nonce", "codeHash"}
+}
+
+func (r *accountRecord) values() []string {
+	return []string{r.address, r.balance.String(), strconv.FormatUint(r.nonce, 10), hex.EncodeToString(r.codeHash)}
+}
+
+func (r *bucketRecord) kind() string { return "buckets" }
+
+func (r *bucketRecord) fields() []string {
+	return []string{"index", "owner", "candidate", "stakedAmount", "stakedDuration", "autoStake", "createTime", "stakeStartTime", "unstakeStartTime"}
+}
+
+func (r *bucketRecord) values() []string {
+	return []string{
+		strconv.FormatUint(r.Index, 10),
+		r.Owner,
+		r.CandidateAddress,
+		r.StakedAmount,
+		strconv.FormatUint(uint64(r.StakedDuration), 10),
+		strconv.FormatBool(r.AutoStake),
+		r.CreateTime.AsTime().UTC().Format(time.RFC3339),
+		r.StakeStartTime.AsTime().UTC().Format(time.RFC3339),
+		r.UnstakeStartTime.AsTime().UTC().Format(time.RFC3339),
+	}
+}
+
+func (r *candidateRecord) kind() string { return "candidates" }
+
+func (r *candidateRecord) fields() []string {
+	return []string{"name", "id", "owner", "operator", "reward", "totalWeightedVotes", "selfStakeBucketIdx", "selfStakingTokens"}
+}
+
+func (r *candidateRecord) values() []string {
+	return []string{
+		r.Name,
+		r.Id,
+		r.OwnerAddress,
+		r.OperatorAddress,
+		r.RewardAddress,
+		r.TotalWeightedVotes,
+		strconv.FormatUint(r.SelfStakeBucketIdx, 10),
+		r.SelfStakingTokens,
+	}
+}
+
+func (r *totalRecord) kind() string { return "totals" }
+
+func (r *totalRecord) fields() []string { return []string{"name", "value"} }
+
+func (r *totalRecord) values() []string { return []string{r.name, r.value} }
+
+func (w *csvWriter) write(r record) error {
+	if !w.header {
+		if err := w.w.Write(r.fields()); err != nil {
+			return err
+		}
+		w.header = true
+	}
+	return w.w.Write(r.values())
+}
+
+func (w *csvWriter) flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *jsonlWriter) write(r record) error {
+	fields, values := r.fields(), r.values()
+	m := make(map[string]string, len(fields))
+	for i, f := range fields {
+		m[f] = values[i]
+	}
+	return w.enc.Encode(m)
+}
+
+func (w *jsonlWriter) flush() error {
+	return nil
+}
+
+// newRecordWriter creates a record writer of the format
+func newRecordWriter(format string, w io.Writer) (recordWriter, error) {
+	switch format {
+	case _formatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case _formatJSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, errors.Errorf("unsupported format %s", format)
+	}
+}
+
+// fileWriters returns a function creating the file of each kind of records in the directory
+func fileWriters(dir, format string) func(string) (recordWriter, io.Closer, error) {
+	return func(kind string) (recordWriter, io.Closer, error) {
+		f, err := os.Create(filepath.Join(dir, kind+"."+format))
+		if err != nil {
+			return nil, nil, err
+		}
+		w, err := newRecordWriter(format, f)
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return w, f, nil
+	}
+}
+
+func newDumper(
+	sr protocol.StateReader,
+	rp *rewarding.Protocol,
+	sp *staking.Protocol,
+	newWriter func(string) (recordWriter, io.Closer, error),
+) *dumper {
+	return &dumper{
+		sr:        sr,
+		rewarding: rp,
+		staking:   sp,
+		newWriter: newWriter,
+		writers:   make(map[string]recordWriter),
+	}
+}
+
+// dump writes all the accounts, buckets and candidates, and the totals reconciling them
+func (d *dumper) dump(ctx context.Context) (_ *totals, err error) {
+	defer func() {
+		if e := d.close(); e != nil && err == nil {
+			err = errors.Wrap(e, "failed to close the output")
+		}
+	}()
+	t := &totals{
+		balance:      big.NewInt(0),
+		bucketStaked: big.NewInt(0),
+	}
+	height, err := d.sr.Height()
+	if err != nil {
+		return nil, err
+	}
+	if err := d.dumpAccounts(t); err != nil {
+		return nil, errors.Wrap(err, "failed to dump accounts")
+	}
+	if t.fundTotal, _, err = d.rewarding.TotalBalance(ctx, d.sr); err != nil {
+		return nil, errors.Wrap(err, "failed to read total balance of rewarding fund")
+	}
+	if t.fundAvailable, _, err = d.rewarding.AvailableBalance(ctx, d.sr); err != nil {
+		return nil, errors.Wrap(err, "failed to read available balance of rewarding fund")
+	}
+	if err := d.dumpBuckets(ctx, t); err != nil {
+		return nil, errors.Wrap(err, "failed to dump buckets")
+	}
+	if err := d.dumpCandidates(ctx, t); err != nil {
+		return nil, errors.Wrap(err, "failed to dump candidates")
+	}
+	meta := &iotextypes.AccountMeta{}
+	if err := d.readStaking(ctx, iotexapi.ReadStakingDataMethod_TOTAL_STAKING_AMOUNT, &iotexapi.ReadStakingDataRequest{
+		Request: &iotexapi.ReadStakingDataRequest_TotalStakingAmount_{
+			TotalStakingAmount: &iotexapi.ReadStakingDataRequest_TotalStakingAmount{},
+		},
+	}, meta); err != nil {
+		return nil, errors.Wrap(err, "failed to read total staking amount")
+	}
+	var ok bool
+	if t.totalStaked, ok = new(big.Int).SetString(meta.Balance, 10); !ok {
+		return nil, errors.Errorf("invalid total staking amount %s", meta.Balance)
+	}
+	t.stakingMatched = t.bucketStaked.Cmp(t.totalStaked) == 0
+	for _, r := range []*totalRecord{
+		{"height", strconv.FormatUint(height, 10)},
+		{"accounts", strconv.FormatUint(t.accounts, 10)},
+		{"contracts", strconv.FormatUint(t.contracts, 10)},
+		{"skippedEntries", strconv.FormatUint(t.skipped, 10)},
+		{"accountBalance", t.balance.String()},
+		{"rewardingFundTotal", t.fundTotal.String()},
+		{"rewardingFundAvailable", t.fundAvailable.String()},
+		{"buckets", strconv.FormatUint(t.buckets, 10)},
+		{"bucketStakedAmount", t.bucketStaked.String()},
+		{"totalStakingAmount", t.totalStaked.String()},
+		{"stakingReconciled", strconv.FormatBool(t.stakingMatched)},
+		{"candidates", strconv.FormatUint(t.candidates, 10)},
+		{"totalSupply", t.supply().String()},
+	} {
+		if err := d.write(r); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// supply is the sum of the account balances, the rewarding fund and the staked amount
+func (t *totals) supply() *big.Int {
+	supply := new(big.Int).Add(t.balance, t.fundTotal)
+	return supply.Add(supply, t.totalStaked)
+}
+
+func (d *dumper) dumpAccounts(t *totals) error {
+	_, iter, err := d.sr.States(protocol.NamespaceOption(factory.AccountKVNamespace))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < iter.Size(); i++ {
+		acct := &state.Account{}
+		key, err := iter.Next(acct)
+		if len(key) != 20 || err != nil {
+			// the height and the legacy protocol states are stored in the account namespace too
+			t.skipped++
+			continue
+		}
+		addr, err := address.FromBytes(key)
+		if err != nil {
+			return err
+		}
+		if err := d.write(&accountRecord{
+			address:  addr.String(),
+			balance:  acct.Balance,
+			nonce:    acct.PendingNonceConsideringFreshAccount(),
+			codeHash: acct.CodeHash,
+		}); err != nil {
+			return err
+		}
+		t.accounts++
+		if acct.IsContract() {
+			t.contracts++
+		}
+		t.balance.Add(t.balance, acct.Balance)
+	}
+	return nil
+}
+
+func (d *dumper) dumpBuckets(ctx context.Context, t *totals) error {
+	for offset := uint32(0); ; offset += _pageSize {
+		list := &iotextypes.VoteBucketList{}
+		if err := d.readStaking(ctx, iotexapi.ReadStakingDataMethod_BUCKETS, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_Buckets{
+				Buckets: &iotexapi.ReadStakingDataRequest_VoteBuckets{
+					Pagination: &iotexapi.PaginationParam{Offset: offset, Limit: _pageSize},
+				},
+			},
+		}, list); err != nil {
+			return err
+		}
+		for _, b := range list.Buckets {
+			amount, ok := new(big.Int).SetString(b.StakedAmount, 10)
+			if !ok {
+				return errors.Errorf("invalid staked amount %s of bucket %d", b.StakedAmount, b.Index)
+			}
+			if err := d.write(&bucketRecord{b}); err != nil {
+				return err
+			}
+			t.buckets++
+			t.bucketStaked.Add(t.bucketStaked, amount)
+		}
+		if len(list.Buckets) < _pageSize {
+			return nil
+		}
+	}
+}
+
+func (d *dumper) dumpCandidates(ctx context.Context, t *totals) error {
+	for offset := uint32(0); ; offset += _pageSize {
+		list := &iotextypes.CandidateListV2{}
+		if err := d.readStaking(ctx, iotexapi.ReadStakingDataMethod_CANDIDATES, &iotexapi.ReadStakingDataRequest{
+			Request: &iotexapi.ReadStakingDataRequest_Candidates_{
+				Candidates: &iotexapi.ReadStakingDataRequest_Candidates{
+					Pagination: &iotexapi.PaginationParam{Offset: offset, Limit: _pageSize},
+				},
+			},
+		}, list); err != nil {
+			return err
+		}
+		for _, c := range list.Candidates {
+			if err := d.write(&candidateRecord{c}); err != nil {
+				return err
+			}
+			t.candidates++
+		}
+		if len(list.Candidates) < _pageSize {
+			return nil
+		}
+	}
+}
+
+func (d *dumper) readStaking(ctx context.Context, m iotexapi.ReadStakingDataMethod_Name, req *iotexapi.ReadStakingDataRequest, resp proto.Message) error {
+	method, err := proto.Marshal(&iotexapi.ReadStakingDataMethod{Method: m})
+	if err != nil {
+		return err
+	}
+	arg, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	data, _, err := d.staking.ReadState(ctx, d.sr, method, arg)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, resp)
+}
+
+func (d *dumper) write(r record) error {
+	w, ok := d.writers[r.kind()]
+	if !ok {
+		var (
+			closer io.Closer
+			err    error
+		)
+		if w, closer, err = d.newWriter(r.kind()); err != nil {
+			return err
+		}
+		d.writers[r.kind()] = w
+		d.closers = append(d.closers, closer)
+	}
+	return w.write(r)
+}
+
+func (d *dumper) close() error {
+	var err error
+	for _, w := range d.writers {
+		if e := w.flush(); e != nil && err == nil {
+			err = e
+		}
+	}
+	for _, c := range d.closers {
+		if e := c.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	d.writers = make(map[string]recordWriter)
+	d.closers = nil
+	return err
+}
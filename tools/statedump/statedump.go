@@ -0,0 +1,207 @@
+// Copyright (c) 2025 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that dumps every account, staking bucket and candidate in the state db,
+// with the totals reconciling them, while the node is stopped.
+// To use, run "make build-statedump"
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	glog "log"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
+	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
+	"github.com/iotexproject/iotex-core/v2/config"
+	"github.com/iotexproject/iotex-core/v2/consensus/consensusfsm"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/log"
+	"github.com/iotexproject/iotex-core/v2/state/factory"
+)
+
+var (
+	genesisPath    string
+	_overwritePath string
+	_secretPath    string
+	// _height is the height to dump the states at, 0 means the height of the state db
+	_height uint64
+	// _format is the format of the output files
+	_format string
+	// _outputDir is the directory of the output files
+	_outputDir string
+)
+
+func init() {
+	flag.StringVar(&genesisPath, "genesis-path", "", "Genesis path")
+	flag.StringVar(&_overwritePath, "config-path", "", "Config path")
+	flag.StringVar(&_secretPath, "secret-path", "", "Secret path")
+	flag.Uint64Var(&_height, "height", 0, "Height to dump the states at, below the state db height it requires the state diff db")
+	flag.StringVar(&_format, "format", _formatCSV, "Output format, csv or jsonl")
+	flag.StringVar(&_outputDir, "output-dir", ".", "Directory of the output files")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: statedump -config-path=[string]\n -height=[int]\n -format=[csv|jsonl]\n -output-dir=[string]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	genesisCfg, err := genesis.New(genesisPath)
+	if err != nil {
+		glog.Fatalln("Failed to new genesis config.", zap.Error(err))
+	}
+	cfg, err := config.New([]string{_overwritePath, _secretPath}, []string{})
+	if err != nil {
+		glog.Fatalln("Failed to new config.", zap.Error(err))
+	}
+	cfg.Genesis = genesisCfg
+	block.LoadGenesisHash(&cfg.Genesis)
+	if _, err := newRecordWriter(_format, os.Stdout); err != nil {
+		log.L().Fatal("Invalid output format.", zap.Error(err))
+	}
+
+	ctx := genesis.WithGenesisContext(context.Background(), cfg.Genesis)
+	t, err := dumpStates(ctx, cfg)
+	if err != nil {
+		log.L().Fatal("Failed to dump states.", zap.Error(err))
+	}
+	if !t.stakingMatched {
+		log.L().Warn("Staked amount of the buckets does not match the total staking amount.",
+			zap.String("buckets", t.bucketStaked.String()),
+			zap.String("total", t.totalStaked.String()))
+	}
+	log.L().Info("Success to dump states.",
+		zap.Uint64("accounts", t.accounts),
+		zap.Uint64("buckets", t.buckets),
+		zap.Uint64("candidates", t.candidates),
+		zap.String("totalSupply", t.supply().String()))
+}
+
+func dumpStates(ctx context.Context, cfg config.Config) (*totals, error) {
+	registry := protocol.NewRegistry()
+	rp := rewarding.NewProtocol(cfg.Genesis.Rewarding)
+	if err := rp.Register(registry); err != nil {
+		return nil, err
+	}
+	consensusCfg := consensusfsm.NewConsensusConfig(cfg.Consensus.RollDPoS.FSM, cfg.DardanellesUpgrade, cfg.WakeUpgrade, cfg.Genesis, cfg.Consensus.RollDPoS.Delay)
+	sp, err := staking.NewProtocol(
+		staking.HelperCtx{
+			DepositGas:    rewarding.DepositGas,
+			BlockInterval: consensusCfg.BlockInterval,
+		},
+		&staking.BuilderConfig{
+			Staking:                  cfg.Genesis.Staking,
+			PersistStakingPatchBlock: cfg.Chain.PersistStakingPatchBlock,
+			FixAliasForNonStopHeight: cfg.Chain.FixAliasForNonStopHeight,
+			StakingPatchDir:          cfg.Chain.StakingPatchDir,
+			Revise: staking.ReviseConfig{
+				VoteWeight:                  cfg.Genesis.VoteWeightCalConsts,
+				ReviseHeights:               []uint64{cfg.Genesis.GreenlandBlockHeight, cfg.Genesis.HawaiiBlockHeight},
+				CorrectCandsHeight:          cfg.Genesis.OkhotskBlockHeight,
+				SelfStakeBucketReviseHeight: cfg.Genesis.UpernavikBlockHeight,
+				CorrectCandSelfStakeHeight:  cfg.Genesis.VanuatuBlockHeight,
+			},
+		},
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := sp.Register(registry); err != nil {
+		return nil, err
+	}
+
+	// open the state db read-only, the states below its height are reconstructed from the state diffs
+	factoryCfg := factory.GenerateConfig(cfg.Chain, cfg.Genesis)
+	factoryCfg.Chain.HistoryIndexPath = ""
+	factoryCfg.Chain.HistoryStateDepth = math.MaxUint64
+	factoryCfg.Chain.HistoryStateCacheSize = 1
+	dbCfg := cfg.DB
+	dbCfg.ReadOnly = true
+	factoryDBCfg := dbCfg
+	factoryDBCfg.DBType = cfg.Chain.FactoryDBType
+	kvStore, err := db.CreateKVStore(factoryDBCfg, cfg.Chain.TrieDBPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open state db")
+	}
+	opts := []factory.StateDBOption{factory.RegistryStateDBOption(registry)}
+	if path := cfg.Chain.StateDiffDBPath; len(path) > 0 {
+		dbCfg.DbPath = path
+		indexer, err := statediff.NewIndexer(db.NewBoltDB(dbCfg), math.MaxUint64, cfg.Genesis.GreenlandBlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		if err := indexer.Start(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to open state diff db")
+		}
+		defer indexer.Stop(ctx)
+		opts = append(opts, factory.HistoryStateDBOption(indexer))
+	}
+	sf, err := factory.NewStateDB(factoryCfg, kvStore, opts...)
+	if err != nil {
+		return nil, err
+	}
+	ctx = protocol.WithFeatureWithHeightCtx(protocol.WithRegistry(ctx, registry))
+	if err := sf.Start(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to start state db")
+	}
+	defer sf.Stop(ctx)
+	tip, err := sf.Height()
+	if err != nil {
+		return nil, err
+	}
+	height := _height
+	switch {
+	case height == 0:
+		height = tip
+	case height > tip:
+		return nil, errors.Errorf("height %d is higher than state db height %d", height, tip)
+	case height < tip && len(cfg.Chain.StateDiffDBPath) == 0:
+		return nil, errors.Errorf("state diff db is required to dump the states at height %d below state db height %d", height, tip)
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: height}))
+
+	var sr protocol.StateReader = sf
+	if height != tip {
+		ws, err := sf.WorkingSetAtHeight(ctx, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read states at height %d", height)
+		}
+		views, err := registry.StartAll(ctx, ws)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to start protocols at height %d", height)
+		}
+		sr = &viewReader{StateReader: ws, views: views}
+	}
+	if err := os.MkdirAll(_outputDir, 0755); err != nil {
+		return nil, err
+	}
+	return newDumper(sr, rp, sp, fileWriters(_outputDir, _format)).dump(ctx)
+}
+
+// viewReader serves the protocol views built on the states at a height below the state db height
+type viewReader struct {
+	protocol.StateReader
+	views *protocol.Views
+}
+
+func (r *viewReader) ReadView(name string) (protocol.View, error) {
+	return r.views.Read(name)
+}
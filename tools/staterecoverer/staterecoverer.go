@@ -3,7 +3,8 @@
 // or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
 // This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
 
-// This is a recovery tool that recovers a corrupted or missing state database.
+// This is a recovery tool that rolls the chain db and all indexers back to a
+// target height, while the node is stopped.
 // To use, run "make recover"
 package main
 
@@ -12,22 +13,41 @@ import (
 	"flag"
 	"fmt"
 	glog "log"
+	"math/big"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/v2/action/protocol"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/v2/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/v2/blockchain/block"
 	"github.com/iotexproject/iotex-core/v2/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/v2/blockchain/filedao"
 	"github.com/iotexproject/iotex-core/v2/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/v2/blockindex"
+	"github.com/iotexproject/iotex-core/v2/blockindex/balancehistory"
+	"github.com/iotexproject/iotex-core/v2/blockindex/contractstaking"
+	"github.com/iotexproject/iotex-core/v2/blockindex/statediff"
 	"github.com/iotexproject/iotex-core/v2/config"
+	"github.com/iotexproject/iotex-core/v2/db"
+	"github.com/iotexproject/iotex-core/v2/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/v2/pkg/log"
-	"github.com/iotexproject/iotex-core/v2/server/itx"
+	"github.com/iotexproject/iotex-core/v2/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/v2/state/factory"
+	"github.com/iotexproject/iotex-core/v2/systemcontractindex/stakingindex"
 )
 
 // recoveryHeight is the blockchain height being recovered to
 var recoveryHeight int
 
+// dryRun only prints the rollback plan
+var dryRun bool
+
 /**
  * overwritePath is the path to the config file which overwrite default values
  * secretPath is the path to the  config file store secret values
@@ -50,15 +70,46 @@ func (ss *strs) Set(str string) error {
 	return nil
 }
 
+// stateHeight reports the height of the state db, which cannot be rolled back
+// without the state diffs
+type stateHeight struct {
+	kvStore db.KVStore
+}
+
+func (s *stateHeight) Start(ctx context.Context) error {
+	return s.kvStore.Start(ctx)
+}
+
+func (s *stateHeight) Stop(ctx context.Context) error {
+	return s.kvStore.Stop(ctx)
+}
+
+func (s *stateHeight) Height() (uint64, error) {
+	h, err := s.kvStore.Get(factory.AccountKVNamespace, []byte(factory.CurrentHeightKey))
+	switch errors.Cause(err) {
+	case nil:
+		return byteutil.BytesToUint64(h), nil
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+func (s *stateHeight) PutBlock(context.Context, *block.Block) error {
+	return errors.New("state db is read only")
+}
+
 func init() {
 	flag.StringVar(&genesisPath, "genesis-path", "", "Genesis path")
 	flag.StringVar(&_overwritePath, "config-path", "", "Config path")
 	flag.StringVar(&_secretPath, "secret-path", "", "Secret path")
 	flag.Var(&_plugins, "plugin", "Plugin of the node")
 	flag.IntVar(&recoveryHeight, "recovery-height", 0, "Recovery height")
+	flag.BoolVar(&dryRun, "dry-run", false, "Print the stores to roll back without changing them")
 	flag.Usage = func() {
 		_, _ = fmt.Fprintf(os.Stderr,
-			"usage: recover -config-path=[string]\n -recovery-height=[int]\n")
+			"usage: recover -config-path=[string]\n -recovery-height=[int]\n -dry-run\n")
 		flag.PrintDefaults()
 		os.Exit(2)
 	}
@@ -77,53 +128,197 @@ func main() {
 	}
 
 	cfg.Genesis = genesisCfg
+	block.LoadGenesisHash(&cfg.Genesis)
 
 	log.S().Infof("Config in use: %+v", cfg)
 
-	// create server
-	svr, err := itx.NewServer(cfg)
+	// the indexers rebuilt from the chain db replay the blocks in the blockchain ctx
+	ctx := genesis.WithGenesisContext(protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{
+		ChainID:      cfg.Chain.ID,
+		EvmNetworkID: cfg.Chain.EVMNetworkID,
+	}), cfg.Genesis)
+	var lc lifecycle.Lifecycle
+	rb, err := newRollbacker(cfg, &lc)
 	if err != nil {
-		log.L().Fatal("Failed to create server.", zap.Error(err))
+		log.L().Fatal("Failed to create stores.", zap.Error(err))
 	}
-
-	// recover chain and state
-	bc := svr.ChainService(cfg.Chain.ID).Blockchain()
-	sf := svr.ChainService(cfg.Chain.ID).StateFactory()
-	dao := svr.ChainService(cfg.Chain.ID).BlockDAO()
-	if err := bc.Start(context.Background()); err == nil {
-		log.L().Info("State DB status is normal.")
+	if err := lc.OnStartSequentially(ctx); err != nil {
+		log.L().Fatal("Failed to start stores.", zap.Error(err))
 	}
 	defer func() {
-		if err := bc.Stop(context.Background()); err != nil {
-			log.L().Fatal("Failed to stop blockchain")
+		if err := lc.OnStopSequentially(ctx); err != nil {
+			log.L().Fatal("Failed to stop stores.", zap.Error(err))
 		}
 	}()
-	if err := recoverChainAndState(dao, sf, cfg, uint64(recoveryHeight)); err != nil {
-		log.L().Fatal("Failed to recover chain and state.", zap.Error(err))
-	} else {
+	if err := recoverChainAndState(ctx, rb, uint64(recoveryHeight)); err != nil {
+		log.L().Error("Failed to recover chain and state.", zap.Error(err))
+		return
+	}
+	if !dryRun {
 		log.S().Infof("Success to recover chain and state to target height %d", recoveryHeight)
 	}
 }
 
-// recoverChainAndState recovers the chain to target height and refresh state db if necessary
-func recoverChainAndState(dao blockdao.BlockDAO, sf factory.Factory, cfg config.Config, targetHeight uint64) error {
-	// TODO: not all indexers could be reverted, e.g., state factory. Thus, dao.DeleteTipBlockToTarget will always
-	// return error. Therefore, it is not hard to tell that this tool is not working. The right way is to revert indexer
-	// one by one. Before we implement it in that way, comment out the following code.
-	/*
-		if err := dao.DeleteBlockToTarget(targetHeight); err != nil {
-			return errors.Wrapf(err, "failed to recover blockchain to target height %d", targetHeight)
+// newRollbacker opens the chain db and all indexers of the node
+func newRollbacker(cfg config.Config, lc *lifecycle.Lifecycle) (*blockdao.Rollbacker, error) {
+	uri, err := url.Parse(cfg.Chain.ChainDBPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse chain db path %s", cfg.Chain.ChainDBPath)
+	}
+	if uri.Scheme != "file" && uri.Scheme != "" {
+		return nil, errors.Errorf("cannot roll back chain db %s", cfg.Chain.ChainDBPath)
+	}
+	dbConfig := cfg.DB
+	dbConfig.DbPath = uri.Path
+	store, err := filedao.NewFileDAO(dbConfig, block.NewDeserializer(cfg.Chain.EVMNetworkID))
+	if err != nil {
+		return nil, err
+	}
+	lc.Add(store)
+	rb := blockdao.NewRollbacker(store)
+
+	// state db, which is rolled back by reverting the recorded state diffs
+	if len(cfg.Chain.HistoryIndexPath) > 0 {
+		return nil, errors.Errorf("cannot roll back history index %s", cfg.Chain.HistoryIndexPath)
+	}
+	factoryDBCfg := cfg.DB
+	factoryDBCfg.DBType = cfg.Chain.FactoryDBType
+	stateDB, err := db.CreateKVStore(factoryDBCfg, cfg.Chain.TrieDBPath)
+	if err != nil {
+		return nil, err
+	}
+	if path := cfg.Chain.StateDiffDBPath; len(path) > 0 {
+		dbConfig.DbPath = path
+		blocksPerHour := time.Hour / cfg.WakeUpgrade.BlockInterval
+		diffs, err := statediff.NewIndexer(db.NewBoltDB(dbConfig), uint64(blocksPerHour)*uint64(cfg.Chain.StateDiffRetentionDays)*24, cfg.Genesis.GreenlandBlockHeight)
+		if err != nil {
+			return nil, err
 		}
-		stateHeight, err := sf.Height()
+		lc.Add(diffs)
+		sr := factory.NewStateDBRewinder(stateDB, diffs)
+		lc.Add(sr)
+		rb.AddIndexer("state db", sr)
+	} else {
+		sh := &stateHeight{kvStore: stateDB}
+		lc.Add(sh)
+		rb.AddIndexer("state db", sh)
+	}
+
+	// contract staking indexers
+	if cfg.Chain.EnableStakingProtocol {
+		dbConfig.DbPath = cfg.Chain.ContractStakingIndexDBPath
+		kvstore := db.NewBoltDB(dbConfig)
+		if len(cfg.Genesis.SystemStakingContractAddress) > 0 {
+			voteCalcConsts := cfg.Genesis.VoteWeightCalConsts
+			indexer, err := contractstaking.NewContractStakingIndexer(kvstore, contractstaking.Config{
+				ContractAddress:      cfg.Genesis.SystemStakingContractAddress,
+				ContractDeployHeight: cfg.Genesis.SystemStakingContractHeight,
+				CalculateVoteWeight: func(v *staking.VoteBucket) *big.Int {
+					return staking.CalculateVoteWeight(voteCalcConsts, v, false)
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			lc.Add(indexer)
+			rb.AddIndexer("contract staking indexer", indexer)
+		}
+		if len(cfg.Genesis.SystemStakingContractV2Address) > 0 {
+			indexer := stakingindex.NewIndexer(kvstore, cfg.Genesis.SystemStakingContractV2Address, cfg.Genesis.SystemStakingContractV2Height, nil)
+			lc.Add(indexer)
+			rb.AddIndexer("contract staking indexer v2", indexer)
+		}
+		if len(cfg.Genesis.SystemStakingContractV3Address) > 0 {
+			indexer := stakingindex.NewIndexer(kvstore, cfg.Genesis.SystemStakingContractV3Address, cfg.Genesis.SystemStakingContractV3Height, nil)
+			lc.Add(indexer)
+			rb.AddIndexer("contract staking indexer v3", indexer)
+		}
+	}
+
+	// gateway indexers
+	if _, gateway := cfg.Plugins[config.GatewayPlugin]; gateway {
+		dbConfig.DbPath = cfg.Chain.IndexDBPath
+		indexer, err := blockindex.NewIndexer(db.NewBoltDB(dbConfig), cfg.Genesis.Hash())
+		if err != nil {
+			return nil, err
+		}
+		lc.Add(indexer)
+		rb.AddIndexer("block indexer", indexer)
+
+		dbConfig.DbPath = cfg.Chain.BloomfilterIndexDBPath
+		bfIndexer, err := blockindex.NewBloomfilterIndexer(db.NewBoltDB(dbConfig), cfg.Indexer)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if targetHeight < stateHeight {
-			// delete existing state DB (build from scratch)
-			if fileutil.FileExists(cfg.Chain.TrieDBPath) && os.Remove(cfg.Chain.TrieDBPath) != nil {
-				return errors.New("failed to delete existing state DB")
+		lc.Add(bfIndexer)
+		rb.AddIndexer("bloomfilter indexer", bfIndexer)
+
+		dbConfig.DbPath = cfg.Chain.CandidateIndexDBPath
+		candidateIndexer, err := poll.NewCandidateIndexer(db.NewBoltDB(dbConfig))
+		if err != nil {
+			return nil, err
+		}
+		lc.Add(candidateIndexer)
+		rb.AddRewinder("candidate indexer", candidateIndexer)
+
+		if cfg.Chain.EnableStakingIndexer {
+			dbConfig.DbPath = cfg.Chain.StakingIndexDBPath
+			candBucketsIndexer, err := staking.NewStakingCandidatesBucketsIndexer(db.NewBoltDB(dbConfig))
+			if err != nil {
+				return nil, err
 			}
+			lc.Add(candBucketsIndexer)
+			rb.AddRewinder("staking indexer", candBucketsIndexer)
 		}
-	*/
-	panic("not implemented")
+
+		if path := cfg.Chain.BalanceHistoryIndexDBPath; len(path) > 0 {
+			dbConfig.DbPath = path
+			bhIndexer, err := balancehistory.NewIndexer(db.NewBoltDB(dbConfig), cfg.Genesis.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			lc.Add(bhIndexer)
+			rb.AddIndexer("balance history indexer", bhIndexer)
+		}
+	}
+
+	// blob store
+	if bsPath := cfg.Chain.BlobStoreDBPath; len(bsPath) > 0 {
+		blocksPerHour := time.Hour / cfg.WakeUpgrade.BlockInterval
+		dbConfig.DbPath = bsPath
+		blobStore := blockdao.NewBlobStore(db.NewBoltDB(dbConfig), uint64(blocksPerHour)*uint64(cfg.Chain.BlobStoreRetentionDays)*24)
+		lc.Add(blobStore)
+		rb.AddRewinder("blob store", blobStore)
+	}
+	return rb, nil
+}
+
+// recoverChainAndState rolls the chain and all indexers back to target height.
+// Nothing is changed if any store cannot be rolled back, like the state db
+// without the state diffs of the blocks above the target height.
+func recoverChainAndState(ctx context.Context, rb *blockdao.Rollbacker, targetHeight uint64) error {
+	steps, err := rb.Plan(targetHeight)
+	if err != nil {
+		return err
+	}
+	var refused []string
+	for _, s := range steps {
+		switch {
+		case s.Err != nil:
+			fmt.Printf("%-28s height %d, cannot roll back: %v\n", s.Name, s.Height, s.Err)
+			refused = append(refused, s.Name)
+		case s.Height > targetHeight:
+			fmt.Printf("%-28s height %d -> %d\n", s.Name, s.Height, targetHeight)
+		default:
+			fmt.Printf("%-28s height %d, unchanged\n", s.Name, s.Height)
+		}
+	}
+	if len(refused) > 0 {
+		fmt.Printf("Delete the db of %s to rebuild from the chain db, then run again.\n", strings.Join(refused, ", "))
+		return errors.Wrapf(blockdao.ErrCannotRewind, "cannot roll back to height %d", targetHeight)
+	}
+	if dryRun {
+		return nil
+	}
+	return rb.Rollback(ctx, targetHeight)
 }